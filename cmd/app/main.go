@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log/slog"
+	"os"
 	"reviewer-assigner/internal/app"
 	"reviewer-assigner/internal/config"
 	"reviewer-assigner/internal/logger"
@@ -14,6 +15,26 @@ func main() {
 	cfg := config.Must()
 	log := logger.New(cfg.Env)
 
+	if len(os.Args) > 1 {
+		var err error
+		switch os.Args[1] {
+		case "tokens":
+			err = app.RunTokens(ctx, cfg, log, os.Args[2:], os.Stdout)
+		case "export-state":
+			err = app.RunExportState(ctx, cfg, log, os.Args[2:], os.Stdout)
+		case "import-state":
+			err = app.RunImportState(ctx, cfg, log, os.Args[2:], os.Stdin, os.Stdout)
+		default:
+			log.Error("unknown command", slog.String("command", os.Args[1]))
+			os.Exit(1)
+		}
+		if err != nil {
+			log.Error(os.Args[1]+" command failed", logger.ErrAttr(err))
+			os.Exit(1)
+		}
+		return
+	}
+
 	log.Info("starting service",
 		slog.String("env", cfg.Env),
 		slog.Int("port", cfg.HTTPServer.Port),
@@ -0,0 +1,179 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	assignmentsHandler "reviewer-assigner/internal/http/handlers/assignments"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type AssignmentSimulateSuite struct {
+	BaseSuite
+}
+
+func (s *AssignmentSimulateSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *AssignmentSimulateSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *AssignmentSimulateSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	const layout = "2006-01-02 15:04:05"
+	now := time.Now().UTC()
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Template(),
+		testfixtures.TemplateData(map[string]string{
+			"Recent": now.AddDate(0, 0, -1).Format(layout),
+			"Old":    now.AddDate(0, 0, -90).Format(layout),
+		}),
+		testfixtures.Directory("fixtures/storage/assignment_simulate"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestAssignmentSimulateSuite_Run(t *testing.T) {
+	suite.Run(t, new(AssignmentSimulateSuite))
+}
+
+func (s *AssignmentSimulateSuite) TestSimulateLeastLoaded() {
+	requestBody := `
+{
+  "team_name": "backend",
+  "strategy": "least_loaded",
+  "days": 30,
+  "params": {
+    "reviewers_count": 2
+  }
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/assignment/simulate", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response assignmentsHandler.SimulateResponse
+	err = json.NewDecoder(res.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	expectedReviewers := `
+[
+  {"user_id": "u1_Alice", "username": "Alice", "simulated": 1, "actual": 0},
+  {"user_id": "u2_Bob", "username": "Bob", "simulated": 3, "actual": 3},
+  {"user_id": "u3_John", "username": "John", "simulated": 2, "actual": 1}
+]
+`
+
+	s.Equal("backend", response.TeamName)
+	s.Equal("least_loaded", response.Strategy)
+	s.Equal(3, response.PullRequests)
+	JSONEq(s.T(), expectedReviewers, response.Reviewers)
+
+	const delta = 1e-9
+	s.InDelta(2.0/9, response.Simulated.Gini, delta)
+	s.Equal(3, response.Simulated.MaxLoad)
+	s.Equal(1, response.Simulated.MinLoad)
+	s.InDelta(0.5, response.Actual.Gini, delta)
+	s.Equal(3, response.Actual.MaxLoad)
+	s.Equal(0, response.Actual.MinLoad)
+
+	// nothing is written
+	res, err = s.server.Client().Get(s.server.URL + "/users/getReview?user_id=u1_Alice")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `{"user_id": "u1_Alice", "pull_requests": [
+  {
+    "pull_request_id": "pr_frontend",
+    "pull_request_name": "Add button",
+    "author_id": "u5_Kate",
+    "team_name": "frontend",
+    "status": "OPEN"
+  }
+]}`, res.Body)
+}
+
+func (s *AssignmentSimulateSuite) TestSimulateErrors() {
+	testCases := []struct {
+		name          string
+		requestBody   string
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name:         "team not found",
+			requestBody:  `{"team_name": "unknown", "strategy": "random"}`,
+			expectedCode: http.StatusNotFound,
+			expectedError: `
+{
+  "error": {
+    "code": "NOT_FOUND",
+    "message": "resource not found"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "unknown strategy",
+			requestBody:  `{"team_name": "backend", "strategy": "by_mood"}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedError: `
+{
+  "error": {
+    "code": "INVALID_BODY",
+    "message": "invalid request body"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "too long period",
+			requestBody:  `{"team_name": "backend", "strategy": "random", "days": 1000}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedError: `
+{
+  "error": {
+    "code": "INVALID_BODY",
+    "message": "invalid request body"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.server.Client().
+				Post(s.server.URL+"/assignment/simulate", "", bytes.NewBufferString(tc.requestBody))
+			s.Require().NoError(err)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+
+			var errorResp handlers.ErrorResponse
+			err = json.NewDecoder(res.Body).Decode(&errorResp)
+			s.Require().NoError(err)
+
+			JSONEq(s.T(), tc.expectedError, errorResp)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"reviewer-assigner/internal/auth/authtest"
+	"reviewer-assigner/internal/config"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthJWTSuite struct {
+	BaseSuite
+
+	issuer *authtest.Issuer
+}
+
+func (s *AuthJWTSuite) SetupSuite() {
+	s.issuer = authtest.NewIssuer(s.T())
+	s.authCfg = config.Auth{
+		Mode: config.AuthModeJWT,
+		JWT: config.JWT{
+			JWKSURL:     s.issuer.JWKSURL(),
+			CacheTTL:    10 * time.Minute,
+			Issuer:      authtest.IssuerName,
+			Audience:    authtest.Audience,
+			UserIDClaim: "sub",
+			RolesClaim:  "roles",
+		},
+	}
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *AuthJWTSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *AuthJWTSuite) SetupTest() {
+	s.BaseSuite.SetupTest()
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/rbac"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestAuthJWTSuite_Run(t *testing.T) {
+	suite.Run(t, new(AuthJWTSuite))
+}
+
+func (s *AuthJWTSuite) do(method, path, token string, body string) *http.Response {
+	req, err := http.NewRequest(method, s.server.URL+path, bytes.NewBufferString(body))
+	s.Require().NoError(err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := s.server.Client().Do(req)
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *AuthJWTSuite) TestClaims() {
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		claims         jwt.MapClaims
+		expectedStatus int
+	}{
+		{
+			name:           "expired",
+			method:         http.MethodGet,
+			path:           "/team/get?team_name=payments",
+			claims:         jwt.MapClaims{"sub": "u2_Bob", "exp": time.Now().Add(-time.Hour).Unix()},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "wrong_audience",
+			method:         http.MethodGet,
+			path:           "/team/get?team_name=payments",
+			claims:         jwt.MapClaims{"sub": "u2_Bob", "aud": "other"},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "member_reads",
+			method:         http.MethodGet,
+			path:           "/team/get?team_name=payments",
+			claims:         jwt.MapClaims{"sub": "u2_Bob"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "member_cannot_deactivate",
+			method:         http.MethodPost,
+			path:           "/users/setIsActive",
+			body:           `{"user_id": "u3_John", "is_active": false}`,
+			claims:         jwt.MapClaims{"sub": "u2_Bob"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "team_admin_deactivates",
+			method: http.MethodPost,
+			path:   "/users/setIsActive",
+			body:   `{"user_id": "u3_John", "is_active": false}`,
+			claims: jwt.MapClaims{
+				"sub":   "u1_Alice",
+				"roles": []string{"member", "team_admin"},
+				"scope": "users:write",
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "scope_claim_restricts",
+			method:         http.MethodPost,
+			path:           "/users/setIsActive",
+			body:           `{"user_id": "u3_John", "is_active": false}`,
+			claims:         jwt.MapClaims{"sub": "root", "roles": "admin", "scope": "teams:read"},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.do(tc.method, tc.path, s.issuer.Issue(s.T(), tc.claims), tc.body)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedStatus, res.StatusCode)
+		})
+	}
+}
+
+func (s *AuthJWTSuite) TestKeyRotation() {
+	token := s.issuer.Issue(s.T(), jwt.MapClaims{"sub": "u2_Bob"})
+	res := s.do(http.MethodGet, "/team/get?team_name=payments", token, "")
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	s.issuer.Rotate(s.T())
+
+	token = s.issuer.Issue(s.T(), jwt.MapClaims{"sub": "u2_Bob"})
+	res = s.do(http.MethodGet, "/team/get?team_name=payments", token, "")
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+}
@@ -0,0 +1,182 @@
+package integration_tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/domain/access"
+	tokensHandler "reviewer-assigner/internal/http/handlers/tokens"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthTokenSuite struct {
+	BaseSuite
+}
+
+func (s *AuthTokenSuite) SetupSuite() {
+	s.authCfg.Mode = config.AuthModeToken
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *AuthTokenSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func TestAuthTokenSuite_Run(t *testing.T) {
+	suite.Run(t, new(AuthTokenSuite))
+}
+
+func (s *AuthTokenSuite) issue(scopes ...access.Scope) string {
+	_, plain, err := s.tokenService.Issue(context.Background(), "test", access.RoleAdmin, scopes)
+	s.Require().NoError(err)
+
+	return plain
+}
+
+func (s *AuthTokenSuite) do(method, path, token string, body string) *http.Response {
+	req, err := http.NewRequest(method, s.server.URL+path, bytes.NewBufferString(body))
+	s.Require().NoError(err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := s.server.Client().Do(req)
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *AuthTokenSuite) TestScopes() {
+	readToken := s.issue(access.ScopeTeamsRead)
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:           "no_token",
+			method:         http.MethodGet,
+			path:           "/team/get?team_name=unknown",
+			expectedStatus: http.StatusUnauthorized,
+			expectedError: `
+{
+  "error": {
+    "code": "UNAUTHORIZED",
+    "message": "missing, invalid or revoked credentials"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:           "unknown_token",
+			method:         http.MethodGet,
+			path:           "/team/get?team_name=unknown",
+			token:          "ra_unknown",
+			expectedStatus: http.StatusUnauthorized,
+			expectedError: `
+{
+  "error": {
+    "code": "UNAUTHORIZED",
+    "message": "missing, invalid or revoked credentials"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:           "insufficient_scope",
+			method:         http.MethodPost,
+			path:           "/team/add",
+			token:          readToken,
+			expectedStatus: http.StatusForbidden,
+			expectedError: `
+{
+  "error": {
+    "code": "INSUFFICIENT_SCOPE",
+    "message": "token lacks scope teams:write"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:           "allowed",
+			method:         http.MethodGet,
+			path:           "/team/get?team_name=unknown",
+			token:          readToken,
+			expectedStatus: http.StatusNotFound,
+			expectedError: `
+{
+  "error": {
+    "code": "NOT_FOUND",
+    "message": "resource not found"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.do(tc.method, tc.path, tc.token, "{}")
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedStatus, res.StatusCode)
+			JSONEq(s.T(), tc.expectedError, res.Body)
+		})
+	}
+}
+
+func (s *AuthTokenSuite) TestIssueAndRevoke() {
+	adminToken := s.issue(access.ScopeTokensWrite)
+
+	res := s.do(http.MethodPost, "/tokens/issue", adminToken, `
+{
+  "actor": "ci-bot",
+  "scopes": ["stats:read"]
+}
+`)
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	var issued tokensHandler.IssueTokenResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&issued))
+	s.Equal("ci-bot", issued.Actor)
+	s.Equal([]string{"stats:read"}, issued.Scopes)
+	s.NotEmpty(issued.Token)
+
+	res = s.do(http.MethodGet, "/stats/reviewers/assignments", issued.Token, "")
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	res = s.do(http.MethodPost, "/tokens/revoke", adminToken, `{"token_id": "`+issued.TokenID+`"}`)
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	res = s.do(http.MethodPost, "/tokens/revoke", adminToken, `{"token_id": "`+issued.TokenID+`"}`)
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+
+	res = s.do(http.MethodGet, "/stats/reviewers/assignments", issued.Token, "")
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusUnauthorized, res.StatusCode)
+}
+
+func (s *AuthTokenSuite) TestIssueInvalidScope() {
+	adminToken := s.issue(access.ScopeTokensWrite)
+
+	res := s.do(http.MethodPost, "/tokens/issue", adminToken, `
+{
+  "actor": "ci-bot",
+  "scopes": ["prs:delete"]
+}
+`)
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusUnprocessableEntity, res.StatusCode)
+}
@@ -5,22 +5,38 @@ import (
 	"database/sql"
 	"io"
 	"log/slog"
+	"math/rand/v2"
 	"net/http/httptest"
 	"reviewer-assigner/internal/app"
+	"reviewer-assigner/internal/auth"
+	"reviewer-assigner/internal/config"
 	reviewerPicker "reviewer-assigner/internal/domain/pullrequests/pickers"
 	reviewerAssigner "reviewer-assigner/internal/domain/pullrequests/reassigners"
+	assignmentsHandler "reviewer-assigner/internal/http/handlers/assignments"
+	exportHandler "reviewer-assigner/internal/http/handlers/export"
 	prsHandler "reviewer-assigner/internal/http/handlers/pullrequests"
 	statsHandler "reviewer-assigner/internal/http/handlers/stats"
 	teamsHandler "reviewer-assigner/internal/http/handlers/teams"
+	tokensHandler "reviewer-assigner/internal/http/handlers/tokens"
 	usersHandler "reviewer-assigner/internal/http/handlers/users"
+	"reviewer-assigner/internal/http/middleware"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/metrics"
+	"reviewer-assigner/internal/ratelimit"
+	accessService "reviewer-assigner/internal/service/access"
+	assignmentsService "reviewer-assigner/internal/service/assignments"
 	prsService "reviewer-assigner/internal/service/pullrequests"
 	teamsService "reviewer-assigner/internal/service/teams"
+	tokensService "reviewer-assigner/internal/service/tokens"
 	usersService "reviewer-assigner/internal/service/users"
+	exportRepo "reviewer-assigner/internal/storage/export"
 	"reviewer-assigner/internal/storage/postgres"
 	prsRepo "reviewer-assigner/internal/storage/pullrequests"
 	statsRepo "reviewer-assigner/internal/storage/stats"
 	teamsRepo "reviewer-assigner/internal/storage/teams"
+	tokensRepo "reviewer-assigner/internal/storage/tokens"
 	usersRepo "reviewer-assigner/internal/storage/users"
+	"reviewer-assigner/internal/tracing"
 	"time"
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
@@ -28,6 +44,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 const migrationsPath = "../migrations/postgres"
@@ -43,16 +62,29 @@ type BaseSuite struct {
 	psqlContainer *PostgreSQLContainer
 	server        *httptest.Server
 	loader        *FixtureLoader
+	pickerSource  *rand.PCG
+	metrics       *metrics.Metrics
+	spans         *tracetest.InMemoryExporter
+	// authCfg is set by suites before SetupSuite, authentication is off by default.
+	authCfg      config.Auth
+	tokenService *tokensService.TokenService
+	// rateLimitCfg is set by suites before SetupSuite, rate limiting is off by default.
+	rateLimitCfg config.RateLimit
 }
 
 func (s *BaseSuite) SetupSuite() {
-	l := slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	l := slog.New(logger.NewContextHandler(
+		slog.NewTextHandler(out, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	))
 	gin.DefaultWriter = out // nolint:reassign
 
 	const baseTimout = 30 * time.Second
 	ctx, ctxCancel := context.WithTimeout(context.Background(), baseTimout)
 	defer ctxCancel()
 
+	s.spans = tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(tracing.NewProvider(sdktrace.WithSyncer(s.spans)))
+
 	psqlContainer, err := NewPostgreSQLContainer(ctx)
 	s.Require().NoError(err)
 	s.psqlContainer = psqlContainer
@@ -74,6 +106,9 @@ func (s *BaseSuite) SetupSuite() {
 
 	txManager := manager.Must(trmpgx.NewDefaultFactory(pool))
 
+	s.metrics = metrics.New()
+	s.metrics.RegisterPool(pool)
+
 	teamRepo := teamsRepo.NewPostgresTeamRepository(pool, trmpgx.DefaultCtxGetter)
 	userRepo := usersRepo.NewPostgresUserRepository(pool, trmpgx.DefaultCtxGetter)
 	pullRequestRepo := prsRepo.NewPostgresPullRequestRepository(
@@ -81,31 +116,89 @@ func (s *BaseSuite) SetupSuite() {
 		trmpgx.DefaultCtxGetter,
 	)
 	statRepo := statsRepo.NewPostgresStatsRepository(pool, trmpgx.DefaultCtxGetter)
+	exportsRepo := exportRepo.NewPostgresExportRepository(pool, trmpgx.DefaultCtxGetter)
+	tokenRepo := tokensRepo.NewPostgresTokenRepository(pool, trmpgx.DefaultCtxGetter)
+
+	const defaultPickerSeed = 1
+	s.pickerSource = rand.NewPCG(defaultPickerSeed, defaultPickerSeed)
+	picker := reviewerPicker.NewRandomReviewerPicker(s.pickerSource)
 
-	teamService := teamsService.NewTeamService(l, teamRepo, txManager)
-	userService := usersService.NewUserService(l, userRepo, pullRequestRepo, txManager)
+	policy := accessService.NewPolicy(userRepo)
+
+	userService := usersService.NewUserService(l, userRepo, pullRequestRepo, policy, txManager)
 	pullRequestService := prsService.NewPullRequestService(
 		l,
 		userRepo,
 		teamRepo,
 		pullRequestRepo,
-		&reviewerPicker.RandomReviewerPicker{},
-		reviewerAssigner.NewRandomReviewerReassigner(),
+		picker,
+		reviewerAssigner.NewRandomReviewerReassigner(picker),
+		picker.Strategy(),
+		s.metrics,
+		policy,
+		txManager,
+	)
+	teamService := teamsService.NewTeamService(l, teamRepo, pullRequestService, policy, txManager)
+	assignmentService := assignmentsService.NewAssignmentService(
+		l,
+		teamRepo,
+		pullRequestRepo,
 		txManager,
 	)
+	s.tokenService = tokensService.NewTokenService(l, tokenRepo, policy)
+
+	if s.authCfg.Mode == "" {
+		s.authCfg.Mode = config.AuthModeNone
+	}
+	authenticator, err := auth.NewAuthenticator(l, &s.authCfg, s.tokenService)
+	s.Require().NoError(err)
+	authMiddleware := auth.NewMiddleware(l, authenticator)
+
+	if s.rateLimitCfg.Backend == "" {
+		s.rateLimitCfg.Backend = ratelimit.BackendNone
+	}
+	limiter, err := app.NewLimiter(&s.rateLimitCfg, pool)
+	s.Require().NoError(err)
+	rateLimiter := middleware.NewRateLimiter(l, limiter, &s.rateLimitCfg)
+
 	statHandler := statsHandler.NewStatHandler(l, statRepo)
+	exportsHandler := exportHandler.NewExportHandler(l, exportsRepo)
 
 	teamHandler := teamsHandler.NewTeamHandler(l, teamService)
 	userHandler := usersHandler.NewUserHandler(l, userService)
 	pullRequestHandler := prsHandler.NewPullRequestHandler(l, pullRequestService)
+	assignmentHandler := assignmentsHandler.NewAssignmentHandler(l, assignmentService)
+	tokenHandler := tokensHandler.NewTokenHandler(l, s.tokenService)
 
-	s.server = httptest.NewServer(
-		app.NewRouter(l, teamHandler, userHandler, pullRequestHandler, statHandler),
+	router, err := app.NewRouter(
+		l,
+		nil,
+		s.metrics,
+		teamHandler,
+		userHandler,
+		pullRequestHandler,
+		assignmentHandler,
+		statHandler,
+		exportsHandler,
+		tokenHandler,
+		authMiddleware,
+		rateLimiter,
 	)
+	s.Require().NoError(err)
+
+	s.server = httptest.NewServer(router)
+
+	client := s.server.Client()
+	client.Transport = requestIDTransport{base: client.Transport}
 
 	s.loader = NewFixtureLoader(s.T(), Fixtures)
 }
 
+// SeedPicker restarts reviewer picking from seed, so a test can assert exact reviewers.
+func (s *BaseSuite) SeedPicker(seed uint64) {
+	s.pickerSource.Seed(seed, seed)
+}
+
 func (s *BaseSuite) TearDownSuite() {
 	const timeoutToDown = 5 * time.Second
 	ctx, ctxCancel := context.WithTimeout(context.Background(), timeoutToDown)
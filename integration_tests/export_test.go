@@ -0,0 +1,176 @@
+package integration_tests
+
+import (
+	"bufio"
+	"database/sql"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportSuite struct {
+	BaseSuite
+}
+
+func (s *ExportSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *ExportSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *ExportSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/export"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestExportSuite_Run(t *testing.T) {
+	suite.Run(t, new(ExportSuite))
+}
+
+func (s *ExportSuite) export(path string) (*http.Response, string) {
+	res, err := s.server.Client().Get(s.server.URL + path)
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	s.Require().NoError(err)
+
+	return res, string(body)
+}
+
+func (s *ExportSuite) requireNDJSONEq(expected []string, body string) {
+	lines := strings.Split(strings.TrimSuffix(body, "\n"), "\n")
+	s.Require().Len(lines, len(expected))
+
+	for i := range expected {
+		assert.JSONEq(s.T(), expected[i], lines[i])
+	}
+}
+
+func (s *ExportSuite) TestExportPullRequestsNDJSON() {
+	res, body := s.export("/export/pullRequests")
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	s.Require().Equal("application/x-ndjson", res.Header.Get("Content-Type"))
+
+	expected := []string{
+		`{"pull_request_id": "pr_billing", "pull_request_name": "Billing", "author_id": "u1_Alice",` +
+			` "team_name": "payments", "status": "MERGED", "reviewer_ids": ["u2_Bob"],` +
+			` "created_at": "2024-01-10T10:00:00Z", "merged_at": "2024-01-12T15:30:00Z"}`,
+		`{"pull_request_id": "pr_cart", "pull_request_name": "Cart", "author_id": "u3_John",` +
+			` "team_name": "checkout", "status": "OPEN", "reviewer_ids": ["sec_Sam", "u1_Alice"],` +
+			` "created_at": "2024-02-10T10:00:00Z", "merged_at": null}`,
+		`{"pull_request_id": "pr_audit", "pull_request_name": "Audit", "author_id": "sec_Sam",` +
+			` "team_name": "security", "status": "OPEN", "reviewer_ids": [],` +
+			` "created_at": "2024-03-10T10:00:00Z", "merged_at": null}`,
+	}
+	s.requireNDJSONEq(expected, body)
+}
+
+func (s *ExportSuite) TestExportPullRequestsCSV() {
+	// payments rolls up checkout, pr_audit is out of the period anyway
+	res, body := s.export(
+		"/export/pullRequests?format=csv&team_name=payments&from=2024-01-01T00:00:00Z&to=2024-03-01T00:00:00Z",
+	)
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	s.Require().Equal("text/csv; charset=utf-8", res.Header.Get("Content-Type"))
+	s.Require().Equal(`attachment; filename="pull_requests.csv"`, res.Header.Get("Content-Disposition"))
+
+	expected := `pull_request_id,pull_request_name,author_id,team_name,status,reviewer_ids,created_at,merged_at
+pr_billing,Billing,u1_Alice,payments,MERGED,u2_Bob,2024-01-10T10:00:00Z,2024-01-12T15:30:00Z
+pr_cart,Cart,u3_John,checkout,OPEN,sec_Sam;u1_Alice,2024-02-10T10:00:00Z,
+`
+	s.Require().Equal(expected, body)
+}
+
+func (s *ExportSuite) TestExportPullRequestsEmptyCSV() {
+	res, body := s.export("/export/pullRequests?format=csv&from=2030-01-01T00:00:00Z")
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := "pull_request_id,pull_request_name,author_id,team_name,status,reviewer_ids,created_at,merged_at\n"
+	s.Require().Equal(expected, body)
+}
+
+func (s *ExportSuite) TestExportAssignments() {
+	res, body := s.export("/export/assignments?team_name=checkout")
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := []string{
+		`{"pull_request_id": "pr_cart", "team_name": "checkout", "reviewer_id": "sec_Sam",` +
+			` "group_team_name": "security", "approved_at": "2024-02-11T09:00:00Z",` +
+			` "declined_at": null, "decline_reason": ""}`,
+		`{"pull_request_id": "pr_cart", "team_name": "checkout", "reviewer_id": "u1_Alice",` +
+			` "group_team_name": "", "approved_at": null, "declined_at": null, "decline_reason": ""}`,
+		`{"pull_request_id": "pr_cart", "team_name": "checkout", "reviewer_id": "u2_Bob",` +
+			` "group_team_name": "", "approved_at": null,` +
+			` "declined_at": "2024-02-10T12:00:00Z", "decline_reason": "UNAVAILABLE"}`,
+	}
+	s.requireNDJSONEq(expected, body)
+}
+
+func (s *ExportSuite) TestExportTeamsCSV() {
+	res, body := s.export("/export/teams?format=csv")
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := `team_name,parent_team_name,member_ids
+payments,,u1_Alice;u2_Bob
+checkout,payments,u3_John
+security,,sec_Sam
+`
+	s.Require().Equal(expected, body)
+}
+
+func (s *ExportSuite) TestExportOverManyFetches() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	const generated = 2500
+	_, err = db.Exec(`
+	INSERT INTO pull_requests (pull_request_id, name, author_id, team_id, created_at)
+	SELECT 'pr_gen_' || i, 'Generated ' || i, 'u1_Alice', 1, '2024-04-01'::timestamp + i * interval '1 minute'
+	FROM generate_series(1, $1::int) AS i
+	`, generated)
+	s.Require().NoError(err)
+
+	res, err := s.server.Client().Get(s.server.URL + "/export/pullRequests?from=2024-04-01T00:00:00Z")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	lines := 0
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		lines++
+	}
+	s.Require().NoError(scanner.Err())
+	s.Require().Equal(generated, lines)
+}
+
+func (s *ExportSuite) TestExportInvalidQuery() {
+	paths := []string{
+		"/export/teams?format=xml",
+		"/export/pullRequests?from=yesterday",
+		"/export/assignments?from=2024-03-01T00:00:00Z&to=2024-01-01T00:00:00Z",
+	}
+
+	for _, path := range paths {
+		res, body := s.export(path)
+		s.Require().Equal(http.StatusBadRequest, res.StatusCode, path)
+		s.Require().Contains(body, "INVALID_QUERY_PARAM", path)
+	}
+}
@@ -0,0 +1,47 @@
+package integration_tests
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MetricsSuite struct {
+	BaseSuite
+}
+
+func (s *MetricsSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *MetricsSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func TestMetricsSuite_Run(t *testing.T) {
+	suite.Run(t, new(MetricsSuite))
+}
+
+func (s *MetricsSuite) TestMetrics() {
+	res, err := s.server.Client().Get(s.server.URL + "/team/get?team_name=unknown")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+
+	res, err = s.server.Client().Get(s.server.URL + "/metrics")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	body, err := io.ReadAll(res.Body)
+	s.Require().NoError(err)
+
+	s.Contains(string(body),
+		`reviewer_assigner_http_request_duration_seconds_count{method="GET",route="/team/get",status="404"} 1`)
+	s.Contains(string(body), "reviewer_assigner_db_pool_max_connections")
+	s.Contains(string(body), "reviewer_assigner_pull_requests_created_total 0")
+}
@@ -294,6 +294,172 @@ func (s *PullRequestCreateSuite) TestCreateWithOneActiveMembers() {
 	JSONEq(s.T(), expected, response)
 }
 
+func (s *PullRequestCreateSuite) TestCreateSeeded() {
+	s.SeedPicker(42)
+
+	requestBody := `
+{
+  "pull_request_id": "pr_seeded_id",
+  "pull_request_name": "Seeded PR",
+  "author_id": "u14_PlatformAuthor"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/create", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	response := prHandler.CreatePullRequestResponse{}
+	err = json.NewDecoder(res.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	expectedTemplate := `
+{
+  "pr": {
+    "pull_request_id": "pr_seeded_id",
+    "pull_request_name": "Seeded PR",
+    "author_id": "u14_PlatformAuthor",
+    "status": "OPEN",
+    "assigned_reviewers": [
+      "u18_Platform5",
+      "u15_Platform2"
+    ],
+	"created_at": "{{.createdAt}}"
+  }
+}
+`
+
+	expected := s.loader.LoadTemplate(expectedTemplate, map[string]any{
+		"createdAt": template.HTML(response.CreatedAt.Format(time.RFC3339Nano)),
+	})
+
+	JSONEq(s.T(), expected, response)
+}
+
+func (s *PullRequestCreateSuite) TestCreateAppliedRules() {
+	requestBody := `
+{
+  "pull_request_id": "pr_applied_rules_id",
+  "pull_request_name": "PR with applied rules",
+  "author_id": "u19_RuledAuthor"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/create", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	response := prHandler.CreatePullRequestResponse{}
+	err = json.NewDecoder(res.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	expectedTemplate := `
+{
+  "pr": {
+    "pull_request_id": "pr_applied_rules_id",
+    "pull_request_name": "PR with applied rules",
+    "author_id": "u19_RuledAuthor",
+    "status": "OPEN",
+    "assigned_reviewers": [
+      "u21_Reviewer"
+    ],
+	"created_at": "{{.createdAt}}"
+  },
+  "applied_rules": [
+    {
+      "kind": "CONFLICT_OF_INTEREST",
+      "user_id": "u19_RuledAuthor",
+      "other_user_id": "u20_Conflicted",
+      "reason": "u20_Conflicted must not review PRs of u19_RuledAuthor"
+    }
+  ]
+}
+`
+
+	expected := s.loader.LoadTemplate(expectedTemplate, map[string]any{
+		"createdAt": template.HTML(response.CreatedAt.Format(time.RFC3339Nano)),
+	})
+
+	JSONEq(s.T(), expected, response)
+}
+
+func (s *PullRequestCreateSuite) TestCreateRequestedReviewers() {
+	requestBody := `
+{
+  "pull_request_id": "pr_requested_id",
+  "pull_request_name": "PR with requested reviewer",
+  "author_id": "u1_Alice",
+  "requested_reviewers": ["u3_John"]
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/create", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	response := prHandler.CreatePullRequestResponse{}
+	err = json.NewDecoder(res.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	// the requested reviewer comes first, the picker fills the slot left
+	s.Require().Equal([]string{"u3_John", "u2_Bob"}, response.AssignedReviewers)
+}
+
+func (s *PullRequestCreateSuite) TestCreateRequestedReviewerInvalid() {
+	testCases := []struct {
+		name       string
+		reviewerID string
+	}{
+		{name: "other_team", reviewerID: "u4_Sarah"},
+		{name: "author", reviewerID: "u1_Alice"},
+		{name: "unknown", reviewerID: "unknown"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			requestBody := `
+{
+  "pull_request_id": "pr_requested_id",
+  "pull_request_name": "PR with requested reviewer",
+  "author_id": "u1_Alice",
+  "requested_reviewers": ["` + tc.reviewerID + `"]
+}
+`
+
+			res, err := s.server.Client().
+				Post(s.server.URL+"/pullRequest/create", "", bytes.NewBufferString(requestBody))
+			s.Require().NoError(err)
+
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusUnprocessableEntity, res.StatusCode)
+
+			expected := `
+{
+  "error": {
+    "code": "REVIEWER_INVALID",
+    "message": "user ` + tc.reviewerID + ` cannot review this PR"
+  },
+  "request_id": "test-request-id"
+}
+`
+			JSONEq(s.T(), expected, res.Body)
+		})
+	}
+}
+
 func (s *PullRequestCreateSuite) TestCreateNotFoundAuthor() {
 	requestBody := `
 {
@@ -320,7 +486,8 @@ func (s *PullRequestCreateSuite) TestCreateNotFoundAuthor() {
   "error": {
     "code": "NOT_FOUND",
     "message": "resource not found"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
@@ -353,7 +520,8 @@ func (s *PullRequestCreateSuite) TestCreateAlreadyExists() {
   "error": {
     "code": "PR_EXISTS",
     "message": "PR pr_already_exists_id already exists"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
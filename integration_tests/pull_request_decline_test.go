@@ -0,0 +1,188 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	prHandler "reviewer-assigner/internal/http/handlers/pullrequests"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type PullRequestDeclineSuite struct {
+	BaseSuite
+}
+
+func (s *PullRequestDeclineSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *PullRequestDeclineSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *PullRequestDeclineSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/review_decline"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestPullRequestDeclineSuite_Run(t *testing.T) {
+	suite.Run(t, new(PullRequestDeclineSuite))
+}
+
+func (s *PullRequestDeclineSuite) post(path, body string) *http.Response {
+	res, err := s.server.Client().Post(s.server.URL+path, "", bytes.NewBufferString(body))
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *PullRequestDeclineSuite) decline(body string) *prHandler.DeclinePullRequestResponse {
+	res := s.post("/pullRequest/decline", body)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response prHandler.DeclinePullRequestResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+
+	return &response
+}
+
+func (s *PullRequestDeclineSuite) TestDecline() {
+	// Mike is the only member left to take over from Bob
+	response := s.decline(`
+{"pull_request_id": "pr_opened_id", "reviewer_id": "u2_Bob", "reason": "NO_CONTEXT", "comment": "no payments context"}`)
+
+	s.Require().NotNil(response.ReplacedBy)
+	s.Require().Equal("u4_Mike", *response.ReplacedBy)
+	s.Require().ElementsMatch([]string{"u3_John", "u4_Mike"}, response.AssignedReviewers)
+
+	// Bob declined, so nobody takes over from John
+	response = s.decline(`{"pull_request_id": "pr_opened_id", "reviewer_id": "u3_John", "reason": "UNAVAILABLE"}`)
+
+	s.Require().Nil(response.ReplacedBy)
+	s.Require().Equal([]string{"u4_Mike"}, response.AssignedReviewers)
+
+	res := s.post("/pullRequest/reassign", `{"pull_request_id": "pr_opened_id", "old_reviewer_id": "u4_Mike"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+	JSONEq(s.T(), `
+{
+  "error": {
+    "code": "NO_CANDIDATE",
+    "message": "no active replacement candidate in team"
+  },
+  "request_id": "test-request-id"
+}`, res.Body)
+
+	res, err := s.server.Client().Get(
+		s.server.URL + "/stats/reviewers/declines?team_name=payments" +
+			"&from=2024-01-15T00:00:00Z&to=2024-01-16T00:00:00Z",
+	)
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `
+{
+  "from": "2024-01-15T00:00:00Z",
+  "to": "2024-01-16T00:00:00Z",
+  "team_name": "payments",
+  "reviewers": [
+    {
+      "user_id": "u3_John", "username": "John", "assignments": 1, "declines": 1, "decline_rate": 1,
+      "reasons": {"UNAVAILABLE": 1}
+    },
+    {
+      "user_id": "u2_Bob", "username": "Bob", "assignments": 2, "declines": 1, "decline_rate": 0.5,
+      "reasons": {"NO_CONTEXT": 1}
+    },
+    {"user_id": "u4_Mike", "username": "Mike", "assignments": 2, "declines": 0, "decline_rate": 0, "reasons": {}}
+  ]
+}`, res.Body)
+}
+
+func (s *PullRequestDeclineSuite) TestDeclineInvalid() {
+	testCases := []struct {
+		name         string
+		requestBody  string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "not_assigned",
+			requestBody:  `{"pull_request_id": "pr_opened_id", "reviewer_id": "u4_Mike", "reason": "OTHER"}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "NOT_ASSIGNED",
+    "message": "reviewer is not assigned to this PR"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "merged",
+			requestBody:  `{"pull_request_id": "pr_merged_id", "reviewer_id": "u2_Bob", "reason": "OTHER"}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "PR_MERGED",
+    "message": "cannot reassign on merged PR"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "unknown_pull_request",
+			requestBody:  `{"pull_request_id": "unknown", "reviewer_id": "u2_Bob", "reason": "OTHER"}`,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `
+{
+  "error": {
+    "code": "NOT_FOUND",
+    "message": "resource not found"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "unknown_reason",
+			requestBody:  `{"pull_request_id": "pr_opened_id", "reviewer_id": "u2_Bob", "reason": "BORED"}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `
+{
+  "error": {
+    "code": "INVALID_BODY",
+    "message": "invalid request body"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.post("/pullRequest/decline", tc.requestBody)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+			JSONEq(s.T(), tc.expectedBody, res.Body)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	prHandler "reviewer-assigner/internal/http/handlers/pullrequests"
+	usersHandler "reviewer-assigner/internal/http/handlers/users"
+	"strings"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type PullRequestImportSuite struct {
+	BaseSuite
+}
+
+func (s *PullRequestImportSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *PullRequestImportSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *PullRequestImportSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/pull_request_import"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestPullRequestImportSuite_Run(t *testing.T) {
+	suite.Run(t, new(PullRequestImportSuite))
+}
+
+func (s *PullRequestImportSuite) importFile(contentType, body string) *prHandler.ImportPullRequestsResponse {
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/import", contentType, bytes.NewBufferString(body))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response prHandler.ImportPullRequestsResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+
+	return &response
+}
+
+func (s *PullRequestImportSuite) getReview(userID string) *usersHandler.GetReviewResponse {
+	res, err := s.server.Client().Get(s.server.URL + "/users/getReview?user_id=" + userID)
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response usersHandler.GetReviewResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+
+	return &response
+}
+
+func (s *PullRequestImportSuite) TestImportNDJSON() {
+	body := strings.Join([]string{
+		`{"pull_request_id": "pr_old_1", "pull_request_name": "Old search", "author_id": "u1_Alice",` +
+			` "status": "MERGED", "reviewer_ids": ["u2_Bob", "u3_John"],` +
+			` "created_at": "2023-05-01T09:00:00Z", "merged_at": "2023-05-02T18:00:00Z"}`,
+		`{"pull_request_id": "pr_old_2", "pull_request_name": "Old alerts", "author_id": "u1_Alice",` +
+			` "team_name": "infra", "status": "OPEN", "reviewer_ids": ["infra_Ivan"],` +
+			` "created_at": "2023-06-01T09:00:00Z"}`,
+		``,
+		`{"pull_request_id": "pr_existing", "pull_request_name": "Again", "author_id": "u1_Alice",` +
+			` "status": "OPEN", "created_at": "2023-06-01T09:00:00Z"}`,
+		`{"pull_request_id": "pr_old_5", "pull_request_name": "Unknown", "author_id": "u9_Nobody",` +
+			` "status": "OPEN", "created_at": "2023-06-01T09:00:00Z"}`,
+		`{"pull_request_id": "pr_old_6", "pull_request_name": "Closed", "author_id": "u1_Alice",` +
+			` "status": "CLOSED", "created_at": "2023-06-01T09:00:00Z"}`,
+		`{"pull_request_id": "pr_old_7", "pull_request_name": "Never merged", "author_id": "u1_Alice",` +
+			` "status": "MERGED", "created_at": "2023-06-01T09:00:00Z"}`,
+		`not json`,
+	}, "\n")
+
+	response := s.importFile("application/x-ndjson", body)
+
+	expected := `
+{
+  "imported": 2,
+  "errors": [
+    {"line": 4, "pull_request_id": "pr_existing", "message": "PR pr_existing already exists"},
+    {"line": 5, "pull_request_id": "pr_old_5", "message": "unknown author u9_Nobody"},
+    {"line": 6, "pull_request_id": "pr_old_6", "message": "invalid status"},
+    {
+      "line": 7,
+      "pull_request_id": "pr_old_7",
+      "message": "invalid pull request record: merged_at must be set for MERGED PRs only"
+    },
+    {"line": 8, "message": "invalid JSON"}
+  ]
+}
+`
+	JSONEq(s.T(), expected, response)
+
+	// John is inactive, but keeps his review from history; the PR went to Alice's primary team
+	expectedReview := `
+{
+  "user_id": "u3_John",
+  "pull_requests": [
+    {
+      "pull_request_id": "pr_old_1",
+      "pull_request_name": "Old search",
+      "author_id": "u1_Alice",
+      "team_name": "payments",
+      "status": "MERGED"
+    }
+  ]
+}
+`
+	JSONEq(s.T(), expectedReview, s.getReview("u3_John"))
+
+	expectedReview = `
+{
+  "user_id": "infra_Ivan",
+  "pull_requests": [
+    {
+      "pull_request_id": "pr_old_2",
+      "pull_request_name": "Old alerts",
+      "author_id": "u1_Alice",
+      "team_name": "infra",
+      "status": "OPEN"
+    }
+  ]
+}
+`
+	JSONEq(s.T(), expectedReview, s.getReview("infra_Ivan"))
+}
+
+func (s *PullRequestImportSuite) TestImportCSV() {
+	body := `pull_request_id,pull_request_name,author_id,team_name,status,reviewer_ids,created_at,merged_at
+pr_old_1,Old search,u1_Alice,,MERGED,u2_Bob;u3_John,2023-05-01T09:00:00Z,2023-05-02T18:00:00Z
+pr_old_2,Old alerts,u1_Alice,frontend,OPEN,,2023-06-01T09:00:00Z,
+pr_old_3,Old billing,u1_Alice,,OPEN,u1_Alice,2023-06-01T09:00:00Z,
+pr_old_4,Old docs,u1_Alice,,OPEN,,yesterday,
+`
+
+	response := s.importFile("text/csv", body)
+
+	expected := `
+{
+  "imported": 1,
+  "errors": [
+    {"line": 3, "pull_request_id": "pr_old_2", "message": "unknown team frontend"},
+    {
+      "line": 4,
+      "pull_request_id": "pr_old_3",
+      "message": "invalid pull request record: author cannot review their own PR"
+    },
+    {"line": 5, "pull_request_id": "pr_old_4", "message": "invalid created_at"}
+  ]
+}
+`
+	JSONEq(s.T(), expected, response)
+
+	s.Require().Len(s.getReview("u2_Bob").PullRequests, 1)
+}
+
+func (s *PullRequestImportSuite) TestImportCSVWithoutRequiredColumns() {
+	res, err := s.server.Client().Post(
+		s.server.URL+"/pullRequest/import",
+		"text/csv",
+		bytes.NewBufferString("pull_request_id,author_id\npr_old_1,u1_Alice\n"),
+	)
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusUnprocessableEntity, res.StatusCode)
+}
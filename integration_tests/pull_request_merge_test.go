@@ -125,7 +125,8 @@ func (s *PullRequestMergeSuite) TestNotFound() {
   "error": {
     "code": "NOT_FOUND",
     "message": "resource not found"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 	JSONEq(s.T(), expected, response)
@@ -107,7 +107,8 @@ func (s *PullRequestReassignSuite) TestNotFound() {
   "error": {
     "code": "NOT_FOUND",
     "message": "resource not found"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
@@ -193,7 +194,8 @@ func (s *PullRequestReassignSuite) TestMerged() {
   "error": {
     "code": "PR_MERGED",
     "message": "cannot reassign on merged PR"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
@@ -225,7 +227,8 @@ func (s *PullRequestReassignSuite) TestNotAssigned() {
   "error": {
     "code": "NOT_ASSIGNED",
     "message": "reviewer is not assigned to this PR"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
@@ -257,7 +260,8 @@ func (s *PullRequestReassignSuite) TestNoCandidates() {
   "error": {
     "code": "NO_CANDIDATE",
     "message": "no active replacement candidate in team"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
@@ -0,0 +1,189 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	prHandler "reviewer-assigner/internal/http/handlers/pullrequests"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type PullRequestSetReviewersSuite struct {
+	BaseSuite
+
+	fixtures *testfixtures.Loader
+}
+
+func (s *PullRequestSetReviewersSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *PullRequestSetReviewersSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *PullRequestSetReviewersSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	s.fixtures, err = testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/pull_request_set_reviewers"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.fixtures.Load())
+}
+
+func TestPullRequestSetReviewersSuite_Run(t *testing.T) {
+	suite.Run(t, new(PullRequestSetReviewersSuite))
+}
+
+func (s *PullRequestSetReviewersSuite) post(body string) *http.Response {
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/setReviewers", "", bytes.NewBufferString(body))
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *PullRequestSetReviewersSuite) TestSetReviewers() {
+	testCases := []struct {
+		name              string
+		requestBody       string
+		expectedReviewers []string
+	}{
+		{
+			name:              "replace",
+			requestBody:       `{"pull_request_id": "pr_opened_id", "add": ["u4_Mike"], "remove": ["u3_John"]}`,
+			expectedReviewers: []string{"u2_Bob", "u4_Mike"},
+		},
+		{
+			name:              "add_assigned",
+			requestBody:       `{"pull_request_id": "pr_opened_id", "add": ["u2_Bob"]}`,
+			expectedReviewers: []string{"u2_Bob", "u3_John"},
+		},
+		{
+			name:              "remove_all",
+			requestBody:       `{"pull_request_id": "pr_opened_id", "remove": ["u2_Bob", "u3_John"]}`,
+			expectedReviewers: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().NoError(s.fixtures.Load())
+
+			res := s.post(tc.requestBody)
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusOK, res.StatusCode)
+
+			var response prHandler.SetReviewersResponse
+			s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+			s.Require().ElementsMatch(tc.expectedReviewers, response.AssignedReviewers)
+		})
+	}
+}
+
+func (s *PullRequestSetReviewersSuite) TestSetReviewersInvalid() {
+	testCases := []struct {
+		name         string
+		requestBody  string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "other_team",
+			requestBody:  `{"pull_request_id": "pr_opened_id", "add": ["infra_Ivan"]}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `
+{
+  "error": {
+    "code": "REVIEWER_INVALID",
+    "message": "user infra_Ivan cannot review this PR"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "declined",
+			requestBody:  `{"pull_request_id": "pr_declined_id", "add": ["u4_Mike"]}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `
+{
+  "error": {
+    "code": "REVIEWER_INVALID",
+    "message": "user u4_Mike cannot review this PR"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "not_assigned",
+			requestBody:  `{"pull_request_id": "pr_opened_id", "remove": ["u4_Mike"]}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "NOT_ASSIGNED",
+    "message": "reviewer is not assigned to this PR"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "merged",
+			requestBody:  `{"pull_request_id": "pr_merged_id", "add": ["u3_John"]}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "PR_MERGED",
+    "message": "cannot reassign on merged PR"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "unknown_pull_request",
+			requestBody:  `{"pull_request_id": "unknown", "add": ["u3_John"]}`,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `
+{
+  "error": {
+    "code": "NOT_FOUND",
+    "message": "resource not found"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "nothing_to_change",
+			requestBody:  `{"pull_request_id": "pr_opened_id"}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `
+{
+  "error": {
+    "code": "INVALID_BODY",
+    "message": "invalid request body"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.post(tc.requestBody)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+			JSONEq(s.T(), tc.expectedBody, res.Body)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package integration_tests
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net/http"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/ratelimit"
+	"reviewer-assigner/internal/storage/postgres"
+	rateLimitRepo "reviewer-assigner/internal/storage/ratelimit"
+	"strconv"
+	"testing"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitSuite struct {
+	BaseSuite
+
+	db *sql.DB
+}
+
+func (s *RateLimitSuite) SetupSuite() {
+	s.rateLimitCfg = config.RateLimit{
+		Backend: ratelimit.BackendPostgres,
+		Default: config.RateLimitRule{RPS: 0},
+		Groups: map[string]config.RateLimitRule{
+			"pullRequest": {RPS: 0.01, Burst: 2},
+		},
+	}
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *RateLimitSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *RateLimitSuite) SetupTest() {
+	var err error
+	s.db, err = sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(s.db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/pull_request_create"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+
+	_, err = s.db.ExecContext(context.Background(), "TRUNCATE rate_limit_buckets")
+	s.Require().NoError(err)
+}
+
+func TestRateLimitSuite_Run(t *testing.T) {
+	suite.Run(t, new(RateLimitSuite))
+}
+
+func (s *RateLimitSuite) createPullRequest(id string) *http.Response {
+	return s.createPullRequestFrom(id, "")
+}
+
+// createPullRequestFrom sends forwardedFor as X-Forwarded-For unless empty.
+func (s *RateLimitSuite) createPullRequestFrom(id, forwardedFor string) *http.Response {
+	requestBody := `
+{
+  "pull_request_id": "` + id + `",
+  "pull_request_name": "Add search",
+  "author_id": "u1_Alice"
+}
+`
+
+	req, err := http.NewRequest(http.MethodPost, s.server.URL+"/pullRequest/create", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+
+	res, err := s.server.Client().Do(req)
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *RateLimitSuite) TestLimitExceeded() {
+	for _, id := range []string{"pr-1001", "pr-1002"} {
+		res := s.createPullRequest(id)
+		res.Body.Close()
+		s.Require().Equal(http.StatusCreated, res.StatusCode)
+	}
+
+	res := s.createPullRequest("pr-1003")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusTooManyRequests, res.StatusCode)
+	s.Equal("100", res.Header.Get("Retry-After"))
+
+	expected := `
+{
+  "error": {
+    "code": "RATE_LIMITED",
+    "message": "rate limit exceeded, retry in 100 s"
+  },
+  "request_id": "test-request-id"
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+
+	var key string
+	err := s.db.QueryRowContext(context.Background(), "SELECT bucket_key FROM rate_limit_buckets").Scan(&key)
+	s.Require().NoError(err)
+	s.Equal("pullRequest|ip:127.0.0.1", key)
+}
+
+func (s *RateLimitSuite) TestForwardedForIgnored() {
+	for i, id := range []string{"pr-1001", "pr-1002", "pr-1003"} {
+		res := s.createPullRequestFrom(id, "10.0.0."+strconv.Itoa(i))
+		res.Body.Close()
+
+		if i < 2 {
+			s.Require().Equal(http.StatusCreated, res.StatusCode)
+		} else {
+			s.Require().Equal(http.StatusTooManyRequests, res.StatusCode)
+		}
+	}
+
+	var key string
+	err := s.db.QueryRowContext(context.Background(), "SELECT bucket_key FROM rate_limit_buckets").Scan(&key)
+	s.Require().NoError(err)
+	s.Equal("pullRequest|ip:127.0.0.1", key)
+}
+
+func (s *RateLimitSuite) TestIdleBucketsSwept() {
+	_, err := s.db.ExecContext(context.Background(), `
+	INSERT INTO rate_limit_buckets (bucket_key, tokens, updated_at, full_at) VALUES
+	    ('pullRequest|ip:10.0.0.1', 0, now() - interval '2 hours', now() - interval '1 hour'),
+	    ('pullRequest|ip:10.0.0.2', 0, now(), now() + interval '1 hour')
+	`)
+	s.Require().NoError(err)
+
+	pool, err := postgres.NewPool(context.Background(), s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer pool.Close()
+
+	limiter := rateLimitRepo.NewPostgresLimiter(pool, trmpgx.DefaultCtxGetter)
+	s.Require().NoError(limiter.Sweep(context.Background()))
+
+	var keys []string
+	rows, err := s.db.QueryContext(context.Background(), "SELECT bucket_key FROM rate_limit_buckets")
+	s.Require().NoError(err)
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		s.Require().NoError(rows.Scan(&key))
+		keys = append(keys, key)
+	}
+	s.Require().NoError(rows.Err())
+	s.Equal([]string{"pullRequest|ip:10.0.0.2"}, keys)
+}
+
+func (s *RateLimitSuite) TestOtherGroupsNotLimited() {
+	for range 5 {
+		res, err := s.server.Client().Get(s.server.URL + "/team/get?team_name=backend")
+		s.Require().NoError(err)
+		res.Body.Close()
+		s.Require().NotEqual(http.StatusTooManyRequests, res.StatusCode)
+	}
+}
@@ -0,0 +1,706 @@
+package integration_tests
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net/http"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/domain/access"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type RBACSuite struct {
+	BaseSuite
+
+	fixtures *testfixtures.Loader
+	tokens   map[string]string
+}
+
+const (
+	roleAdmin          = "admin"
+	roleTeamAdmin      = "payments_team_admin"
+	roleOtherTeamAdmin = "infra_team_admin"
+	roleMember         = "payments_member"
+)
+
+func (s *RBACSuite) SetupSuite() {
+	s.authCfg.Mode = config.AuthModeToken
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *RBACSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *RBACSuite) SetupTest() {
+	s.BaseSuite.SetupTest()
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	s.fixtures, err = testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/rbac"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.fixtures.Load())
+
+	actors := map[string]struct {
+		userID string
+		role   access.Role
+	}{
+		roleAdmin:          {userID: "root", role: access.RoleAdmin},
+		roleTeamAdmin:      {userID: "u1_Alice", role: access.RoleTeamAdmin},
+		roleOtherTeamAdmin: {userID: "infra_Ivan", role: access.RoleTeamAdmin},
+		roleMember:         {userID: "u2_Bob", role: access.RoleMember},
+	}
+
+	s.tokens = make(map[string]string, len(actors))
+	for name, actor := range actors {
+		_, plain, err := s.tokenService.Issue(context.Background(), actor.userID, actor.role, access.Scopes())
+		s.Require().NoError(err)
+		s.tokens[name] = plain
+	}
+}
+
+func TestRBACSuite_Run(t *testing.T) {
+	suite.Run(t, new(RBACSuite))
+}
+
+func (s *RBACSuite) TestMatrix() {
+	testCases := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		expected map[string]int
+	}{
+		{
+			name:   "update_team",
+			method: http.MethodPost,
+			path:   "/team/add",
+			body: `
+{
+  "team_name": "payments",
+  "members": [
+    {"user_id": "u1_Alice", "username": "Alice", "is_active": true},
+    {"user_id": "u2_Bob", "username": "Bob", "is_active": true},
+    {"user_id": "u3_John", "username": "John", "is_active": true},
+    {"user_id": "u4_Mike", "username": "Mike", "is_active": false}
+  ]
+}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusCreated,
+				roleTeamAdmin:      http.StatusCreated,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "create_team",
+			method: http.MethodPost,
+			path:   "/team/add",
+			body: `
+{
+  "team_name": "mobile",
+  "members": [{"user_id": "mobile_Olga", "username": "Olga", "is_active": true}]
+}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusCreated,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "get_team",
+			method: http.MethodGet,
+			path:   "/team/get?team_name=payments",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "add_rule",
+			method: http.MethodPost,
+			path:   "/team/addRule",
+			body: `
+{
+  "team_name": "payments",
+  "kind": "CONFLICT_OF_INTEREST",
+  "user_id": "u3_John",
+  "other_user_id": "u4_Mike"
+}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusCreated,
+				roleTeamAdmin:      http.StatusCreated,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "remove_rule",
+			method: http.MethodPost,
+			path:   "/team/removeRule",
+			body: `
+{
+  "team_name": "payments",
+  "kind": "CONFLICT_OF_INTEREST",
+  "user_id": "u2_Bob",
+  "other_user_id": "u3_John"
+}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "get_rules",
+			method: http.MethodGet,
+			path:   "/team/getRules?team_name=payments",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "add_review_rule",
+			method: http.MethodPost,
+			path:   "/team/addReviewRule",
+			body:   `{"team_name": "payments", "min_lines_changed": 500, "reviewers_count": 3}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusCreated,
+				roleTeamAdmin:      http.StatusCreated,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "remove_review_rule",
+			method: http.MethodPost,
+			path:   "/team/removeReviewRule",
+			body:   `{"team_name": "payments", "label": "security", "reviewers_count": 3}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "get_review_rules",
+			method: http.MethodGet,
+			path:   "/team/getReviewRules?team_name=payments",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "set_required_group",
+			method: http.MethodPost,
+			path:   "/team/setRequiredGroup",
+			body:   `{"team_name": "payments", "path_prefixes": ["billing/"]}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "remove_required_group",
+			method: http.MethodPost,
+			path:   "/team/removeRequiredGroup",
+			body:   `{"team_name": "infra"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "get_required_groups",
+			method: http.MethodGet,
+			path:   "/team/requiredGroups",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "add_member",
+			method: http.MethodPost,
+			path:   "/team/addMember",
+			body:   `{"team_name": "payments", "user_id": "infra_Azat", "username": "Azat", "is_active": true}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusCreated,
+				roleTeamAdmin:      http.StatusCreated,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "remove_member",
+			method: http.MethodPost,
+			path:   "/team/removeMember",
+			body:   `{"team_name": "payments", "user_id": "u4_Mike", "open_reviews": "KEEP"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "move_member",
+			method: http.MethodPost,
+			path:   "/team/moveMember",
+			body: `
+{
+  "user_id": "u4_Mike",
+  "team_name": "payments",
+  "to_team_name": "infra",
+  "open_reviews": "KEEP"
+}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "rename_team",
+			method: http.MethodPost,
+			path:   "/team/rename",
+			body:   `{"team_name": "payments", "new_team_name": "billing"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "delete_team",
+			method: http.MethodPost,
+			path:   "/team/delete",
+			body:   `{"team_name": "archive"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			// a team admin passes the access check for its own team, which still has members
+			name:   "delete_own_team",
+			method: http.MethodPost,
+			path:   "/team/delete",
+			body:   `{"team_name": "payments"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusConflict,
+				roleTeamAdmin:      http.StatusConflict,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "sync_team_dry_run",
+			method: http.MethodPut,
+			path:   "/team/sync?dry_run=true",
+			body:   `{"team_name": "payments", "members": []}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "sync_team",
+			method: http.MethodPut,
+			path:   "/team/sync",
+			body: `
+{
+  "team_name": "payments",
+  "members": [
+    {"user_id": "u1_Alice", "username": "Alice", "is_active": true},
+    {"user_id": "u2_Bob", "username": "Bob", "is_active": true},
+    {"user_id": "u3_John", "username": "John", "is_active": true}
+  ]
+}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "set_parent",
+			method: http.MethodPost,
+			path:   "/team/setParent",
+			body:   `{"team_name": "payments", "parent_team_name": "infra"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "set_parent_top_level",
+			method: http.MethodPost,
+			path:   "/team/setParent",
+			body:   `{"team_name": "payments", "parent_team_name": null}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "team_tree",
+			method: http.MethodGet,
+			path:   "/team/tree",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "set_is_active",
+			method: http.MethodPost,
+			path:   "/users/setIsActive",
+			body:   `{"user_id": "u4_Mike", "is_active": false}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "get_review",
+			method: http.MethodGet,
+			path:   "/users/getReview?user_id=u2_Bob",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "get_user",
+			method: http.MethodGet,
+			path:   "/users/get?user_id=u2_Bob",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "list_users",
+			method: http.MethodGet,
+			path:   "/users/list",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "search_users",
+			method: http.MethodGet,
+			path:   "/users/search?query=b",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "create_pull_request",
+			method: http.MethodPost,
+			path:   "/pullRequest/create",
+			body:   `{"pull_request_id": "pr_new", "pull_request_name": "New", "author_id": "u3_John"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusCreated,
+				roleTeamAdmin:      http.StatusCreated,
+				roleOtherTeamAdmin: http.StatusCreated,
+				roleMember:         http.StatusCreated,
+			},
+		},
+		{
+			name:   "merge_pull_request",
+			method: http.MethodPost,
+			path:   "/pullRequest/merge",
+			body:   `{"pull_request_id": "pr_payments"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "reassign_self",
+			method: http.MethodPost,
+			path:   "/pullRequest/reassign",
+			body:   `{"pull_request_id": "pr_payments", "old_reviewer_id": "u2_Bob"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "reassign_other",
+			method: http.MethodPost,
+			path:   "/pullRequest/reassign",
+			body:   `{"pull_request_id": "pr_payments", "old_reviewer_id": "u3_John"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "set_reviewers",
+			method: http.MethodPost,
+			path:   "/pullRequest/setReviewers",
+			body:   `{"pull_request_id": "pr_payments", "add": ["u4_Mike"]}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "decline",
+			method: http.MethodPost,
+			path:   "/pullRequest/decline",
+			body:   `{"pull_request_id": "pr_payments", "reviewer_id": "u2_Bob", "reason": "NO_CONTEXT"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusForbidden,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "approve",
+			method: http.MethodPost,
+			path:   "/pullRequest/approve",
+			body:   `{"pull_request_id": "pr_payments", "reviewer_id": "u2_Bob"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusForbidden,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "import",
+			method: http.MethodPost,
+			path:   "/pullRequest/import",
+			body: `{"pull_request_id": "pr_old", "pull_request_name": "Old PR", "author_id": "u1_Alice",` +
+				` "status": "OPEN", "reviewer_ids": ["u2_Bob"], "created_at": "2023-05-01T09:00:00Z"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "simulate",
+			method: http.MethodPost,
+			path:   "/assignment/simulate",
+			body:   `{"team_name": "payments", "strategy": "round_robin"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "stats_assignments",
+			method: http.MethodGet,
+			path:   "/stats/reviewers/assignments",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "stats_load",
+			method: http.MethodGet,
+			path:   "/stats/reviewers/load",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "stats_timeseries",
+			method: http.MethodGet,
+			path:   "/stats/pullRequests/timeseries",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "stats_declines",
+			method: http.MethodGet,
+			path:   "/stats/reviewers/declines",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "export_pull_requests",
+			method: http.MethodGet,
+			path:   "/export/pullRequests",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "export_assignments",
+			method: http.MethodGet,
+			path:   "/export/assignments",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "export_teams",
+			method: http.MethodGet,
+			path:   "/export/teams",
+			expected: map[string]int{
+				roleAdmin:          http.StatusOK,
+				roleTeamAdmin:      http.StatusOK,
+				roleOtherTeamAdmin: http.StatusOK,
+				roleMember:         http.StatusOK,
+			},
+		},
+		{
+			name:   "issue_token",
+			method: http.MethodPost,
+			path:   "/tokens/issue",
+			body:   `{"actor": "u3_John", "role": "admin", "scopes": ["tokens:write"]}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusCreated,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+		{
+			name:   "revoke_token",
+			method: http.MethodPost,
+			path:   "/tokens/revoke",
+			body:   `{"token_id": "unknown"}`,
+			expected: map[string]int{
+				roleAdmin:          http.StatusNotFound,
+				roleTeamAdmin:      http.StatusForbidden,
+				roleOtherTeamAdmin: http.StatusForbidden,
+				roleMember:         http.StatusForbidden,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		for role, expectedStatus := range tc.expected {
+			s.Run(tc.name+"/"+role, func() {
+				s.Require().NoError(s.fixtures.Load())
+
+				req, err := http.NewRequest(tc.method, s.server.URL+tc.path, bytes.NewBufferString(tc.body))
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", "Bearer "+s.tokens[role])
+
+				res, err := s.server.Client().Do(req)
+				s.Require().NoError(err)
+				defer res.Body.Close()
+
+				s.Require().Equal(expectedStatus, res.StatusCode)
+			})
+		}
+	}
+}
+
+func (s *RBACSuite) TestForbiddenResponse() {
+	req, err := http.NewRequest(
+		http.MethodPost,
+		s.server.URL+"/users/setIsActive",
+		bytes.NewBufferString(`{"user_id": "u4_Mike", "is_active": false}`),
+	)
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer "+s.tokens[roleMember])
+
+	res, err := s.server.Client().Do(req)
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusForbidden, res.StatusCode)
+
+	expected := `
+{
+  "error": {
+    "code": "FORBIDDEN",
+    "message": "not allowed for the caller's role"
+  },
+  "request_id": "test-request-id"
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+}
@@ -0,0 +1,59 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/http/middleware"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RequestIDSuite struct {
+	BaseSuite
+}
+
+func (s *RequestIDSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *RequestIDSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func TestRequestIDSuite_Run(t *testing.T) {
+	suite.Run(t, new(RequestIDSuite))
+}
+
+func (s *RequestIDSuite) TestProvided() {
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+"/team/get?team_name=unknown", nil)
+	s.Require().NoError(err)
+	req.Header.Set(middleware.RequestIDHeader, "client-id-1")
+
+	res, err := s.server.Client().Do(req)
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+	s.Equal("client-id-1", res.Header.Get(middleware.RequestIDHeader))
+
+	var response handlers.ErrorResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+	s.Equal("client-id-1", response.RequestID)
+}
+
+func (s *RequestIDSuite) TestGenerated() {
+	res, err := http.Get(s.server.URL + "/team/get?team_name=unknown")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+
+	requestID := res.Header.Get(middleware.RequestIDHeader)
+	s.Require().NotEmpty(requestID)
+
+	var response handlers.ErrorResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+	s.Equal(requestID, response.RequestID)
+}
@@ -0,0 +1,190 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	prHandler "reviewer-assigner/internal/http/handlers/pullrequests"
+	teamsHandler "reviewer-assigner/internal/http/handlers/teams"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type RequiredGroupsSuite struct {
+	BaseSuite
+}
+
+func (s *RequiredGroupsSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *RequiredGroupsSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *RequiredGroupsSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/required_reviewer_groups"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestRequiredGroupsSuite_Run(t *testing.T) {
+	suite.Run(t, new(RequiredGroupsSuite))
+}
+
+func (s *RequiredGroupsSuite) post(path, body string) *http.Response {
+	res, err := s.server.Client().Post(s.server.URL+path, "", bytes.NewBufferString(body))
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *RequiredGroupsSuite) requireError(res *http.Response, expectedStatus int, expectedCode handlers.ErrCode) {
+	defer res.Body.Close()
+
+	s.Require().Equal(expectedStatus, res.StatusCode)
+
+	var errorResp handlers.ErrorResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&errorResp))
+	s.Require().Equal(expectedCode, errorResp.Error.Code)
+}
+
+func (s *RequiredGroupsSuite) TestGetRequiredGroups() {
+	res, err := s.server.Client().Get(s.server.URL + "/team/requiredGroups")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response teamsHandler.GetRequiredGroupsResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+
+	expected := `
+{
+  "groups": [
+    {
+      "team_name": "compliance",
+      "labels": ["pci"],
+      "path_prefixes": ["billing/"]
+    }
+  ]
+}
+`
+
+	JSONEq(s.T(), expected, response)
+}
+
+func (s *RequiredGroupsSuite) TestSetAndRemoveRequiredGroup() {
+	res := s.post("/team/setRequiredGroup", `{"team_name": "compliance", "labels": ["gdpr"]}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	res = s.post("/team/setRequiredGroup", `{"team_name": "compliance"}`)
+	s.requireError(res, http.StatusUnprocessableEntity, handlers.ErrCodeTeamRuleInvalid)
+
+	res = s.post("/team/setRequiredGroup", `{"team_name": "frontend", "labels": ["ui"]}`)
+	s.requireError(res, http.StatusNotFound, handlers.ErrCodeResourceNotFound)
+
+	res = s.post("/team/removeRequiredGroup", `{"team_name": "compliance"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	res = s.post("/team/removeRequiredGroup", `{"team_name": "compliance"}`)
+	s.requireError(res, http.StatusNotFound, handlers.ErrCodeResourceNotFound)
+}
+
+func (s *RequiredGroupsSuite) TestCreateWithRequiredGroup() {
+	res := s.post("/pullRequest/create", `
+{
+  "pull_request_id": "pr-1001",
+  "pull_request_name": "Add refunds",
+  "author_id": "u1_Alice",
+  "paths": ["billing/refund.go"]
+}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	var response prHandler.CreatePullRequestResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+
+	// Bob and John fill the team slots, Carol the compliance slot on top
+	s.Require().ElementsMatch([]string{"u2_Bob", "u3_John", "cmp_Carol"}, response.AssignedReviewers)
+	s.Require().Equal(map[string]string{"cmp_Carol": "compliance"}, response.GroupReviewers)
+
+	res = s.post("/pullRequest/create", `
+{
+  "pull_request_id": "pr-1002",
+  "pull_request_name": "Fix typo",
+  "author_id": "u1_Alice",
+  "paths": ["docs/README.md"]
+}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	response = prHandler.CreatePullRequestResponse{}
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+
+	s.Require().ElementsMatch([]string{"u2_Bob", "u3_John"}, response.AssignedReviewers)
+	s.Require().Empty(response.GroupReviewers)
+}
+
+func (s *RequiredGroupsSuite) TestMergeRequiresGroupApproval() {
+	res := s.post("/pullRequest/merge", `{"pull_request_id": "pr_billing"}`)
+	s.requireError(res, http.StatusConflict, handlers.ErrCodeApprovalRequired)
+
+	// approval of the team reviewer does not count for the group
+	res = s.post("/pullRequest/approve", `{"pull_request_id": "pr_billing", "reviewer_id": "u2_Bob"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response prHandler.ApprovePullRequestResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+	s.Require().Equal([]string{"compliance"}, response.PendingGroups)
+
+	res = s.post("/pullRequest/approve", `{"pull_request_id": "pr_billing", "reviewer_id": "u3_John"}`)
+	s.requireError(res, http.StatusConflict, handlers.ErrCodePullRequestNotAssigned)
+
+	res = s.post("/pullRequest/approve", `{"pull_request_id": "pr_billing", "reviewer_id": "cmp_Carol"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	response = prHandler.ApprovePullRequestResponse{}
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+	s.Require().Empty(response.PendingGroups)
+	s.Require().ElementsMatch([]string{"u2_Bob", "cmp_Carol"}, response.ApprovedReviewers)
+
+	res = s.post("/pullRequest/merge", `{"pull_request_id": "pr_billing"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+}
+
+func (s *RequiredGroupsSuite) TestGroupSlotCannotBeLeftEmpty() {
+	res := s.post("/pullRequest/setReviewers", `{"pull_request_id": "pr_billing", "remove": ["cmp_Carol"]}`)
+	s.requireError(res, http.StatusConflict, handlers.ErrCodeReviewerInGroupSlot)
+
+	// Carol is the only compliance member
+	res = s.post("/pullRequest/decline",
+		`{"pull_request_id": "pr_billing", "reviewer_id": "cmp_Carol", "reason": "UNAVAILABLE"}`)
+	s.requireError(res, http.StatusConflict, handlers.ErrCodePullRequestNoCandidate)
+
+	res = s.post("/pullRequest/reassign", `{"pull_request_id": "pr_billing", "old_reviewer_id": "cmp_Carol"}`)
+	s.requireError(res, http.StatusConflict, handlers.ErrCodePullRequestNoCandidate)
+}
@@ -0,0 +1,186 @@
+package integration_tests
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"reviewer-assigner/internal/app"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/service"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type StateSuite struct {
+	BaseSuite
+
+	cfg *config.Config
+}
+
+func (s *StateSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+
+	port, err := strconv.Atoi(s.psqlContainer.Config.MappedPort)
+	s.Require().NoError(err)
+
+	s.cfg = &config.Config{
+		DB: config.DB{
+			Host:     s.psqlContainer.Config.Host,
+			Port:     port,
+			User:     s.psqlContainer.Config.User,
+			Password: s.psqlContainer.Config.Password,
+			Name:     s.psqlContainer.Config.Database,
+			SslMode:  "disable",
+		},
+	}
+}
+
+func (s *StateSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *StateSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/state"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestStateSuite_Run(t *testing.T) {
+	suite.Run(t, new(StateSuite))
+}
+
+func (s *StateSuite) exportState() []byte {
+	var out bytes.Buffer
+	s.Require().NoError(app.RunExportState(context.Background(), s.cfg, slog.New(slog.DiscardHandler), nil, &out))
+
+	return out.Bytes()
+}
+
+func (s *StateSuite) importState(archive []byte) error {
+	return app.RunImportState(
+		context.Background(),
+		s.cfg,
+		slog.New(slog.DiscardHandler),
+		nil,
+		bytes.NewReader(archive),
+		io.Discard,
+	)
+}
+
+func (s *StateSuite) clearState() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+	TRUNCATE users, teams, team_members, team_rules, team_review_rules, required_reviewer_groups,
+		pull_requests, pull_request_reviewers, review_declines
+	RESTART IDENTITY CASCADE
+	`)
+	s.Require().NoError(err)
+}
+
+func (s *StateSuite) decode(archive []byte) app.ArchiveJSON {
+	var archiveJSON app.ArchiveJSON
+	s.Require().NoError(json.Unmarshal(archive, &archiveJSON))
+	archiveJSON.ExportedAt = time.Time{}
+
+	return archiveJSON
+}
+
+func (s *StateSuite) TestExportRestoreRoundTrip() {
+	archive := s.exportState()
+
+	exported := s.decode(archive)
+	s.Require().Len(exported.Users, 4)
+	s.Require().Len(exported.Teams, 3)
+	s.Require().Len(exported.PullRequests, 3)
+
+	expectedCart := `
+{
+  "pull_request_id": "pr_cart",
+  "pull_request_name": "Cart",
+  "author_id": "u3_John",
+  "team_name": "checkout",
+  "status": "OPEN",
+  "created_at": "2024-02-10T10:00:00Z",
+  "merged_at": null,
+  "reviewers": [
+    {"user_id": "sec_Sam", "group_team_name": "security", "approved_at": "2024-02-11T09:00:00Z"},
+    {"user_id": "u1_Alice", "group_team_name": "", "approved_at": null}
+  ],
+  "declines": [
+    {"reviewer_id": "u2_Bob", "reason": "UNAVAILABLE", "comment": "", "declined_at": "2024-02-10T12:00:00Z"}
+  ]
+}
+`
+	JSONEq(s.T(), expectedCart, exported.PullRequests[1])
+
+	expectedSecurity := `
+{
+  "team_name": "security",
+  "parent_team_name": "",
+  "members": [{"user_id": "sec_Sam", "is_primary": true}],
+  "rules": [],
+  "review_rules": [],
+  "required_group": {"labels": ["auth"], "path_prefixes": ["internal/auth/"]}
+}
+`
+	JSONEq(s.T(), expectedSecurity, exported.Teams[2])
+
+	s.clearState()
+	s.Require().NoError(s.importState(archive))
+
+	s.Require().Equal(exported, s.decode(s.exportState()))
+}
+
+func (s *StateSuite) TestImportIntoNonEmptyDatabase() {
+	archive := s.exportState()
+
+	s.Require().ErrorIs(s.importState(archive), service.ErrStateNotEmpty)
+}
+
+func (s *StateSuite) TestImportInvalidArchive() {
+	archive := s.decode(s.exportState())
+	s.clearState()
+
+	archive.Version++
+	s.Require().ErrorIs(s.importState(s.encode(archive)), service.ErrArchiveInvalid)
+
+	archive.Version--
+	archive.PullRequests[0].AuthorID = "u9_Nobody"
+	s.Require().ErrorIs(s.importState(s.encode(archive)), service.ErrArchiveInvalid)
+}
+
+func (s *StateSuite) TestImportIsTransactional() {
+	archive := s.decode(s.exportState())
+	s.clearState()
+
+	// a repeated review rule passes validation but breaks a unique constraint after the users are in
+	archive.Teams[0].ReviewRules = append(archive.Teams[0].ReviewRules, archive.Teams[0].ReviewRules[0])
+	s.Require().Error(s.importState(s.encode(archive)))
+
+	archive.Teams[0].ReviewRules = archive.Teams[0].ReviewRules[:2]
+	s.Require().NoError(s.importState(s.encode(archive)))
+}
+
+func (s *StateSuite) encode(archive app.ArchiveJSON) []byte {
+	data, err := json.Marshal(archive)
+	s.Require().NoError(err)
+
+	return data
+}
@@ -0,0 +1,145 @@
+package integration_tests
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatsGetPullRequestsTimeseriesSuite struct {
+	BaseSuite
+}
+
+func (s *StatsGetPullRequestsTimeseriesSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *StatsGetPullRequestsTimeseriesSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *StatsGetPullRequestsTimeseriesSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/stats_pull_requests_timeseries"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestStatsGetPullRequestsTimeseriesSuite_Run(t *testing.T) {
+	suite.Run(t, new(StatsGetPullRequestsTimeseriesSuite))
+}
+
+func (s *StatsGetPullRequestsTimeseriesSuite) TestTimeseries() {
+	testCases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:  "weekly overall",
+			query: "bucket=week&from=2024-01-01T00:00:00Z&to=2024-01-29T00:00:00Z",
+			expected: `
+{
+  "from": "2024-01-01T00:00:00Z",
+  "to": "2024-01-29T00:00:00Z",
+  "bucket": "week",
+  "team_name": null,
+  "points": [
+    {"bucket_start": "2024-01-01T00:00:00Z", "created": 0, "merged": 0, "median_time_to_merge_seconds": null},
+    {"bucket_start": "2024-01-08T00:00:00Z", "created": 3, "merged": 2, "median_time_to_merge_seconds": 18000},
+    {"bucket_start": "2024-01-15T00:00:00Z", "created": 2, "merged": 1, "median_time_to_merge_seconds": 86400},
+    {"bucket_start": "2024-01-22T00:00:00Z", "created": 0, "merged": 0, "median_time_to_merge_seconds": null}
+  ]
+}`,
+		},
+		{
+			name:  "weekly by team",
+			query: "bucket=week&team_name=payments&from=2024-01-08T00:00:00Z&to=2024-01-22T00:00:00Z",
+			expected: `
+{
+  "from": "2024-01-08T00:00:00Z",
+  "to": "2024-01-22T00:00:00Z",
+  "bucket": "week",
+  "team_name": "payments",
+  "points": [
+    {"bucket_start": "2024-01-08T00:00:00Z", "created": 0, "merged": 0, "median_time_to_merge_seconds": null},
+    {"bucket_start": "2024-01-15T00:00:00Z", "created": 1, "merged": 0, "median_time_to_merge_seconds": null}
+  ]
+}`,
+		},
+		{
+			name:  "daily",
+			query: "bucket=day&from=2024-01-10T00:00:00Z&to=2024-01-12T00:00:00Z",
+			expected: `
+{
+  "from": "2024-01-10T00:00:00Z",
+  "to": "2024-01-12T00:00:00Z",
+  "bucket": "day",
+  "team_name": null,
+  "points": [
+    {"bucket_start": "2024-01-10T00:00:00Z", "created": 2, "merged": 2, "median_time_to_merge_seconds": 18000},
+    {"bucket_start": "2024-01-11T00:00:00Z", "created": 0, "merged": 0, "median_time_to_merge_seconds": null}
+  ]
+}`,
+		},
+		{
+			name:  "monthly with partial first bucket",
+			query: "bucket=month&from=2023-12-15T00:00:00Z&to=2024-02-01T00:00:00Z",
+			expected: `
+{
+  "from": "2023-12-15T00:00:00Z",
+  "to": "2024-02-01T00:00:00Z",
+  "bucket": "month",
+  "team_name": null,
+  "points": [
+    {"bucket_start": "2023-12-01T00:00:00Z", "created": 0, "merged": 0, "median_time_to_merge_seconds": null},
+    {"bucket_start": "2024-01-01T00:00:00Z", "created": 5, "merged": 3, "median_time_to_merge_seconds": 28800}
+  ]
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.server.Client().
+				Get(s.server.URL + "/stats/pullRequests/timeseries?" + tc.query)
+			s.Require().NoError(err)
+
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusOK, res.StatusCode)
+
+			JSONEq(s.T(), tc.expected, res.Body)
+		})
+	}
+}
+
+func (s *StatsGetPullRequestsTimeseriesSuite) TestInvalidQuery() {
+	queries := []string{
+		"bucket=year",
+		"to=2024-01-01",
+		"from=2024-02-01T00:00:00Z&to=2024-01-01T00:00:00Z",
+		"bucket=day&from=2000-01-01T00:00:00Z&to=2024-01-01T00:00:00Z",
+	}
+
+	for _, query := range queries {
+		s.Run(query, func() {
+			res, err := s.server.Client().
+				Get(s.server.URL + "/stats/pullRequests/timeseries?" + query)
+			s.Require().NoError(err)
+
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusBadRequest, res.StatusCode)
+		})
+	}
+}
@@ -152,3 +152,30 @@ func (s *StatsGetReviewersAssignmentsSuite) TestOnlyActiveAndStatusCombination()
 		s.Require().Equal(expectAssignment.Count, assignment.Count)
 	}
 }
+
+func (s *StatsGetReviewersAssignmentsSuite) TestPagination() {
+	res, err := s.server.Client().
+		Get(s.server.URL + "/stats/reviewers/assignments?limit=1&offset=1")
+	s.Require().NoError(err)
+
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	response := statsHandler.GetStatsUserAssignmentsResponse{}
+	err = json.NewDecoder(res.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	// ties are ordered by user id
+	s.Require().Len(response.UserAssignments, 1)
+	s.Require().Equal("u5_backend_reviewer", response.UserAssignments[0].UserID)
+	s.Require().Equal(2, response.UserAssignments[0].Count)
+
+	res, err = s.server.Client().
+		Get(s.server.URL + "/stats/reviewers/assignments?limit=0")
+	s.Require().NoError(err)
+
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusBadRequest, res.StatusCode)
+}
@@ -0,0 +1,204 @@
+package integration_tests
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	statsHandler "reviewer-assigner/internal/http/handlers/stats"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+const statsReviewersLoadPeriod = "from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z"
+
+type StatsGetReviewersLoadSuite struct {
+	BaseSuite
+}
+
+func (s *StatsGetReviewersLoadSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *StatsGetReviewersLoadSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *StatsGetReviewersLoadSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/stats_reviewers_load"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestStatsGetReviewersLoadSuite_Run(t *testing.T) {
+	suite.Run(t, new(StatsGetReviewersLoadSuite))
+}
+
+func (s *StatsGetReviewersLoadSuite) getLoad(query string) *statsHandler.GetStatsReviewersLoadResponse {
+	res, err := s.server.Client().Get(s.server.URL + "/stats/reviewers/load?" + query)
+	s.Require().NoError(err)
+
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	response := &statsHandler.GetStatsReviewersLoadResponse{}
+	err = json.NewDecoder(res.Body).Decode(response)
+	s.Require().NoError(err)
+
+	return response
+}
+
+func (s *StatsGetReviewersLoadSuite) TestDefault() {
+	response := s.getLoad(statsReviewersLoadPeriod)
+
+	s.Require().Equal(2, response.Total)
+	s.Require().Equal(20, response.Limit)
+	s.Require().Len(response.Teams, 2)
+
+	backend := response.Teams[0]
+	s.Require().Equal("backend", backend.TeamName)
+
+	expectedBackend := `
+[
+  {
+    "user_id": "u1_Alice",
+    "username": "Alice",
+    "is_active": true,
+    "open_reviews": 0,
+    "assignments": 1,
+    "avg_time_to_merge_seconds": 86400
+  },
+  {
+    "user_id": "u2_Bob",
+    "username": "Bob",
+    "is_active": true,
+    "open_reviews": 2,
+    "assignments": 2,
+    "avg_time_to_merge_seconds": 7200
+  },
+  {
+    "user_id": "u3_John",
+    "username": "John",
+    "is_active": false,
+    "open_reviews": 0,
+    "assignments": 1,
+    "avg_time_to_merge_seconds": 7200
+  }
+]
+`
+	JSONEq(s.T(), expectedBackend, backend.Reviewers)
+
+	const delta = 1e-9
+	s.InDelta(1.0/6, backend.Fairness.Gini, delta)
+	s.Equal(2, backend.Fairness.MaxLoad)
+	s.Equal(1, backend.Fairness.MinLoad)
+
+	payments := response.Teams[1]
+	s.Require().Equal("payments", payments.TeamName)
+
+	expectedPayments := `
+[
+  {
+    "user_id": "u4_Kate",
+    "username": "Kate",
+    "is_active": true,
+    "open_reviews": 0,
+    "assignments": 0,
+    "avg_time_to_merge_seconds": null
+  },
+  {
+    "user_id": "u5_Lena",
+    "username": "Lena",
+    "is_active": true,
+    "open_reviews": 1,
+    "assignments": 1,
+    "avg_time_to_merge_seconds": null
+  }
+]
+`
+	JSONEq(s.T(), expectedPayments, payments.Reviewers)
+
+	s.InDelta(0.5, payments.Fairness.Gini, delta)
+	s.Equal(1, payments.Fairness.MaxLoad)
+	s.Equal(0, payments.Fairness.MinLoad)
+}
+
+func (s *StatsGetReviewersLoadSuite) TestPagination() {
+	response := s.getLoad(statsReviewersLoadPeriod + "&limit=1&offset=1")
+
+	s.Require().Equal(2, response.Total)
+	s.Require().Equal(1, response.Limit)
+	s.Require().Equal(1, response.Offset)
+	s.Require().Len(response.Teams, 1)
+	s.Require().Equal("payments", response.Teams[0].TeamName)
+}
+
+func (s *StatsGetReviewersLoadSuite) TestTeamAndPeriod() {
+	response := s.getLoad("team_name=backend&from=2024-01-11T00:00:00Z&to=2024-01-13T00:00:00Z")
+
+	s.Require().Equal(1, response.Total)
+	s.Require().Len(response.Teams, 1)
+
+	expected := `
+[
+  {
+    "user_id": "u1_Alice",
+    "username": "Alice",
+    "is_active": true,
+    "open_reviews": 0,
+    "assignments": 0,
+    "avg_time_to_merge_seconds": null
+  },
+  {
+    "user_id": "u2_Bob",
+    "username": "Bob",
+    "is_active": true,
+    "open_reviews": 2,
+    "assignments": 1,
+    "avg_time_to_merge_seconds": null
+  },
+  {
+    "user_id": "u3_John",
+    "username": "John",
+    "is_active": false,
+    "open_reviews": 0,
+    "assignments": 0,
+    "avg_time_to_merge_seconds": null
+  }
+]
+`
+	JSONEq(s.T(), expected, response.Teams[0].Reviewers)
+
+	// inactive John without assignments is not scored
+	s.InDelta(0.5, response.Teams[0].Fairness.Gini, 1e-9)
+}
+
+func (s *StatsGetReviewersLoadSuite) TestInvalidQuery() {
+	queries := []string{
+		"from=yesterday",
+		"from=2024-02-01T00:00:00Z&to=2024-01-01T00:00:00Z",
+		"limit=0",
+		"limit=1000",
+		"offset=-1",
+	}
+
+	for _, query := range queries {
+		s.Run(query, func() {
+			res, err := s.server.Client().Get(s.server.URL + "/stats/reviewers/load?" + query)
+			s.Require().NoError(err)
+
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusBadRequest, res.StatusCode)
+		})
+	}
+}
@@ -143,7 +143,8 @@ func (s *TeamAddSuite) TestAddTeamAlreadyExists() {
   "error": {
     "code": "TEAM_EXISTS",
     "message": "backend_already_exists already exists"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
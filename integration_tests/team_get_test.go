@@ -59,7 +59,8 @@ func (s *TeamGetSuite) TestGetTeamNotFound() {
   "error": {
     "code": "NOT_FOUND",
     "message": "resource not found"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
@@ -105,6 +106,7 @@ func (s *TeamGetSuite) TestGetTeamDefault() {
 		s.Require().True(exists, "Unexpected user ID: %s", member.ID)
 		s.Require().Equal(expected.Name, member.Name)
 		s.Require().Equal(expected.IsActive, member.IsActive)
+		s.Require().True(member.IsPrimary)
 	}
 }
 
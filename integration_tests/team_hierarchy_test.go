@@ -0,0 +1,290 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	prHandler "reviewer-assigner/internal/http/handlers/pullrequests"
+	statsHandler "reviewer-assigner/internal/http/handlers/stats"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type TeamHierarchySuite struct {
+	BaseSuite
+}
+
+func (s *TeamHierarchySuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *TeamHierarchySuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *TeamHierarchySuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/team_hierarchy"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestTeamHierarchySuite_Run(t *testing.T) {
+	suite.Run(t, new(TeamHierarchySuite))
+}
+
+func (s *TeamHierarchySuite) post(path, body string) *http.Response {
+	res, err := s.server.Client().Post(s.server.URL+path, "", bytes.NewBufferString(body))
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *TeamHierarchySuite) get(path string) *http.Response {
+	res, err := s.server.Client().Get(s.server.URL + path)
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *TeamHierarchySuite) TestTree() {
+	res := s.get("/team/tree")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := `
+{
+  "teams": [
+    {
+      "team_name": "engineering",
+      "members_count": 1,
+      "children": [
+        {
+          "team_name": "payments",
+          "members_count": 3,
+          "children": [
+            {"team_name": "checkout", "members_count": 1, "children": []}
+          ]
+        }
+      ]
+    },
+    {"team_name": "infra", "members_count": 1, "children": []},
+    {
+      "team_name": "legacy",
+      "members_count": 0,
+      "children": [
+        {"team_name": "legacy_tools", "members_count": 0, "children": []}
+      ]
+    }
+  ]
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+
+	res = s.get("/team/tree?team_name=payments")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected = `
+{
+  "teams": [
+    {
+      "team_name": "payments",
+      "members_count": 3,
+      "children": [
+        {"team_name": "checkout", "members_count": 1, "children": []}
+      ]
+    }
+  ]
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+
+	res = s.get("/team/tree?team_name=unknown")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+}
+
+func (s *TeamHierarchySuite) TestSetParent() {
+	res := s.post("/team/setParent", `{"team_name": "infra", "parent_team_name": "engineering"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `{"team_name": "infra", "parent_team_name": "engineering"}`, res.Body)
+
+	res = s.get("/team/get?team_name=infra")
+	defer res.Body.Close()
+
+	JSONEq(s.T(), `
+{
+  "team_name": "infra",
+  "parent_team_name": "engineering",
+  "members": [
+    {"user_id": "infra_Ivan", "username": "Ivan", "is_active": true, "is_primary": true}
+  ]
+}`, res.Body)
+
+	res = s.post("/team/setParent", `{"team_name": "payments", "parent_team_name": null}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `{"team_name": "payments", "parent_team_name": null}`, res.Body)
+}
+
+func (s *TeamHierarchySuite) TestSetParentInvalid() {
+	testCases := []struct {
+		name         string
+		requestBody  string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "under_subteam",
+			requestBody:  `{"team_name": "engineering", "parent_team_name": "checkout"}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "TEAM_CYCLE",
+    "message": "team engineering cannot be nested under itself or its subteam"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "under_itself",
+			requestBody:  `{"team_name": "payments", "parent_team_name": "payments"}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "TEAM_CYCLE",
+    "message": "team payments cannot be nested under itself or its subteam"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "unknown_parent",
+			requestBody:  `{"team_name": "payments", "parent_team_name": "unknown"}`,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `
+{
+  "error": {
+    "code": "NOT_FOUND",
+    "message": "resource not found"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "empty_parent",
+			requestBody:  `{"team_name": "payments", "parent_team_name": ""}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `
+{
+  "error": {
+    "code": "INVALID_BODY",
+    "message": "invalid request body"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.post("/team/setParent", tc.requestBody)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+			JSONEq(s.T(), tc.expectedBody, res.Body)
+		})
+	}
+}
+
+func (s *TeamHierarchySuite) TestDeleteTeamWithSubteams() {
+	res := s.post("/team/delete", `{"team_name": "legacy"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+	JSONEq(s.T(), `
+{
+  "error": {
+    "code": "TEAM_HAS_SUBTEAMS",
+    "message": "team legacy still has subteams"
+  },
+  "request_id": "test-request-id"
+}`, res.Body)
+
+	res = s.post("/team/delete", `{"team_name": "legacy_tools"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	res = s.post("/team/delete", `{"team_name": "legacy"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+}
+
+func (s *TeamHierarchySuite) TestCreatePullRequestFallback() {
+	// Kate is alone in checkout: Bob is ruled out by the payments conflict rule,
+	// so payments gives Alice and engineering gives Lead
+	res := s.post("/pullRequest/create", `
+{"pull_request_id": "pr_new_id", "pull_request_name": "New PR", "author_id": "co_Kate"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	var response prHandler.CreatePullRequestResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+	s.Require().ElementsMatch([]string{"pay_Alice", "eng_Lead"}, response.AssignedReviewers)
+}
+
+func (s *TeamHierarchySuite) TestStatsRollup() {
+	const period = "from=2024-01-15T00:00:00Z&to=2024-01-22T00:00:00Z"
+
+	res := s.get("/stats/pullRequests/timeseries?bucket=week&team_name=engineering&" + period)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `
+{
+  "from": "2024-01-15T00:00:00Z",
+  "to": "2024-01-22T00:00:00Z",
+  "bucket": "week",
+  "team_name": "engineering",
+  "points": [
+    {"bucket_start": "2024-01-15T00:00:00Z", "created": 2, "merged": 0, "median_time_to_merge_seconds": null}
+  ]
+}`, res.Body)
+
+	res = s.get("/stats/reviewers/load?team_name=payments&" + period)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response statsHandler.GetStatsReviewersLoadResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+
+	teamNames := make([]string, 0, len(response.Teams))
+	for _, team := range response.Teams {
+		teamNames = append(teamNames, team.TeamName)
+	}
+
+	s.Require().Equal(2, response.Total)
+	s.Require().Equal([]string{"checkout", "payments"}, teamNames)
+}
@@ -0,0 +1,444 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	prHandler "reviewer-assigner/internal/http/handlers/pullrequests"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type TeamLifecycleSuite struct {
+	BaseSuite
+}
+
+func (s *TeamLifecycleSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *TeamLifecycleSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *TeamLifecycleSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/team_lifecycle"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestTeamLifecycleSuite_Run(t *testing.T) {
+	suite.Run(t, new(TeamLifecycleSuite))
+}
+
+func (s *TeamLifecycleSuite) post(path, body string) *http.Response {
+	res, err := s.server.Client().Post(s.server.URL+path, "", bytes.NewBufferString(body))
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *TeamLifecycleSuite) get(path string) *http.Response {
+	res, err := s.server.Client().Get(s.server.URL + path)
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *TeamLifecycleSuite) TestAddMember() {
+	testCases := []struct {
+		name         string
+		requestBody  string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "new_user",
+			requestBody:  `{"team_name": "infra", "user_id": "infra_Oleg", "username": "Oleg", "is_active": true}`,
+			expectedCode: http.StatusCreated,
+			expectedBody: `
+{
+  "team": {
+    "team_name": "infra",
+    "members": [
+      {"user_id": "infra_Ivan", "username": "Ivan", "is_active": true},
+      {"user_id": "infra_Azat", "username": "Azat", "is_active": true},
+      {"user_id": "infra_Oleg", "username": "Oleg", "is_active": true}
+    ]
+  }
+}`,
+		},
+		{
+			name:         "user_without_team",
+			requestBody:  `{"team_name": "archive", "user_id": "u9_Former", "username": "Former", "is_active": false}`,
+			expectedCode: http.StatusCreated,
+			expectedBody: `
+{
+  "team": {
+    "team_name": "archive",
+    "members": [
+      {"user_id": "u9_Former", "username": "Former", "is_active": false}
+    ]
+  }
+}`,
+		},
+		{
+			name:         "member_of_other_team",
+			requestBody:  `{"team_name": "infra", "user_id": "u2_Bob", "username": "Bob", "is_active": true}`,
+			expectedCode: http.StatusCreated,
+			expectedBody: `
+{
+  "team": {
+    "team_name": "infra",
+    "members": [
+      {"user_id": "u2_Bob", "username": "Bob", "is_active": true},
+      {"user_id": "infra_Ivan", "username": "Ivan", "is_active": true},
+      {"user_id": "infra_Azat", "username": "Azat", "is_active": true}
+    ]
+  }
+}`,
+		},
+		{
+			name:         "already_member",
+			requestBody:  `{"team_name": "payments", "user_id": "u2_Bob", "username": "Bob", "is_active": true}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "MEMBER_EXISTS",
+    "message": "user u2_Bob is already a member of a team"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "unknown_team",
+			requestBody:  `{"team_name": "unknown", "user_id": "u10_New", "username": "New", "is_active": true}`,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `
+{
+  "error": {
+    "code": "NOT_FOUND",
+    "message": "resource not found"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.post("/team/addMember", tc.requestBody)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+			JSONEq(s.T(), tc.expectedBody, res.Body)
+		})
+	}
+}
+
+func (s *TeamLifecycleSuite) TestRemoveMemberKeepReviews() {
+	res := s.post("/team/removeMember", `{"team_name": "payments", "user_id": "u2_Bob", "open_reviews": "KEEP"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := `
+{
+  "team": {
+    "team_name": "payments",
+    "members": [
+      {"user_id": "u1_Alice", "username": "Alice", "is_active": true},
+      {"user_id": "u3_John", "username": "John", "is_active": true},
+      {"user_id": "u4_Mike", "username": "Mike", "is_active": true}
+    ]
+  },
+  "reassignments": []
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+
+	// rules binding the removed member are dropped
+	res = s.get("/team/getRules?team_name=payments")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `{"team_name": "payments", "rules": []}`, res.Body)
+
+	// a user without teams stays known together with the kept reviews
+	res = s.get("/users/get?user_id=u2_Bob")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `
+{
+  "user": {
+    "user_id": "u2_Bob",
+    "username": "Bob",
+    "team_name": "",
+    "team_names": [],
+    "is_active": true,
+    "open_reviews": 1
+  }
+}`, res.Body)
+}
+
+func (s *TeamLifecycleSuite) TestRemoveMemberReassignReviews() {
+	res := s.post("/team/removeMember", `{"team_name": "payments", "user_id": "u2_Bob", "open_reviews": "REASSIGN"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := `
+{
+  "team": {
+    "team_name": "payments",
+    "members": [
+      {"user_id": "u1_Alice", "username": "Alice", "is_active": true},
+      {"user_id": "u3_John", "username": "John", "is_active": true},
+      {"user_id": "u4_Mike", "username": "Mike", "is_active": true}
+    ]
+  },
+  "reassignments": [
+    {"pull_request_id": "pr_opened_id", "old_reviewer_id": "u2_Bob", "new_reviewer_id": "u4_Mike"}
+  ]
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+}
+
+func (s *TeamLifecycleSuite) TestRemoveMemberNoCandidate() {
+	res := s.post("/team/removeMember", `{"team_name": "infra", "user_id": "infra_Azat", "open_reviews": "REASSIGN"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+
+	// nothing is changed when a review cannot be handed over
+	res = s.get("/team/get?team_name=infra")
+	defer res.Body.Close()
+
+	expected := `
+{
+  "team_name": "infra",
+  "members": [
+    {"user_id": "infra_Ivan", "username": "Ivan", "is_active": true, "is_primary": true},
+    {"user_id": "infra_Azat", "username": "Azat", "is_active": true, "is_primary": true}
+  ]
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+}
+
+func (s *TeamLifecycleSuite) TestMoveMember() {
+	res := s.post("/team/moveMember", `
+{
+  "user_id": "u2_Bob",
+  "team_name": "payments",
+  "to_team_name": "infra",
+  "open_reviews": "REASSIGN"
+}
+`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := `
+{
+  "team": {
+    "team_name": "infra",
+    "members": [
+      {"user_id": "u2_Bob", "username": "Bob", "is_active": true},
+      {"user_id": "infra_Ivan", "username": "Ivan", "is_active": true},
+      {"user_id": "infra_Azat", "username": "Azat", "is_active": true}
+    ]
+  },
+  "reassignments": [
+    {"pull_request_id": "pr_opened_id", "old_reviewer_id": "u2_Bob", "new_reviewer_id": "u4_Mike"}
+  ]
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+}
+
+func (s *TeamLifecycleSuite) TestMoveMemberInvalid() {
+	testCases := []struct {
+		name         string
+		requestBody  string
+		expectedCode int
+	}{
+		{
+			name: "not_a_member",
+			requestBody: `
+{"user_id": "infra_Ivan", "team_name": "payments", "to_team_name": "archive", "open_reviews": "KEEP"}`,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "unknown_team",
+			requestBody: `
+{"user_id": "u2_Bob", "team_name": "payments", "to_team_name": "unknown", "open_reviews": "KEEP"}`,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "same_team",
+			requestBody: `
+{"user_id": "u2_Bob", "team_name": "payments", "to_team_name": "payments", "open_reviews": "KEEP"}`,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "unknown_open_reviews_option",
+			requestBody: `
+{"user_id": "u2_Bob", "team_name": "payments", "to_team_name": "infra", "open_reviews": "DROP"}`,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.post("/team/moveMember", tc.requestBody)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+		})
+	}
+}
+
+func (s *TeamLifecycleSuite) TestMultipleTeams() {
+	res := s.post("/team/addMember", `
+{"team_name": "infra", "user_id": "u2_Bob", "username": "Bob", "is_active": true}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	res = s.get("/team/get?team_name=infra")
+	defer res.Body.Close()
+
+	JSONEq(s.T(), `
+{
+  "team_name": "infra",
+  "members": [
+    {"user_id": "u2_Bob", "username": "Bob", "is_active": true, "is_primary": false},
+    {"user_id": "infra_Ivan", "username": "Ivan", "is_active": true, "is_primary": true},
+    {"user_id": "infra_Azat", "username": "Azat", "is_active": true, "is_primary": true}
+  ]
+}`, res.Body)
+
+	res = s.post("/users/setIsActive", `{"user_id": "u2_Bob", "is_active": true}`)
+	defer res.Body.Close()
+
+	JSONEq(s.T(), `
+{
+  "user": {
+    "user_id": "u2_Bob",
+    "username": "Bob",
+    "team_name": "payments",
+    "team_names": ["payments", "infra"],
+    "is_active": true,
+    "open_reviews": 1
+  }
+}`, res.Body)
+
+	// reviewers come from the requested team instead of the primary one
+	res = s.post("/pullRequest/create", `
+{"pull_request_id": "pr_infra_id", "pull_request_name": "Infra PR", "author_id": "u2_Bob", "team_name": "infra"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	var response prHandler.CreatePullRequestResponse
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&response))
+	s.Require().ElementsMatch([]string{"infra_Ivan", "infra_Azat"}, response.AssignedReviewers)
+
+	res = s.post("/pullRequest/create", `
+{"pull_request_id": "pr_archive_id", "pull_request_name": "Archive PR", "author_id": "u2_Bob", "team_name": "archive"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+
+	// leaving the primary team promotes the remaining membership
+	res = s.post("/team/removeMember", `{"team_name": "payments", "user_id": "u2_Bob", "open_reviews": "KEEP"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	res = s.post("/users/setIsActive", `{"user_id": "u2_Bob", "is_active": true}`)
+	defer res.Body.Close()
+
+	JSONEq(s.T(), `
+{
+  "user": {
+    "user_id": "u2_Bob",
+    "username": "Bob",
+    "team_name": "infra",
+    "team_names": ["infra"],
+    "is_active": true,
+    "open_reviews": 1
+  }
+}`, res.Body)
+}
+
+func (s *TeamLifecycleSuite) TestRenameTeam() {
+	res := s.post("/team/rename", `{"team_name": "payments", "new_team_name": "infra"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+	JSONEq(s.T(), `
+{
+  "error": {
+    "code": "TEAM_EXISTS",
+    "message": "infra already exists"
+  },
+  "request_id": "test-request-id"
+}`, res.Body)
+
+	res = s.post("/team/rename", `{"team_name": "archive", "new_team_name": "legacy"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `{"team": {"team_name": "legacy", "members": []}}`, res.Body)
+
+	res = s.get("/team/get?team_name=archive")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+
+	res = s.post("/team/rename", `{"team_name": "archive", "new_team_name": "old"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+}
+
+func (s *TeamLifecycleSuite) TestDeleteTeam() {
+	res := s.post("/team/delete", `{"team_name": "payments"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+	JSONEq(s.T(), `
+{
+  "error": {
+    "code": "TEAM_NOT_EMPTY",
+    "message": "team payments still has members"
+  },
+  "request_id": "test-request-id"
+}`, res.Body)
+
+	res = s.post("/team/delete", `{"team_name": "archive"}`)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	JSONEq(s.T(), `{"team_name": "archive"}`, res.Body)
+
+	res = s.get("/team/get?team_name=archive")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+}
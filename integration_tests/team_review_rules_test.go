@@ -0,0 +1,273 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	prHandler "reviewer-assigner/internal/http/handlers/pullrequests"
+	teamsHandler "reviewer-assigner/internal/http/handlers/teams"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type TeamReviewRulesSuite struct {
+	BaseSuite
+}
+
+func (s *TeamReviewRulesSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *TeamReviewRulesSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *TeamReviewRulesSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/team_review_rules"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestTeamReviewRulesSuite_Run(t *testing.T) {
+	suite.Run(t, new(TeamReviewRulesSuite))
+}
+
+func (s *TeamReviewRulesSuite) TestGetReviewRules() {
+	res, err := s.server.Client().Get(s.server.URL + "/team/getReviewRules?team_name=payments")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response teamsHandler.GetTeamReviewRulesResponse
+	err = json.NewDecoder(res.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	expected := `
+{
+  "team_name": "payments",
+  "rules": [
+    {
+      "min_lines_changed": 500,
+      "label": "",
+      "reviewers_count": 3,
+      "extra_team_name": ""
+    },
+    {
+      "min_lines_changed": 0,
+      "label": "security",
+      "reviewers_count": 0,
+      "extra_team_name": "security"
+    }
+  ]
+}
+`
+
+	JSONEq(s.T(), expected, response)
+}
+
+func (s *TeamReviewRulesSuite) TestAddAndRemoveReviewRule() {
+	requestBody := `
+{
+  "team_name": "payments",
+  "label": "migration",
+  "reviewers_count": 4,
+  "extra_team_name": "security"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/team/addReviewRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	res, err = s.server.Client().
+		Post(s.server.URL+"/team/addReviewRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+
+	res, err = s.server.Client().
+		Post(s.server.URL+"/team/removeReviewRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	res, err = s.server.Client().
+		Post(s.server.URL+"/team/removeReviewRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+}
+
+func (s *TeamReviewRulesSuite) TestAddReviewRuleInvalid() {
+	testCases := []struct {
+		name          string
+		requestBody   string
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "asks for nothing",
+			requestBody: `
+{
+  "team_name": "payments",
+  "min_lines_changed": 100
+}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedError: `
+{
+  "error": {
+    "code": "RULE_INVALID",
+    "message": "invalid team rule: review rule must ask for 1-10 reviewers or an extra team"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name: "unknown extra team",
+			requestBody: `
+{
+  "team_name": "payments",
+  "label": "frontend",
+  "extra_team_name": "frontend"
+}`,
+			expectedCode: http.StatusNotFound,
+			expectedError: `
+{
+  "error": {
+    "code": "NOT_FOUND",
+    "message": "resource not found"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name: "negative lines",
+			requestBody: `
+{
+  "team_name": "payments",
+  "min_lines_changed": -1,
+  "reviewers_count": 3
+}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedError: `
+{
+  "error": {
+    "code": "INVALID_BODY",
+    "message": "invalid request body"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.server.Client().
+				Post(s.server.URL+"/team/addReviewRule", "", bytes.NewBufferString(tc.requestBody))
+			s.Require().NoError(err)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+
+			var errorResp handlers.ErrorResponse
+			err = json.NewDecoder(res.Body).Decode(&errorResp)
+			s.Require().NoError(err)
+
+			JSONEq(s.T(), tc.expectedError, errorResp)
+		})
+	}
+}
+
+func (s *TeamReviewRulesSuite) TestCreateSizedByRules() {
+	testCases := []struct {
+		name           string
+		requestBody    string
+		expectedCount  int
+		expectedAmong  []string
+		expectedGroups map[string]string
+	}{
+		{
+			name: "small fix",
+			requestBody: `
+{
+  "pull_request_id": "pr-1001",
+  "pull_request_name": "Fix typo",
+  "author_id": "u1_Alice",
+  "lines_changed": 5
+}`,
+			expectedCount: 2,
+		},
+		{
+			name: "large change",
+			requestBody: `
+{
+  "pull_request_id": "pr-1002",
+  "pull_request_name": "Rewrite billing",
+  "author_id": "u1_Alice",
+  "lines_changed": 800
+}`,
+			expectedCount: 3,
+			expectedAmong: []string{"u2_Bob", "u3_John", "u4_Mike"},
+		},
+		{
+			name: "security label",
+			requestBody: `
+{
+  "pull_request_id": "pr-1003",
+  "pull_request_name": "Rotate keys",
+  "author_id": "u1_Alice",
+  "lines_changed": 40,
+  "labels": ["security"]
+}`,
+			// two payments reviewers and Sam on top, in the slot of the security team
+			expectedCount:  3,
+			expectedAmong:  []string{"sec_Sam"},
+			expectedGroups: map[string]string{"sec_Sam": "security"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.server.Client().
+				Post(s.server.URL+"/pullRequest/create", "", bytes.NewBufferString(tc.requestBody))
+			s.Require().NoError(err)
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+			response := prHandler.CreatePullRequestResponse{}
+			err = json.NewDecoder(res.Body).Decode(&response)
+			s.Require().NoError(err)
+
+			s.Require().Len(response.AssignedReviewers, tc.expectedCount)
+			s.Require().Subset(response.AssignedReviewers, tc.expectedAmong)
+			s.Require().Equal(tc.expectedGroups, response.GroupReviewers)
+		})
+	}
+
+	// the slot of the security team is not handed over to payments
+	res, err := s.server.Client().Post(s.server.URL+"/pullRequest/reassign", "", bytes.NewBufferString(`
+{"pull_request_id": "pr-1003", "old_reviewer_id": "sec_Sam"}`))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+}
@@ -0,0 +1,273 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	prHandler "reviewer-assigner/internal/http/handlers/pullrequests"
+	teamsHandler "reviewer-assigner/internal/http/handlers/teams"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type TeamRulesSuite struct {
+	BaseSuite
+}
+
+func (s *TeamRulesSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *TeamRulesSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *TeamRulesSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/team_rules"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestTeamRulesSuite_Run(t *testing.T) {
+	suite.Run(t, new(TeamRulesSuite))
+}
+
+func (s *TeamRulesSuite) TestGetRules() {
+	res, err := s.server.Client().Get(s.server.URL + "/team/getRules?team_name=payments")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	var response teamsHandler.GetTeamRulesResponse
+	err = json.NewDecoder(res.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	expected := `
+{
+  "team_name": "payments",
+  "rules": [
+    {
+      "kind": "CONFLICT_OF_INTEREST",
+      "user_id": "u1_Alice",
+      "other_user_id": "u2_Bob"
+    },
+    {
+      "kind": "PAIRING",
+      "user_id": "u3_John",
+      "other_user_id": "u4_Mike"
+    }
+  ]
+}
+`
+
+	JSONEq(s.T(), expected, response)
+}
+
+func (s *TeamRulesSuite) TestAddAndRemoveRule() {
+	requestBody := `
+{
+  "team_name": "payments",
+  "kind": "CONFLICT_OF_INTEREST",
+  "user_id": "u3_John",
+  "other_user_id": "u2_Bob"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/team/addRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	res, err = s.server.Client().
+		Post(s.server.URL+"/team/addRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+
+	res, err = s.server.Client().
+		Post(s.server.URL+"/team/removeRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	res, err = s.server.Client().
+		Post(s.server.URL+"/team/removeRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+}
+
+func (s *TeamRulesSuite) TestRemoveRuleTeamNotFound() {
+	requestBody := `
+{
+  "team_name": "team_not_found",
+  "kind": "CONFLICT_OF_INTEREST",
+  "user_id": "u3_John",
+  "other_user_id": "u2_Bob"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/team/removeRule", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+}
+
+func (s *TeamRulesSuite) TestAddRuleInvalid() {
+	testCases := []struct {
+		name          string
+		requestBody   string
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "user outside of team",
+			requestBody: `
+{
+  "team_name": "payments",
+  "kind": "PAIRING",
+  "user_id": "u3_John",
+  "other_user_id": "u_not_found_id"
+}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedError: `
+{
+  "error": {
+    "code": "RULE_INVALID",
+    "message": "invalid team rule: rule references user outside of team"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name: "same user",
+			requestBody: `
+{
+  "team_name": "payments",
+  "kind": "PAIRING",
+  "user_id": "u3_John",
+  "other_user_id": "u3_John"
+}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedError: `
+{
+  "error": {
+    "code": "RULE_INVALID",
+    "message": "invalid team rule: rule must bind two distinct users"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name: "unknown kind",
+			requestBody: `
+{
+  "team_name": "payments",
+  "kind": "FRIENDSHIP",
+  "user_id": "u3_John",
+  "other_user_id": "u2_Bob"
+}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedError: `
+{
+  "error": {
+    "code": "INVALID_BODY",
+    "message": "invalid request body"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.server.Client().
+				Post(s.server.URL+"/team/addRule", "", bytes.NewBufferString(tc.requestBody))
+			s.Require().NoError(err)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+
+			var errorResp handlers.ErrorResponse
+			err = json.NewDecoder(res.Body).Decode(&errorResp)
+			s.Require().NoError(err)
+
+			JSONEq(s.T(), tc.expectedError, errorResp)
+		})
+	}
+}
+
+func (s *TeamRulesSuite) TestCreateRespectsRules() {
+	requestBody := `
+{
+  "pull_request_id": "pr-1001",
+  "pull_request_name": "Add search",
+  "author_id": "u1_Alice"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/create", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	response := prHandler.CreatePullRequestResponse{}
+	err = json.NewDecoder(res.Body).Decode(&response)
+	s.Require().NoError(err)
+
+	// Bob conflicts with Alice, John needs Mike
+	s.Require().ElementsMatch([]string{"u3_John", "u4_Mike"}, response.AssignedReviewers)
+}
+
+func (s *TeamRulesSuite) TestReassignMentorViolation() {
+	requestBody := `
+{
+  "pull_request_id": "pr_paired_id",
+  "old_reviewer_id": "u4_Mike"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/reassign", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusConflict, res.StatusCode)
+
+	var errorResp handlers.ErrorResponse
+	err = json.NewDecoder(res.Body).Decode(&errorResp)
+	s.Require().NoError(err)
+
+	expected := `
+{
+  "error": {
+    "code": "RULE_VIOLATION",
+    "message": "team rules violated: PAIRING(u3_John, u4_Mike): mentor u4_Mike cannot leave while u3_John reviews"
+  },
+  "request_id": "test-request-id"
+}
+`
+
+	JSONEq(s.T(), expected, errorResp)
+}
@@ -0,0 +1,250 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type TeamSyncSuite struct {
+	BaseSuite
+}
+
+func (s *TeamSyncSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *TeamSyncSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *TeamSyncSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/team_lifecycle"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestTeamSyncSuite_Run(t *testing.T) {
+	suite.Run(t, new(TeamSyncSuite))
+}
+
+// paymentsRoster renames Alice, drops Bob and brings in an inactive Oleg, so Mike is the only candidate.
+const paymentsRoster = `
+{
+  "team_name": "payments",
+  "members": [
+    {"user_id": "u1_Alice", "username": "Alice Smith", "is_active": true},
+    {"user_id": "u3_John", "username": "John", "is_active": true},
+    {"user_id": "u4_Mike", "username": "Mike", "is_active": true},
+    {"user_id": "payments_Oleg", "username": "Oleg", "is_active": false}
+  ]
+}`
+
+func (s *TeamSyncSuite) sync(query, body string) *http.Response {
+	req, err := http.NewRequest(http.MethodPut, s.server.URL+"/team/sync"+query, bytes.NewBufferString(body))
+	s.Require().NoError(err)
+
+	res, err := s.server.Client().Do(req)
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *TeamSyncSuite) TestDryRun() {
+	res := s.sync("?dry_run=true", paymentsRoster)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := `
+{
+  "dry_run": true,
+  "diff": {
+    "added": [{"user_id": "payments_Oleg", "username": "Oleg", "is_active": false}],
+    "removed": [{"user_id": "u2_Bob", "username": "Bob", "is_active": true}],
+    "renamed": [{"user_id": "u1_Alice", "old_username": "Alice", "new_username": "Alice Smith"}],
+    "activity_changed": []
+  },
+  "team": {
+    "team_name": "payments",
+    "members": [
+      {"user_id": "u1_Alice", "username": "Alice Smith", "is_active": true},
+      {"user_id": "u3_John", "username": "John", "is_active": true},
+      {"user_id": "u4_Mike", "username": "Mike", "is_active": true},
+      {"user_id": "payments_Oleg", "username": "Oleg", "is_active": false}
+    ]
+  },
+  "reassignments": [
+    {"pull_request_id": "pr_opened_id", "old_reviewer_id": "u2_Bob", "new_reviewer_id": "u4_Mike"}
+  ]
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+
+	// the dry run is rolled back
+	res, err := s.server.Client().Get(s.server.URL + "/team/get?team_name=payments")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	expected = `
+{
+  "team_name": "payments",
+  "members": [
+    {"user_id": "u1_Alice", "username": "Alice", "is_active": true, "is_primary": true},
+    {"user_id": "u2_Bob", "username": "Bob", "is_active": true, "is_primary": true},
+    {"user_id": "u3_John", "username": "John", "is_active": true, "is_primary": true},
+    {"user_id": "u4_Mike", "username": "Mike", "is_active": true, "is_primary": true}
+  ]
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+}
+
+func (s *TeamSyncSuite) TestApply() {
+	res := s.sync("", paymentsRoster)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := `
+{
+  "dry_run": false,
+  "diff": {
+    "added": [{"user_id": "payments_Oleg", "username": "Oleg", "is_active": false}],
+    "removed": [{"user_id": "u2_Bob", "username": "Bob", "is_active": true}],
+    "renamed": [{"user_id": "u1_Alice", "old_username": "Alice", "new_username": "Alice Smith"}],
+    "activity_changed": []
+  },
+  "team": {
+    "team_name": "payments",
+    "members": [
+      {"user_id": "u1_Alice", "username": "Alice Smith", "is_active": true},
+      {"user_id": "u3_John", "username": "John", "is_active": true},
+      {"user_id": "u4_Mike", "username": "Mike", "is_active": true},
+      {"user_id": "payments_Oleg", "username": "Oleg", "is_active": false}
+    ]
+  },
+  "reassignments": [
+    {"pull_request_id": "pr_opened_id", "old_reviewer_id": "u2_Bob", "new_reviewer_id": "u4_Mike"}
+  ]
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+}
+
+func (s *TeamSyncSuite) TestErrors() {
+	testCases := []struct {
+		name         string
+		query        string
+		requestBody  string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:  "no_candidate",
+			query: "",
+			requestBody: `
+{
+  "team_name": "infra",
+  "members": [{"user_id": "infra_Ivan", "username": "Ivan", "is_active": true}]
+}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "NO_CANDIDATE",
+    "message": "no active replacement candidate in team"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:  "no_candidate_dry_run",
+			query: "?dry_run=true",
+			requestBody: `
+{
+  "team_name": "infra",
+  "members": [{"user_id": "infra_Ivan", "username": "Ivan", "is_active": true}]
+}`,
+			expectedCode: http.StatusConflict,
+			expectedBody: `
+{
+  "error": {
+    "code": "NO_CANDIDATE",
+    "message": "no active replacement candidate in team"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "unknown_team",
+			query:        "",
+			requestBody:  `{"team_name": "unknown", "members": []}`,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `
+{
+  "error": {
+    "code": "NOT_FOUND",
+    "message": "resource not found"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:  "duplicate_members",
+			query: "",
+			requestBody: `
+{
+  "team_name": "infra",
+  "members": [
+    {"user_id": "infra_Ivan", "username": "Ivan", "is_active": true},
+    {"user_id": "infra_Ivan", "username": "Ivan", "is_active": false}
+  ]
+}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `
+{
+  "error": {
+    "code": "INVALID_BODY",
+    "message": "invalid request body"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+		{
+			name:         "invalid_dry_run",
+			query:        "?dry_run=maybe",
+			requestBody:  `{"team_name": "infra", "members": []}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `
+{
+  "error": {
+    "code": "INVALID_QUERY_PARAM",
+    "message": "invalid query parameter"
+  },
+  "request_id": "test-request-id"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.sync(tc.query, tc.requestBody)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+			JSONEq(s.T(), tc.expectedBody, res.Body)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package integration_tests
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type TracingSuite struct {
+	BaseSuite
+}
+
+func (s *TracingSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *TracingSuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *TracingSuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/pull_request_create"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+
+	s.spans.Reset()
+}
+
+func TestTracingSuite_Run(t *testing.T) {
+	suite.Run(t, new(TracingSuite))
+}
+
+func (s *TracingSuite) TestCreatePullRequestSpans() {
+	requestBody := `
+{
+  "pull_request_id": "pr-1001",
+  "pull_request_name": "Add search",
+  "author_id": "u1_Alice"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/create", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusCreated, res.StatusCode)
+
+	spans := s.spans.GetSpans()
+
+	httpSpan := findSpan(spans, func(name string) bool { return name == "POST /pullRequest/create" })
+	s.Require().NotNil(httpSpan)
+
+	serviceSpan := findSpan(spans, func(name string) bool { return name == "services.pull_requests.Create" })
+	s.Require().NotNil(serviceSpan)
+	s.Equal(httpSpan.SpanContext.TraceID(), serviceSpan.SpanContext.TraceID())
+	s.Equal(httpSpan.SpanContext.SpanID(), serviceSpan.Parent.SpanID())
+
+	querySpan := findSpan(spans, func(name string) bool { return strings.HasPrefix(name, "postgres INSERT") })
+	s.Require().NotNil(querySpan)
+	s.Equal(serviceSpan.SpanContext.TraceID(), querySpan.SpanContext.TraceID())
+}
+
+func (s *TracingSuite) TestServiceErrorSpan() {
+	requestBody := `
+{
+  "pull_request_id": "pr-1001",
+  "pull_request_name": "Add search",
+  "author_id": "unknown"
+}
+`
+
+	res, err := s.server.Client().
+		Post(s.server.URL+"/pullRequest/create", "", bytes.NewBufferString(requestBody))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusNotFound, res.StatusCode)
+
+	serviceSpan := findSpan(s.spans.GetSpans(), func(name string) bool {
+		return name == "services.pull_requests.Create"
+	})
+	s.Require().NotNil(serviceSpan)
+	s.Equal("Error", serviceSpan.Status.Code.String())
+	s.NotEqual(trace.TraceID{}, serviceSpan.SpanContext.TraceID())
+}
+
+func findSpan(spans tracetest.SpanStubs, match func(name string) bool) *tracetest.SpanStub {
+	for i := range spans {
+		if match(spans[i].Name) {
+			return &spans[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package integration_tests
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserDirectorySuite struct {
+	BaseSuite
+}
+
+func (s *UserDirectorySuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+}
+
+func (s *UserDirectorySuite) TearDownSuite() {
+	s.BaseSuite.TearDownSuite()
+}
+
+func (s *UserDirectorySuite) SetupTest() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("postgres"),
+		testfixtures.Directory("fixtures/storage/user_directory"),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+}
+
+func TestUserDirectorySuite_Run(t *testing.T) {
+	suite.Run(t, new(UserDirectorySuite))
+}
+
+func (s *UserDirectorySuite) get(path string) *http.Response {
+	res, err := s.server.Client().Get(s.server.URL + path)
+	s.Require().NoError(err)
+
+	return res
+}
+
+func (s *UserDirectorySuite) TestGetUser() {
+	res := s.get("/users/get?user_id=u3_Bob")
+	defer res.Body.Close()
+
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+
+	expected := `
+{
+  "user": {
+    "user_id": "u3_Bob",
+    "username": "Bob",
+    "team_name": "payments",
+    "team_names": ["payments", "infra"],
+    "is_active": true,
+    "open_reviews": 1
+  }
+}
+`
+	JSONEq(s.T(), expected, res.Body)
+
+	testCases := []struct {
+		name         string
+		query        string
+		expectedCode int
+	}{
+		{
+			name:         "unknown_user",
+			query:        "?user_id=unknown",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "user_without_team",
+			query:        "?user_id=u9_Former",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "missing_user_id",
+			query:        "",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.get("/users/get" + tc.query)
+			defer res.Body.Close()
+
+			s.Require().Equal(tc.expectedCode, res.StatusCode)
+		})
+	}
+}
+
+func (s *UserDirectorySuite) TestListUsers() {
+	testCases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:  "page",
+			query: "?limit=2&offset=1",
+			expected: `
+{
+  "limit": 2,
+  "offset": 1,
+  "total": 6,
+  "users": [
+    {
+      "user_id": "u2_Alina", "username": "Alina", "team_name": "payments", "team_names": ["payments"],
+      "is_active": false, "open_reviews": 0
+    },
+    {
+      "user_id": "u3_Bob", "username": "Bob", "team_name": "payments", "team_names": ["payments", "infra"],
+      "is_active": true, "open_reviews": 1
+    }
+  ]
+}`,
+		},
+		{
+			name:  "by_team",
+			query: "?team_name=infra",
+			expected: `
+{
+  "limit": 20,
+  "offset": 0,
+  "total": 3,
+  "users": [
+    {
+      "user_id": "u3_Bob", "username": "Bob", "team_name": "payments", "team_names": ["payments", "infra"],
+      "is_active": true, "open_reviews": 1
+    },
+    {
+      "user_id": "infra_Ivan", "username": "Ivan", "team_name": "infra", "team_names": ["infra"],
+      "is_active": true, "open_reviews": 0
+    },
+    {
+      "user_id": "infra_Zoe", "username": "Zo_e", "team_name": "infra", "team_names": ["infra"],
+      "is_active": true, "open_reviews": 0
+    }
+  ]
+}`,
+		},
+		{
+			name:  "inactive",
+			query: "?is_active=false",
+			expected: `
+{
+  "limit": 20,
+  "offset": 0,
+  "total": 1,
+  "users": [
+    {
+      "user_id": "u2_Alina", "username": "Alina", "team_name": "payments", "team_names": ["payments"],
+      "is_active": false, "open_reviews": 0
+    }
+  ]
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.get("/users/list" + tc.query)
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusOK, res.StatusCode)
+			JSONEq(s.T(), tc.expected, res.Body)
+		})
+	}
+}
+
+func (s *UserDirectorySuite) TestSearchUsers() {
+	testCases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:  "case_insensitive_prefix",
+			query: "?query=al",
+			expected: `
+{
+  "limit": 20,
+  "offset": 0,
+  "total": 2,
+  "users": [
+    {
+      "user_id": "u1_Alice", "username": "Alice", "team_name": "payments", "team_names": ["payments"],
+      "is_active": true, "open_reviews": 1
+    },
+    {
+      "user_id": "u2_Alina", "username": "Alina", "team_name": "payments", "team_names": ["payments"],
+      "is_active": false, "open_reviews": 0
+    }
+  ]
+}`,
+		},
+		{
+			name:  "active_only",
+			query: "?query=Al&is_active=true",
+			expected: `
+{
+  "limit": 20,
+  "offset": 0,
+  "total": 1,
+  "users": [
+    {
+      "user_id": "u1_Alice", "username": "Alice", "team_name": "payments", "team_names": ["payments"],
+      "is_active": true, "open_reviews": 1
+    }
+  ]
+}`,
+		},
+		{
+			name:  "wildcards_are_literal",
+			query: "?query=zo_",
+			expected: `
+{
+  "limit": 20,
+  "offset": 0,
+  "total": 1,
+  "users": [
+    {
+      "user_id": "infra_Zoe", "username": "Zo_e", "team_name": "infra", "team_names": ["infra"],
+      "is_active": true, "open_reviews": 0
+    }
+  ]
+}`,
+		},
+		{
+			name:     "nothing_found",
+			query:    "?query=%25",
+			expected: `{"limit": 20, "offset": 0, "total": 0, "users": []}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.get("/users/search" + tc.query)
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusOK, res.StatusCode)
+			JSONEq(s.T(), tc.expected, res.Body)
+		})
+	}
+}
+
+func (s *UserDirectorySuite) TestInvalidQuery() {
+	for _, path := range []string{
+		"/users/list?is_active=maybe",
+		"/users/list?limit=0",
+		"/users/list?offset=-1",
+		"/users/search",
+		"/users/search?query=",
+	} {
+		s.Run(path, func() {
+			res := s.get(path)
+			defer res.Body.Close()
+
+			s.Require().Equal(http.StatusBadRequest, res.StatusCode)
+		})
+	}
+}
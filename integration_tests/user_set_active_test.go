@@ -67,7 +67,9 @@ func (s *UserSetActiveSuite) TestDefault() {
     "user_id": "u1_Alice",
     "username": "Alice",
     "team_name": "payments",
-    "is_active": false
+    "team_names": ["payments"],
+    "is_active": false,
+    "open_reviews": 0
   }
 }
 `
@@ -99,7 +101,8 @@ func (s *UserSetActiveSuite) TestUserNotFound() {
   "error": {
     "code": "NOT_FOUND",
     "message": "resource not found"
-  }
+  },
+  "request_id": "test-request-id"
 }
 `
 
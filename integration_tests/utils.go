@@ -3,6 +3,8 @@ package integration_tests
 import (
 	"encoding/json"
 	"io"
+	"net/http"
+	"reviewer-assigner/internal/http/middleware"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,3 +31,19 @@ func jsonMarshal(t *testing.T, data any) string {
 		return string(res)
 	}
 }
+
+const testRequestID = "test-request-id"
+
+// requestIDTransport sends a fixed X-Request-ID, so expected error bodies stay deterministic.
+type requestIDTransport struct {
+	base http.RoundTripper
+}
+
+func (t requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(middleware.RequestIDHeader) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(middleware.RequestIDHeader, testRequestID)
+	}
+
+	return t.base.RoundTrip(req)
+}
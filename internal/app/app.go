@@ -8,43 +8,65 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"reviewer-assigner/internal/auth"
 	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/domain/access"
 	reviewerPicker "reviewer-assigner/internal/domain/pullrequests/pickers"
 	reviewerAssigner "reviewer-assigner/internal/domain/pullrequests/reassigners"
+	assignmentsHandler "reviewer-assigner/internal/http/handlers/assignments"
+	exportHandler "reviewer-assigner/internal/http/handlers/export"
 	prsHandler "reviewer-assigner/internal/http/handlers/pullrequests"
 	statsHandler "reviewer-assigner/internal/http/handlers/stats"
 	teamsHandler "reviewer-assigner/internal/http/handlers/teams"
+	tokensHandler "reviewer-assigner/internal/http/handlers/tokens"
 	usersHandler "reviewer-assigner/internal/http/handlers/users"
+	"reviewer-assigner/internal/http/middleware"
 	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/metrics"
+	"reviewer-assigner/internal/ratelimit"
+	accessService "reviewer-assigner/internal/service/access"
+	assignmentsService "reviewer-assigner/internal/service/assignments"
 	prService "reviewer-assigner/internal/service/pullrequests"
 	teamsService "reviewer-assigner/internal/service/teams"
+	tokensService "reviewer-assigner/internal/service/tokens"
 	usersService "reviewer-assigner/internal/service/users"
+	exportRepo "reviewer-assigner/internal/storage/export"
 	"reviewer-assigner/internal/storage/postgres"
 	pullRequestsRepo "reviewer-assigner/internal/storage/pullrequests"
+	rateLimitRepo "reviewer-assigner/internal/storage/ratelimit"
 	statsRepo "reviewer-assigner/internal/storage/stats"
 	teamsRepo "reviewer-assigner/internal/storage/teams"
+	tokensRepo "reviewer-assigner/internal/storage/tokens"
 	usersRepo "reviewer-assigner/internal/storage/users"
+	"reviewer-assigner/internal/tracing"
 	"syscall"
 	"time"
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
 	sloggin "github.com/samber/slog-gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 )
 
 func Run(ctx context.Context, cfg *config.Config, log *slog.Logger) {
-	dsnConnString := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		cfg.DB.Host, cfg.DB.Port, cfg.DB.User, cfg.DB.Password, cfg.DB.Name, cfg.DB.SslMode)
+	shutdownTracing, err := tracing.Setup(ctx, &cfg.Tracing)
+	if err != nil {
+		log.Error("failed to setup tracing", logger.ErrAttr(err))
+		return
+	}
 
-	pool, err := postgres.NewPool(ctx, dsnConnString)
+	pool, err := postgres.NewPool(ctx, dsn(&cfg.DB))
 	if err != nil {
 		log.Error("failed to create pool to database", logger.ErrAttr(err))
 		return
 	}
 
+	appMetrics := metrics.New()
+	appMetrics.RegisterPool(pool)
+
 	txManager := manager.Must(trmpgx.NewDefaultFactory(pool))
 
 	teamRepo := teamsRepo.NewPostgresTeamRepository(pool, trmpgx.DefaultCtxGetter)
@@ -54,23 +76,59 @@ func Run(ctx context.Context, cfg *config.Config, log *slog.Logger) {
 		trmpgx.DefaultCtxGetter,
 	)
 	statRepo := statsRepo.NewPostgresStatsRepository(pool, trmpgx.DefaultCtxGetter)
+	exportsRepo := exportRepo.NewPostgresExportRepository(pool, trmpgx.DefaultCtxGetter)
+	tokenRepo := tokensRepo.NewPostgresTokenRepository(pool, trmpgx.DefaultCtxGetter)
+
+	picker := reviewerPicker.NewRandomReviewerPicker(reviewerPicker.NewSource(cfg.Assignment.Seed))
 
-	teamService := teamsService.NewTeamService(log, teamRepo, txManager)
-	userService := usersService.NewUserService(log, userRepo, pullRequestRepo, txManager)
+	policy := accessService.NewPolicy(userRepo)
+
+	userService := usersService.NewUserService(log, userRepo, pullRequestRepo, policy, txManager)
 	pullRequestService := prService.NewPullRequestService(
 		log,
 		userRepo,
 		teamRepo,
 		pullRequestRepo,
-		&reviewerPicker.RandomReviewerPicker{},
-		reviewerAssigner.NewRandomReviewerReassigner(),
+		picker,
+		reviewerAssigner.NewRandomReviewerReassigner(picker),
+		picker.Strategy(),
+		appMetrics,
+		policy,
+		txManager,
+	)
+	teamService := teamsService.NewTeamService(log, teamRepo, pullRequestService, policy, txManager)
+	assignmentService := assignmentsService.NewAssignmentService(
+		log,
+		teamRepo,
+		pullRequestRepo,
 		txManager,
 	)
+	tokenService := tokensService.NewTokenService(log, tokenRepo, policy)
+
+	authenticator, err := auth.NewAuthenticator(log, &cfg.Auth, tokenService)
+	if err != nil {
+		log.Error("failed to create authenticator", logger.ErrAttr(err))
+		return
+	}
+	if authenticator == nil {
+		log.Warn("authentication is off, every endpoint is open to anyone who can reach the service")
+	}
+	authMiddleware := auth.NewMiddleware(log, authenticator)
+
+	limiter, err := NewLimiter(&cfg.RateLimit, pool)
+	if err != nil {
+		log.Error("failed to create rate limiter", logger.ErrAttr(err))
+		return
+	}
+	rateLimiter := middleware.NewRateLimiter(log, limiter, &cfg.RateLimit)
 
 	teamHandler := teamsHandler.NewTeamHandler(log, teamService)
 	userHandler := usersHandler.NewUserHandler(log, userService)
 	pullRequestHandler := prsHandler.NewPullRequestHandler(log, pullRequestService)
+	assignmentHandler := assignmentsHandler.NewAssignmentHandler(log, assignmentService)
 	statHandler := statsHandler.NewStatHandler(log, statRepo)
+	exportsHandler := exportHandler.NewExportHandler(log, exportsRepo)
+	tokenHandler := tokensHandler.NewTokenHandler(log, tokenService)
 
 	switch cfg.Env {
 	case config.EnvProd:
@@ -79,7 +137,24 @@ func Run(ctx context.Context, cfg *config.Config, log *slog.Logger) {
 		gin.SetMode(gin.DebugMode)
 	}
 
-	router := NewRouter(log, teamHandler, userHandler, pullRequestHandler, statHandler)
+	router, err := NewRouter(
+		log,
+		cfg.HTTPServer.TrustedProxies,
+		appMetrics,
+		teamHandler,
+		userHandler,
+		pullRequestHandler,
+		assignmentHandler,
+		statHandler,
+		exportsHandler,
+		tokenHandler,
+		authMiddleware,
+		rateLimiter,
+	)
+	if err != nil {
+		log.Error("failed to create router", logger.ErrAttr(err))
+		return
+	}
 
 	server := &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", cfg.HTTPServer.Address, cfg.HTTPServer.Port),
@@ -110,46 +185,171 @@ func Run(ctx context.Context, cfg *config.Config, log *slog.Logger) {
 	}
 
 	pool.Close()
+
+	if err = shutdownTracing(ctx); err != nil {
+		log.Error("failed to shutdown tracing", logger.ErrAttr(err))
+	}
+}
+
+func dsn(db *config.DB) string {
+	return fmt.Sprintf(
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		db.Host, db.Port, db.User, db.Password, db.Name, db.SslMode)
+}
+
+// NewLimiter picks the rate limit backend, the limiter is nil when rate limiting is off.
+func NewLimiter(cfg *config.RateLimit, pool *pgxpool.Pool) (ratelimit.Limiter, error) {
+	switch cfg.Backend {
+	case ratelimit.BackendNone:
+		return nil, nil //nolint:nilnil // no limiter turns rate limiting off
+	case ratelimit.BackendMemory:
+		return ratelimit.NewMemoryLimiter(), nil
+	case ratelimit.BackendPostgres:
+		return rateLimitRepo.NewPostgresLimiter(pool, trmpgx.DefaultCtxGetter), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ratelimit.ErrUnknownBackend, cfg.Backend)
+	}
 }
 
+// NewRouter trusts X-Forwarded-For only from trustedProxies, rate limits by IP rely on it.
 func NewRouter(
 	log *slog.Logger,
+	trustedProxies []string,
+	appMetrics *metrics.Metrics,
 	teamHandler *teamsHandler.TeamHandler,
 	userHandler *usersHandler.UserHandler,
 	pullRequestHandler *prsHandler.PullRequestHandler,
+	assignmentHandler *assignmentsHandler.AssignmentHandler,
 	statHandler *statsHandler.StatHandler,
-) *gin.Engine {
+	exportsHandler *exportHandler.ExportHandler,
+	tokenHandler *tokensHandler.TokenHandler,
+	authMiddleware *auth.Middleware,
+	rateLimiter *middleware.RateLimiter,
+) (*gin.Engine, error) {
 	r := gin.New()
+	if err := r.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
 
+	r.Use(middleware.RequestID())
+	r.Use(otelgin.Middleware(tracing.ServiceName))
 	r.Use(gin.Recovery())
 	r.Use(sloggin.New(log))
+	r.Use(appMetrics.GinMiddleware())
+
+	// metrics stay outside of authentication so scrapers need no token, they hold route templates,
+	// statuses and aggregate counters only, no ids or names. Expose the port only to the scraper network.
+	r.GET("/metrics", gin.WrapH(appMetrics.Handler()))
+
+	api := r.Group("", authMiddleware.Authenticate())
 
 	{
-		teamGroup := r.Group("/team")
-		teamGroup.POST("/add", teamHandler.AddTeam)
-		teamGroup.GET("/get", teamHandler.GetTeam)
+		teamGroup := api.Group("/team", rateLimiter.Limit("team"))
+		teamGroup.POST("/add", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.AddTeam)
+		teamGroup.GET("/get", authMiddleware.Require(access.ScopeTeamsRead), teamHandler.GetTeam)
+		teamGroup.POST("/addRule", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.AddRule)
+		teamGroup.POST("/removeRule", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.RemoveRule)
+		teamGroup.GET("/getRules", authMiddleware.Require(access.ScopeTeamsRead), teamHandler.GetRules)
+		teamGroup.POST("/addReviewRule", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.AddReviewRule)
+		teamGroup.POST(
+			"/removeReviewRule",
+			authMiddleware.Require(access.ScopeTeamsWrite),
+			teamHandler.RemoveReviewRule,
+		)
+		teamGroup.GET("/getReviewRules", authMiddleware.Require(access.ScopeTeamsRead), teamHandler.GetReviewRules)
+		teamGroup.POST(
+			"/setRequiredGroup",
+			authMiddleware.Require(access.ScopeTeamsWrite),
+			teamHandler.SetRequiredGroup,
+		)
+		teamGroup.POST(
+			"/removeRequiredGroup",
+			authMiddleware.Require(access.ScopeTeamsWrite),
+			teamHandler.RemoveRequiredGroup,
+		)
+		teamGroup.GET("/requiredGroups", authMiddleware.Require(access.ScopeTeamsRead), teamHandler.GetRequiredGroups)
+		teamGroup.POST("/addMember", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.AddMember)
+		teamGroup.POST("/removeMember", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.RemoveMember)
+		teamGroup.POST("/moveMember", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.MoveMember)
+		teamGroup.POST("/rename", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.RenameTeam)
+		teamGroup.POST("/delete", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.DeleteTeam)
+		teamGroup.PUT("/sync", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.SyncTeam)
+		teamGroup.POST("/setParent", authMiddleware.Require(access.ScopeTeamsWrite), teamHandler.SetParent)
+		teamGroup.GET("/tree", authMiddleware.Require(access.ScopeTeamsRead), teamHandler.GetTree)
 	}
 
 	{
-		userGroup := r.Group("/users")
-		userGroup.POST("/setIsActive", userHandler.SetIsActive)
-		userGroup.GET("/getReview", userHandler.GetReview)
+		userGroup := api.Group("/users", rateLimiter.Limit("users"))
+		userGroup.POST("/setIsActive", authMiddleware.Require(access.ScopeUsersWrite), userHandler.SetIsActive)
+		userGroup.GET("/getReview", authMiddleware.Require(access.ScopeUsersRead), userHandler.GetReview)
+		userGroup.GET("/get", authMiddleware.Require(access.ScopeUsersRead), userHandler.GetUser)
+		userGroup.GET("/list", authMiddleware.Require(access.ScopeUsersRead), userHandler.ListUsers)
+		userGroup.GET("/search", authMiddleware.Require(access.ScopeUsersRead), userHandler.SearchUsers)
 	}
 
 	{
-		pullRequestGroup := r.Group("/pullRequest")
+		pullRequestGroup := api.Group(
+			"/pullRequest",
+			rateLimiter.Limit("pullRequest"),
+			authMiddleware.Require(access.ScopePRsWrite),
+		)
 		pullRequestGroup.POST("/create", pullRequestHandler.Create)
 		pullRequestGroup.POST("/merge", pullRequestHandler.Merge)
 		pullRequestGroup.POST("/reassign", pullRequestHandler.Reassign)
+		pullRequestGroup.POST("/setReviewers", pullRequestHandler.SetReviewers)
+		pullRequestGroup.POST("/decline", pullRequestHandler.Decline)
+		pullRequestGroup.POST("/approve", pullRequestHandler.Approve)
+		pullRequestGroup.POST("/import", pullRequestHandler.Import)
+	}
+
+	// simulate is a POST for its body only, it reads history and writes nothing, so stats:read is enough
+	{
+		assignmentGroup := api.Group(
+			"/assignment",
+			rateLimiter.Limit("assignment"),
+			authMiddleware.Require(access.ScopeStatsRead),
+		)
+		assignmentGroup.POST("/simulate", assignmentHandler.Simulate)
 	}
 
 	{
-		statsGroup := r.Group("/stats")
+		statsGroup := api.Group(
+			"/stats",
+			rateLimiter.Limit("stats"),
+			authMiddleware.Require(access.ScopeStatsRead),
+		)
 		{
 			reviewerGroup := statsGroup.Group("/reviewers")
 			reviewerGroup.GET("/assignments", statHandler.GetStatsReviewersAssignments)
+			reviewerGroup.GET("/load", statHandler.GetStatsReviewersLoad)
+			reviewerGroup.GET("/declines", statHandler.GetStatsReviewersDeclines)
+		}
+		{
+			pullRequestGroup := statsGroup.Group("/pullRequests")
+			pullRequestGroup.GET("/timeseries", statHandler.GetStatsPullRequestsTimeseries)
 		}
 	}
 
-	return r
+	{
+		exportGroup := api.Group(
+			"/export",
+			rateLimiter.Limit("export"),
+			authMiddleware.Require(access.ScopeStatsRead),
+		)
+		exportGroup.GET("/teams", exportsHandler.ExportTeams)
+		exportGroup.GET("/pullRequests", exportsHandler.ExportPullRequests)
+		exportGroup.GET("/assignments", exportsHandler.ExportAssignments)
+	}
+
+	{
+		tokenGroup := api.Group(
+			"/tokens",
+			rateLimiter.Limit("tokens"),
+			authMiddleware.Require(access.ScopeTokensWrite),
+		)
+		tokenGroup.POST("/issue", tokenHandler.Issue)
+		tokenGroup.POST("/revoke", tokenHandler.Revoke)
+	}
+
+	return r, nil
 }
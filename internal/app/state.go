@@ -0,0 +1,398 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"reviewer-assigner/internal/config"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	stateDomain "reviewer-assigner/internal/domain/state"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	stateService "reviewer-assigner/internal/service/state"
+	"reviewer-assigner/internal/storage/postgres"
+	stateRepo "reviewer-assigner/internal/storage/state"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+)
+
+// RunExportState is the admin subcommand that writes the whole state as a JSON archive,
+// to the file given by -o or to out.
+func RunExportState(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("export-state", flag.ContinueOnError)
+	output := flags.String("o", "", "archive file, stdout by default")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	// logs go to stdout too and would break the archive, errors still reach the caller
+	if *output == "" {
+		log = slog.New(slog.DiscardHandler)
+	}
+
+	service, closeService, err := newStateService(ctx, cfg, log)
+	if err != nil {
+		return err
+	}
+	defer closeService()
+
+	archive, err := service.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return writeArchive(out, archive)
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return fmt.Errorf("failed to create archive file: %w", err)
+	}
+	if err = writeArchive(file, archive); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	// a backup that failed to reach the disk must not look like a good one
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("failed to close archive file: %w", err)
+	}
+
+	return nil
+}
+
+func writeArchive(out io.Writer, archive *stateDomain.Archive) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(archiveToJSON(archive)); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	return nil
+}
+
+// RunImportState is the admin subcommand that restores a JSON archive from the file given by -i or from in.
+// The database must be migrated and empty.
+func RunImportState(
+	ctx context.Context,
+	cfg *config.Config,
+	log *slog.Logger,
+	args []string,
+	in io.Reader,
+	out io.Writer,
+) error {
+	flags := flag.NewFlagSet("import-state", flag.ContinueOnError)
+	input := flags.String("i", "", "archive file, stdin by default")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *input != "" {
+		file, err := os.Open(*input)
+		if err != nil {
+			return fmt.Errorf("failed to open archive file: %w", err)
+		}
+		defer file.Close()
+
+		in = file
+	}
+
+	var archiveJSON ArchiveJSON
+	decoder := json.NewDecoder(in)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&archiveJSON); err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	service, closeService, err := newStateService(ctx, cfg, log)
+	if err != nil {
+		return err
+	}
+	defer closeService()
+
+	archive := archiveJSON.toDomain()
+	if err = service.Restore(ctx, archive); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(
+		out,
+		"restored: %d users, %d teams, %d pull requests\n",
+		len(archive.Users),
+		len(archive.Teams),
+		len(archive.PullRequests),
+	)
+
+	return err
+}
+
+func newStateService(
+	ctx context.Context,
+	cfg *config.Config,
+	log *slog.Logger,
+) (*stateService.StateService, func(), error) {
+	pool, err := postgres.NewPool(ctx, dsn(&cfg.DB))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create pool to database: %w", err)
+	}
+
+	service := stateService.NewStateService(
+		log,
+		stateRepo.NewPostgresStateRepository(pool, trmpgx.DefaultCtxGetter),
+		manager.Must(trmpgx.NewDefaultFactory(pool)),
+	)
+
+	return service, pool.Close, nil
+}
+
+// ArchiveJSON is the archive file layout, changing it means bumping state.Version.
+type ArchiveJSON struct {
+	Version      int               `json:"version"`
+	ExportedAt   time.Time         `json:"exported_at"`
+	Users        []UserJSON        `json:"users"`
+	Teams        []TeamJSON        `json:"teams"`
+	PullRequests []PullRequestJSON `json:"pull_requests"`
+}
+
+type UserJSON struct {
+	UserID   string `json:"user_id"`
+	Name     string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type MemberJSON struct {
+	UserID    string `json:"user_id"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+type RuleJSON struct {
+	Kind        string `json:"kind"`
+	UserID      string `json:"user_id"`
+	OtherUserID string `json:"other_user_id"`
+}
+
+type ReviewRuleJSON struct {
+	MinLinesChanged int    `json:"min_lines_changed"`
+	Label           string `json:"label"`
+	ReviewersCount  int    `json:"reviewers_count"`
+	ExtraTeamName   string `json:"extra_team_name"`
+}
+
+type RequiredGroupJSON struct {
+	Labels       []string `json:"labels"`
+	PathPrefixes []string `json:"path_prefixes"`
+}
+
+type TeamJSON struct {
+	Name          string             `json:"team_name"`
+	ParentName    string             `json:"parent_team_name"`
+	Members       []MemberJSON       `json:"members"`
+	Rules         []RuleJSON         `json:"rules"`
+	ReviewRules   []ReviewRuleJSON   `json:"review_rules"`
+	RequiredGroup *RequiredGroupJSON `json:"required_group"`
+}
+
+type ReviewerJSON struct {
+	UserID        string     `json:"user_id"`
+	GroupTeamName string     `json:"group_team_name"`
+	ApprovedAt    *time.Time `json:"approved_at"`
+}
+
+type DeclineJSON struct {
+	ReviewerID string    `json:"reviewer_id"`
+	Reason     string    `json:"reason"`
+	Comment    string    `json:"comment"`
+	DeclinedAt time.Time `json:"declined_at"`
+}
+
+type PullRequestJSON struct {
+	ID        string         `json:"pull_request_id"`
+	Name      string         `json:"pull_request_name"`
+	AuthorID  string         `json:"author_id"`
+	TeamName  string         `json:"team_name"`
+	Status    string         `json:"status"`
+	CreatedAt time.Time      `json:"created_at"`
+	MergedAt  *time.Time     `json:"merged_at"`
+	Reviewers []ReviewerJSON `json:"reviewers"`
+	Declines  []DeclineJSON  `json:"declines"`
+}
+
+func archiveToJSON(archive *stateDomain.Archive) ArchiveJSON {
+	archiveJSON := ArchiveJSON{
+		Version:      archive.Version,
+		ExportedAt:   archive.ExportedAt,
+		Users:        make([]UserJSON, 0, len(archive.Users)),
+		Teams:        make([]TeamJSON, 0, len(archive.Teams)),
+		PullRequests: make([]PullRequestJSON, 0, len(archive.PullRequests)),
+	}
+
+	for _, user := range archive.Users {
+		archiveJSON.Users = append(archiveJSON.Users, UserJSON{
+			UserID:   user.ID,
+			Name:     user.Name,
+			IsActive: user.IsActive,
+		})
+	}
+	for i := range archive.Teams {
+		archiveJSON.Teams = append(archiveJSON.Teams, teamToJSON(&archive.Teams[i]))
+	}
+	for i := range archive.PullRequests {
+		archiveJSON.PullRequests = append(archiveJSON.PullRequests, pullRequestToJSON(&archive.PullRequests[i]))
+	}
+
+	return archiveJSON
+}
+
+func teamToJSON(team *stateDomain.Team) TeamJSON {
+	teamJSON := TeamJSON{
+		Name:        team.Name,
+		ParentName:  team.ParentName,
+		Members:     make([]MemberJSON, 0, len(team.Members)),
+		Rules:       make([]RuleJSON, 0, len(team.Rules)),
+		ReviewRules: make([]ReviewRuleJSON, 0, len(team.ReviewRules)),
+	}
+
+	for _, member := range team.Members {
+		teamJSON.Members = append(teamJSON.Members, MemberJSON{UserID: member.UserID, IsPrimary: member.IsPrimary})
+	}
+	for _, rule := range team.Rules {
+		teamJSON.Rules = append(teamJSON.Rules, RuleJSON{
+			Kind:        string(rule.Kind),
+			UserID:      rule.UserID,
+			OtherUserID: rule.OtherUserID,
+		})
+	}
+	for _, reviewRule := range team.ReviewRules {
+		teamJSON.ReviewRules = append(teamJSON.ReviewRules, ReviewRuleJSON(reviewRule))
+	}
+	if team.RequiredGroup != nil {
+		teamJSON.RequiredGroup = &RequiredGroupJSON{
+			Labels:       team.RequiredGroup.Labels,
+			PathPrefixes: team.RequiredGroup.PathPrefixes,
+		}
+	}
+
+	return teamJSON
+}
+
+func pullRequestToJSON(pullRequest *stateDomain.PullRequest) PullRequestJSON {
+	pullRequestJSON := PullRequestJSON{
+		ID:        pullRequest.ID,
+		Name:      pullRequest.Name,
+		AuthorID:  pullRequest.AuthorID,
+		TeamName:  pullRequest.TeamName,
+		Status:    string(pullRequest.Status),
+		CreatedAt: pullRequest.CreatedAt,
+		MergedAt:  pullRequest.MergedAt,
+		Reviewers: make([]ReviewerJSON, 0, len(pullRequest.Reviewers)),
+		Declines:  make([]DeclineJSON, 0, len(pullRequest.Declines)),
+	}
+
+	for _, reviewer := range pullRequest.Reviewers {
+		pullRequestJSON.Reviewers = append(pullRequestJSON.Reviewers, ReviewerJSON(reviewer))
+	}
+	for _, decline := range pullRequest.Declines {
+		pullRequestJSON.Declines = append(pullRequestJSON.Declines, DeclineJSON{
+			ReviewerID: decline.ReviewerID,
+			Reason:     string(decline.Reason),
+			Comment:    decline.Comment,
+			DeclinedAt: decline.DeclinedAt,
+		})
+	}
+
+	return pullRequestJSON
+}
+
+func (a *ArchiveJSON) toDomain() *stateDomain.Archive {
+	archive := &stateDomain.Archive{
+		Version:      a.Version,
+		ExportedAt:   a.ExportedAt,
+		Users:        make([]stateDomain.User, 0, len(a.Users)),
+		Teams:        make([]stateDomain.Team, 0, len(a.Teams)),
+		PullRequests: make([]stateDomain.PullRequest, 0, len(a.PullRequests)),
+	}
+
+	for _, user := range a.Users {
+		archive.Users = append(archive.Users, stateDomain.User{
+			ID:       user.UserID,
+			Name:     user.Name,
+			IsActive: user.IsActive,
+		})
+	}
+	for i := range a.Teams {
+		archive.Teams = append(archive.Teams, a.Teams[i].toDomain())
+	}
+	for i := range a.PullRequests {
+		archive.PullRequests = append(archive.PullRequests, a.PullRequests[i].toDomain())
+	}
+
+	return archive
+}
+
+func (t *TeamJSON) toDomain() stateDomain.Team {
+	team := stateDomain.Team{
+		Name:        t.Name,
+		ParentName:  t.ParentName,
+		Members:     make([]stateDomain.Member, 0, len(t.Members)),
+		Rules:       make([]teamsDomain.Rule, 0, len(t.Rules)),
+		ReviewRules: make([]teamsDomain.ReviewRule, 0, len(t.ReviewRules)),
+	}
+
+	for _, member := range t.Members {
+		team.Members = append(team.Members, stateDomain.Member(member))
+	}
+	for _, rule := range t.Rules {
+		team.Rules = append(team.Rules, teamsDomain.Rule{
+			Kind:        teamsDomain.RuleKind(rule.Kind),
+			UserID:      rule.UserID,
+			OtherUserID: rule.OtherUserID,
+		})
+	}
+	for _, reviewRule := range t.ReviewRules {
+		team.ReviewRules = append(team.ReviewRules, teamsDomain.ReviewRule(reviewRule))
+	}
+	if t.RequiredGroup != nil {
+		team.RequiredGroup = &teamsDomain.RequiredGroup{
+			TeamName:     t.Name,
+			Labels:       t.RequiredGroup.Labels,
+			PathPrefixes: t.RequiredGroup.PathPrefixes,
+		}
+	}
+
+	return team
+}
+
+func (p *PullRequestJSON) toDomain() stateDomain.PullRequest {
+	pullRequest := stateDomain.PullRequest{
+		ID:        p.ID,
+		Name:      p.Name,
+		AuthorID:  p.AuthorID,
+		TeamName:  p.TeamName,
+		Status:    prsDomain.StatusPR(p.Status),
+		CreatedAt: p.CreatedAt,
+		MergedAt:  p.MergedAt,
+		Reviewers: make([]stateDomain.Reviewer, 0, len(p.Reviewers)),
+		Declines:  make([]stateDomain.Decline, 0, len(p.Declines)),
+	}
+
+	for _, reviewer := range p.Reviewers {
+		pullRequest.Reviewers = append(pullRequest.Reviewers, stateDomain.Reviewer(reviewer))
+	}
+	for _, decline := range p.Declines {
+		pullRequest.Declines = append(pullRequest.Declines, stateDomain.Decline{
+			ReviewerID: decline.ReviewerID,
+			Reason:     prsDomain.DeclineReason(decline.Reason),
+			Comment:    decline.Comment,
+			DeclinedAt: decline.DeclinedAt,
+		})
+	}
+
+	return pullRequest
+}
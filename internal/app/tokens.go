@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/domain/access"
+	accessService "reviewer-assigner/internal/service/access"
+	tokensService "reviewer-assigner/internal/service/tokens"
+	"reviewer-assigner/internal/storage/postgres"
+	tokensRepo "reviewer-assigner/internal/storage/tokens"
+	usersRepo "reviewer-assigner/internal/storage/users"
+	"strings"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+)
+
+var ErrUnknownCommand = errors.New("unknown command")
+
+const tokensUsage = `usage:
+  tokens issue -actor <user_id> [-role admin|team_admin|member] -scopes <scope,scope>
+  tokens revoke -id <token_id>`
+
+// RunTokens is the admin subcommand that issues and revokes API tokens,
+// it is the only way to get the first tokens:write token.
+func RunTokens(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w\n%s", ErrUnknownCommand, tokensUsage)
+	}
+
+	pool, err := postgres.NewPool(ctx, dsn(&cfg.DB))
+	if err != nil {
+		return fmt.Errorf("failed to create pool to database: %w", err)
+	}
+	defer pool.Close()
+
+	// the CLI runs without an actor, so the policy lets it manage tokens
+	tokenService := tokensService.NewTokenService(
+		log,
+		tokensRepo.NewPostgresTokenRepository(pool, trmpgx.DefaultCtxGetter),
+		accessService.NewPolicy(usersRepo.NewPostgresUserRepository(pool, trmpgx.DefaultCtxGetter)),
+	)
+
+	switch args[0] {
+	case "issue":
+		return issueToken(ctx, tokenService, args[1:], out)
+	case "revoke":
+		return revokeToken(ctx, tokenService, args[1:], out)
+	default:
+		return fmt.Errorf("%w: %s\n%s", ErrUnknownCommand, args[0], tokensUsage)
+	}
+}
+
+func issueToken(ctx context.Context, tokenService *tokensService.TokenService, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("tokens issue", flag.ContinueOnError)
+	actor := flags.String("actor", "", "token owner, e.g. user_id or service name")
+	role := flags.String("role", string(access.RoleMember), "admin, team_admin or member, the first token needs admin")
+	scopes := flags.String("scopes", "", "comma separated scopes: "+scopesList())
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var tokenScopes []access.Scope
+	for scope := range strings.SplitSeq(*scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			tokenScopes = append(tokenScopes, access.Scope(scope))
+		}
+	}
+
+	token, plain, err := tokenService.Issue(ctx, *actor, access.Role(*role), tokenScopes)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "token_id: %s\ntoken: %s\n", token.ID, plain)
+
+	return err
+}
+
+func revokeToken(ctx context.Context, tokenService *tokensService.TokenService, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("tokens revoke", flag.ContinueOnError)
+	tokenID := flags.String("id", "", "token_id to revoke")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if err := tokenService.Revoke(ctx, *tokenID); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(out, "revoked: %s\n", *tokenID)
+
+	return err
+}
+
+func scopesList() string {
+	scopes := make([]string, 0, len(access.Scopes()))
+	for _, scope := range access.Scopes() {
+		scopes = append(scopes, string(scope))
+	}
+
+	return strings.Join(scopes, ",")
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/config"
+	"time"
+)
+
+var ErrJWKSURLRequired = errors.New("jwks url is required in jwt auth mode")
+
+const jwksTimeout = 5 * time.Second
+
+// NewAuthenticator picks the authenticator for the configured mode, it is nil when authentication is off.
+func NewAuthenticator(log *slog.Logger, cfg *config.Auth, tokenService TokenService) (Authenticator, error) {
+	switch cfg.Mode {
+	case config.AuthModeNone:
+		return nil, nil //nolint:nilnil // no authenticator disables authentication
+	case config.AuthModeToken:
+		return NewTokenAuthenticator(tokenService), nil
+	case config.AuthModeJWT:
+		if cfg.JWT.JWKSURL == "" {
+			return nil, ErrJWKSURLRequired
+		}
+		jwks := NewJWKS(log, cfg.JWT.JWKSURL, cfg.JWT.CacheTTL, &http.Client{Timeout: jwksTimeout})
+
+		return NewJWTAuthenticator(&cfg.JWT, jwks), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMode, cfg.Mode)
+	}
+}
@@ -0,0 +1,121 @@
+// Package authtest provides an in-process OIDC issuer, so JWT authentication is tested offline.
+package authtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	IssuerName = "https://issuer.test"
+	Audience   = "reviewer-assigner"
+
+	keyBits = 2048
+)
+
+// Issuer signs tokens with an RSA key and serves the matching JWKS over HTTP.
+type Issuer struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	kid      string
+	key      *rsa.PrivateKey
+	requests atomic.Int64
+}
+
+func NewIssuer(t *testing.T) *Issuer {
+	t.Helper()
+
+	i := &Issuer{}
+	i.Rotate(t)
+
+	i.server = httptest.NewServer(http.HandlerFunc(i.serveJWKS))
+	t.Cleanup(i.server.Close)
+
+	return i
+}
+
+func (i *Issuer) JWKSURL() string {
+	return i.server.URL + "/.well-known/jwks.json"
+}
+
+// Requests is the number of times the JWKS was fetched.
+func (i *Issuer) Requests() int {
+	return int(i.requests.Load())
+}
+
+// Rotate replaces the signing key, the old one is no longer published.
+func (i *Issuer) Rotate(t *testing.T) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, keyBits)
+	require.NoError(t, err)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.key = key
+	i.kid = rand.Text()
+}
+
+// Issue signs claims, registered claims missing from them are filled with valid defaults.
+// A nil claim value drops the claim.
+func (i *Issuer) Issue(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	now := time.Now()
+	defaults := jwt.MapClaims{
+		"iss": IssuerName,
+		"aud": Audience,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(defaults, k)
+			continue
+		}
+		defaults[k] = v
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, defaults)
+	token.Header["kid"] = i.kid
+
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func (i *Issuer) serveJWKS(w http.ResponseWriter, _ *http.Request) {
+	i.requests.Add(1)
+
+	i.mu.Lock()
+	key := map[string]string{
+		"kid": i.kid,
+		"kty": "RSA",
+		"alg": "RS256",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(i.key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(i.key.E)).Bytes()),
+	}
+	i.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"keys": []any{key}})
+}
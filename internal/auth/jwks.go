@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"reviewer-assigner/internal/logger"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// minRefreshInterval stops tokens with made-up kids from hammering the JWKS endpoint.
+const minRefreshInterval = time.Minute
+
+// JWKS caches the public keys of an OIDC provider for ttl.
+// A kid missing from the cache triggers a refresh, so key rotation is picked up before ttl expires.
+// The fetch runs outside of the lock and concurrent refreshes share it, a slow provider blocks only
+// the requests that need fresh keys. Cached keys outlive ttl while the provider is unreachable,
+// the refresh is then retried once per minRefreshInterval.
+type JWKS struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	refresh singleflight.Group
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	failedAt  time.Time
+	now       func() time.Time
+
+	log *slog.Logger
+}
+
+func NewJWKS(log *slog.Logger, url string, ttl time.Duration, client *http.Client) *JWKS {
+	return &JWKS{
+		url:    url,
+		ttl:    ttl,
+		client: client,
+		now:    time.Now,
+		log:    log,
+	}
+}
+
+func (j *JWKS) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	j.mu.RLock()
+	now := j.now()
+	age := now.Sub(j.fetchedAt)
+	failedAgo := now.Sub(j.failedAt)
+	key, ok := j.keys[kid]
+	cached := j.keys != nil
+	j.mu.RUnlock()
+
+	if ok && (age < j.ttl || failedAgo < minRefreshInterval) {
+		return key, nil
+	}
+	if cached && !ok && age < minRefreshInterval {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+	}
+
+	keys, err := j.refreshKeys(ctx)
+	if err != nil && ok {
+		j.log.WarnContext(ctx, "failed to refresh jwks, serving the cached key",
+			slog.String("kid", kid),
+			logger.ErrAttr(err),
+		)
+
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok = keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+	}
+
+	return key, nil
+}
+
+// refreshKeys fetches the keys once for all concurrent callers.
+// The fetch is not canceled with the first caller, the client timeout bounds it.
+func (j *JWKS) refreshKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	keys, err, _ := j.refresh.Do(j.url, func() (any, error) {
+		keys, err := j.fetch(context.WithoutCancel(ctx))
+		if err != nil {
+			j.mu.Lock()
+			j.failedAt = j.now()
+			j.mu.Unlock()
+
+			return nil, err
+		}
+
+		j.mu.Lock()
+		j.keys = keys
+		j.fetchedAt = j.now()
+		j.mu.Unlock()
+
+		return keys, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fetched, _ := keys.(map[string]crypto.PublicKey)
+
+	return fetched, nil
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (j *JWKS) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build jwks request: %w", err)
+	}
+
+	res, err := j.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: status %d", res.StatusCode)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		// keys of unsupported types are skipped, the provider may publish more than we verify
+		if key, err := k.publicKey(); err == nil {
+			keys[k.Kid] = key
+		}
+	}
+
+	return keys, nil
+}
+
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
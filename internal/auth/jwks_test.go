@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/auth/authtest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestJWKS_Key(t *testing.T) {
+	issuer := authtest.NewIssuer(t)
+	jwks := NewJWKS(discardLogger(), issuer.JWKSURL(), 10*time.Minute, http.DefaultClient)
+
+	now := time.Now()
+	jwks.now = func() time.Time { return now }
+
+	kid := parseKid(t, issuer.Issue(t, nil))
+
+	key, err := jwks.Key(context.Background(), kid)
+	require.NoError(t, err)
+	assert.NotNil(t, key)
+	assert.Equal(t, 1, issuer.Requests())
+
+	// cached
+	_, err = jwks.Key(context.Background(), kid)
+	require.NoError(t, err)
+	assert.Equal(t, 1, issuer.Requests())
+
+	// unknown kids do not refresh more often than minRefreshInterval
+	_, err = jwks.Key(context.Background(), "unknown")
+	require.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, 1, issuer.Requests())
+
+	// rotated key is picked up before ttl expires
+	issuer.Rotate(t)
+	rotatedKid := parseKid(t, issuer.Issue(t, nil))
+	now = now.Add(minRefreshInterval)
+
+	_, err = jwks.Key(context.Background(), rotatedKid)
+	require.NoError(t, err)
+	assert.Equal(t, 2, issuer.Requests())
+
+	// expired cache is refreshed even for a known kid
+	now = now.Add(10 * time.Minute)
+
+	_, err = jwks.Key(context.Background(), rotatedKid)
+	require.NoError(t, err)
+	assert.Equal(t, 3, issuer.Requests())
+}
+
+func TestJWKS_Key_Unreachable(t *testing.T) {
+	jwks := NewJWKS(discardLogger(), "http://127.0.0.1:0/jwks.json", time.Minute, http.DefaultClient)
+
+	_, err := jwks.Key(context.Background(), "kid")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrUnknownKey)
+}
+
+// failingTransport fails JWKS requests while down is set.
+type failingTransport struct {
+	down bool
+}
+
+func (t *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.down {
+		return nil, errors.New("connection refused")
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestJWKS_Key_ProviderDown(t *testing.T) {
+	issuer := authtest.NewIssuer(t)
+	transport := &failingTransport{}
+	jwks := NewJWKS(discardLogger(), issuer.JWKSURL(), 10*time.Minute, &http.Client{Transport: transport})
+
+	now := time.Now()
+	jwks.now = func() time.Time { return now }
+
+	kid := parseKid(t, issuer.Issue(t, nil))
+	_, err := jwks.Key(context.Background(), kid)
+	require.NoError(t, err)
+
+	// the cached key is served after ttl while the provider is down
+	transport.down = true
+	now = now.Add(10 * time.Minute)
+
+	key, err := jwks.Key(context.Background(), kid)
+	require.NoError(t, err)
+	assert.NotNil(t, key)
+
+	// kids never seen still fail
+	now = now.Add(minRefreshInterval)
+	_, err = jwks.Key(context.Background(), "unknown")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrUnknownKey)
+
+	// the refresh is retried once the provider is back
+	transport.down = false
+	now = now.Add(minRefreshInterval)
+
+	_, err = jwks.Key(context.Background(), kid)
+	require.NoError(t, err)
+	assert.Equal(t, 2, issuer.Requests())
+}
+
+// blockingTransport holds JWKS requests until release is closed once block is set.
+type blockingTransport struct {
+	block   bool
+	started chan struct{}
+	release chan struct{}
+}
+
+func (t *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.block {
+		t.started <- struct{}{}
+		<-t.release
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestJWKS_Key_SlowRefresh(t *testing.T) {
+	issuer := authtest.NewIssuer(t)
+	transport := &blockingTransport{started: make(chan struct{}, 1), release: make(chan struct{})}
+	jwks := NewJWKS(discardLogger(), issuer.JWKSURL(), 10*time.Minute, &http.Client{Transport: transport})
+
+	now := time.Now()
+	jwks.now = func() time.Time { return now }
+
+	kid := parseKid(t, issuer.Issue(t, nil))
+	_, err := jwks.Key(context.Background(), kid)
+	require.NoError(t, err)
+
+	issuer.Rotate(t)
+	rotatedKid := parseKid(t, issuer.Issue(t, nil))
+	now = now.Add(minRefreshInterval)
+	transport.block = true
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			_, err := jwks.Key(context.Background(), rotatedKid)
+			assert.NoError(t, err)
+		})
+	}
+	<-transport.started
+
+	// the cached key is served while the refresh is in flight
+	cached := make(chan error)
+	go func() {
+		_, err := jwks.Key(context.Background(), kid)
+		cached <- err
+	}()
+	select {
+	case err = <-cached:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("cached key is blocked by the refresh")
+	}
+
+	close(transport.release)
+	wg.Wait()
+
+	// concurrent refreshes share a single fetch
+	assert.Equal(t, 2, issuer.Requests())
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/domain/access"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTAuthenticator accepts JWTs of an OIDC provider verified against its JWKS.
+type JWTAuthenticator struct {
+	jwks          *JWKS
+	parser        *jwt.Parser
+	cfg           *config.JWT
+	defaultScopes []access.Scope
+}
+
+func NewJWTAuthenticator(cfg *config.JWT, jwks *JWKS) *JWTAuthenticator {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	defaultScopes := knownScopes(cfg.DefaultScopes)
+	if len(cfg.DefaultScopes) == 0 {
+		defaultScopes = access.ReadScopes()
+	}
+
+	return &JWTAuthenticator{
+		jwks:          jwks,
+		parser:        jwt.NewParser(opts...),
+		cfg:           cfg,
+		defaultScopes: defaultScopes,
+	}
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, raw string) (*access.Actor, error) {
+	var keyErr error
+	claims := jwt.MapClaims{}
+
+	_, err := a.parser.ParseWithClaims(raw, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		key, err := a.jwks.Key(ctx, kid)
+		if err != nil && !errors.Is(err, ErrUnknownKey) {
+			// the provider is unreachable, it is our failure and not the caller's
+			keyErr = err
+		}
+
+		return key, err
+	})
+	if keyErr != nil {
+		return nil, keyErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	userID, _ := claims[a.cfg.UserIDClaim].(string)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: missing %s claim", ErrUnauthenticated, a.cfg.UserIDClaim)
+	}
+
+	return &access.Actor{
+		ID:     userID,
+		Role:   roleFromClaim(claims[a.cfg.RolesClaim]),
+		Scopes: a.scopesFromClaim(claims["scope"]),
+	}, nil
+}
+
+// roleFromClaim picks the strongest known role, callers without one are members.
+func roleFromClaim(claim any) access.Role {
+	var roles []string
+	switch v := claim.(type) {
+	case string:
+		roles = strings.Fields(v)
+	case []any:
+		for _, role := range v {
+			if s, ok := role.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+	}
+
+	for _, role := range access.Roles() {
+		if slices.Contains(roles, string(role)) {
+			return role
+		}
+	}
+
+	return access.RoleMember
+}
+
+// scopesFromClaim reads the space separated OAuth scope claim. Provider tokens usually carry only
+// OIDC scopes such as openid or none at all, without any of ours they get the configured default scopes.
+func (a *JWTAuthenticator) scopesFromClaim(claim any) []access.Scope {
+	s, _ := claim.(string)
+
+	scopes := knownScopes(strings.Fields(s))
+	if len(scopes) == 0 {
+		return a.defaultScopes
+	}
+
+	return scopes
+}
+
+// knownScopes drops the scopes the API does not know, e.g. openid.
+func knownScopes(names []string) []access.Scope {
+	var scopes []access.Scope
+	for _, name := range names {
+		if slices.Contains(access.Scopes(), access.Scope(name)) {
+			scopes = append(scopes, access.Scope(name))
+		}
+	}
+
+	return scopes
+}
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"reviewer-assigner/internal/auth/authtest"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/domain/access"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseKid(t *testing.T, raw string) string {
+	t.Helper()
+
+	token, _, err := jwt.NewParser().ParseUnverified(raw, jwt.MapClaims{})
+	require.NoError(t, err)
+
+	kid, _ := token.Header["kid"].(string)
+	return kid
+}
+
+func newTestJWTAuthenticator(issuer *authtest.Issuer) *JWTAuthenticator {
+	cfg := &config.JWT{
+		JWKSURL:       issuer.JWKSURL(),
+		CacheTTL:      10 * time.Minute,
+		Issuer:        authtest.IssuerName,
+		Audience:      authtest.Audience,
+		UserIDClaim:   "sub",
+		RolesClaim:    "roles",
+		DefaultScopes: []string{"teams:read", "users:read", "unknown"},
+	}
+
+	return NewJWTAuthenticator(cfg, NewJWKS(discardLogger(), cfg.JWKSURL, cfg.CacheTTL, http.DefaultClient))
+}
+
+func TestJWTAuthenticator_Authenticate(t *testing.T) {
+	issuer := authtest.NewIssuer(t)
+	authenticator := newTestJWTAuthenticator(issuer)
+
+	testCases := []struct {
+		name          string
+		claims        jwt.MapClaims
+		expectedActor *access.Actor
+		expectedErr   error
+	}{
+		{
+			name:   "member_with_default_scopes",
+			claims: jwt.MapClaims{"sub": "u1"},
+			expectedActor: &access.Actor{
+				ID:     "u1",
+				Role:   access.RoleMember,
+				Scopes: []access.Scope{access.ScopeTeamsRead, access.ScopeUsersRead},
+			},
+		},
+		{
+			name:   "strongest_role",
+			claims: jwt.MapClaims{"sub": "u1", "roles": []string{"member", "team_admin", "unknown"}},
+			expectedActor: &access.Actor{
+				ID:     "u1",
+				Role:   access.RoleTeamAdmin,
+				Scopes: []access.Scope{access.ScopeTeamsRead, access.ScopeUsersRead},
+			},
+		},
+		{
+			name:   "scope_claim",
+			claims: jwt.MapClaims{"sub": "u1", "roles": "admin", "scope": "openid stats:read"},
+			expectedActor: &access.Actor{
+				ID:     "u1",
+				Role:   access.RoleAdmin,
+				Scopes: []access.Scope{access.ScopeStatsRead},
+			},
+		},
+		{
+			name:   "oidc_scopes_only",
+			claims: jwt.MapClaims{"sub": "u1", "scope": "openid profile email"},
+			expectedActor: &access.Actor{
+				ID:     "u1",
+				Role:   access.RoleMember,
+				Scopes: []access.Scope{access.ScopeTeamsRead, access.ScopeUsersRead},
+			},
+		},
+		{
+			name:        "missing_subject",
+			claims:      jwt.MapClaims{},
+			expectedErr: ErrUnauthenticated,
+		},
+		{
+			name:        "expired",
+			claims:      jwt.MapClaims{"sub": "u1", "exp": time.Now().Add(-time.Hour).Unix()},
+			expectedErr: ErrUnauthenticated,
+		},
+		{
+			name:        "missing_expiration",
+			claims:      jwt.MapClaims{"sub": "u1", "exp": nil},
+			expectedErr: ErrUnauthenticated,
+		},
+		{
+			name:        "wrong_issuer",
+			claims:      jwt.MapClaims{"sub": "u1", "iss": "https://evil.test"},
+			expectedErr: ErrUnauthenticated,
+		},
+		{
+			name:        "wrong_audience",
+			claims:      jwt.MapClaims{"sub": "u1", "aud": "other"},
+			expectedErr: ErrUnauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actor, err := authenticator.Authenticate(context.Background(), issuer.Issue(t, tc.claims))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedActor, actor)
+		})
+	}
+}
+
+func TestJWTAuthenticator_Authenticate_ReadScopesByDefault(t *testing.T) {
+	issuer := authtest.NewIssuer(t)
+	cfg := &config.JWT{JWKSURL: issuer.JWKSURL(), CacheTTL: time.Minute, UserIDClaim: "sub"}
+	authenticator := NewJWTAuthenticator(cfg, NewJWKS(discardLogger(), cfg.JWKSURL, cfg.CacheTTL, http.DefaultClient))
+
+	actor, err := authenticator.Authenticate(context.Background(), issuer.Issue(t, jwt.MapClaims{"sub": "u1"}))
+	require.NoError(t, err)
+	assert.Equal(t, access.ReadScopes(), actor.Scopes)
+}
+
+func TestJWTAuthenticator_Authenticate_ForeignKey(t *testing.T) {
+	issuer := authtest.NewIssuer(t)
+	foreign := authtest.NewIssuer(t)
+	authenticator := newTestJWTAuthenticator(issuer)
+
+	_, err := authenticator.Authenticate(context.Background(), foreign.Issue(t, jwt.MapClaims{"sub": "u1"}))
+	require.ErrorIs(t, err, ErrUnauthenticated)
+	require.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestJWTAuthenticator_Authenticate_Malformed(t *testing.T) {
+	issuer := authtest.NewIssuer(t)
+	authenticator := newTestJWTAuthenticator(issuer)
+
+	_, err := authenticator.Authenticate(context.Background(), "not-a-jwt")
+	require.ErrorIs(t, err, ErrUnauthenticated)
+	assert.Equal(t, 0, issuer.Requests())
+}
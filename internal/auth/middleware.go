@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/domain/access"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/logger"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	ErrUnknownMode = errors.New("unknown auth mode")
+	// ErrUnauthenticated is returned by authenticators for credentials that must be rejected with 401.
+	ErrUnauthenticated = errors.New("unauthenticated")
+)
+
+const bearerPrefix = "Bearer "
+
+// Authenticator resolves a bearer credential into the calling actor.
+type Authenticator interface {
+	Authenticate(ctx context.Context, credential string) (*access.Actor, error)
+}
+
+type Middleware struct {
+	authenticator Authenticator
+
+	log *slog.Logger
+}
+
+// NewMiddleware builds the middleware, a nil authenticator disables authentication.
+func NewMiddleware(log *slog.Logger, authenticator Authenticator) *Middleware {
+	return &Middleware{authenticator: authenticator, log: log}
+}
+
+func (m *Middleware) enabled() bool {
+	return m.authenticator != nil
+}
+
+// Authenticate resolves the bearer credential into an Actor stored in the request context.
+func (m *Middleware) Authenticate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !m.enabled() {
+			c.Next()
+			return
+		}
+
+		const op = "auth.Authenticate"
+		log := m.log.With(slog.String("op", op))
+
+		header := c.GetHeader("Authorization")
+		credential, ok := strings.CutPrefix(header, bearerPrefix)
+		if !ok || credential == "" {
+			log.WarnContext(c.Request.Context(), "missing bearer credential")
+
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnauthorized),
+			)
+			return
+		}
+
+		actor, err := m.authenticator.Authenticate(c.Request.Context(), credential)
+		if errors.Is(err, ErrUnauthenticated) {
+			log.WarnContext(c.Request.Context(), "credential rejected", logger.ErrAttr(err))
+
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnauthorized),
+			)
+			return
+		}
+		if err != nil {
+			log.ErrorContext(c.Request.Context(), "failed to authenticate", logger.ErrAttr(err))
+
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown),
+			)
+			return
+		}
+
+		c.Request = c.Request.WithContext(access.WithActor(c.Request.Context(), actor))
+
+		c.Next()
+	}
+}
+
+// Require rejects requests whose actor lacks scope, it must run after Authenticate.
+func (m *Middleware) Require(scope access.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !m.enabled() {
+			c.Next()
+			return
+		}
+
+		actor, ok := access.ActorFromContext(c.Request.Context())
+		if !ok || !actor.HasScope(scope) {
+			m.log.WarnContext(
+				c.Request.Context(),
+				"insufficient scope",
+				slog.String("op", "auth.Require"),
+				slog.String("scope", string(scope)),
+			)
+
+			c.AbortWithStatusJSON(
+				http.StatusForbidden,
+				handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInsufficientScope, scope),
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/domain/access"
+	tokensDomain "reviewer-assigner/internal/domain/tokens"
+	"reviewer-assigner/internal/service"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTokenService map[string]*tokensDomain.Token
+
+func (f fakeTokenService) Authenticate(_ context.Context, plain string) (*tokensDomain.Token, error) {
+	token, ok := f[plain]
+	if !ok {
+		return nil, service.ErrTokenNotFound
+	}
+
+	return token, nil
+}
+
+func newTestRouter(t *testing.T, mode string, actor **access.Actor) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	authenticator, err := NewAuthenticator(log, &config.Auth{Mode: mode}, fakeTokenService{
+		"stats": {ID: "t1", Actor: "ci", Role: access.RoleAdmin, Scopes: []access.Scope{access.ScopeStatsRead}},
+	})
+	require.NoError(t, err)
+	m := NewMiddleware(log, authenticator)
+
+	r := gin.New()
+	r.GET("/stats", m.Authenticate(), m.Require(access.ScopeStatsRead), func(c *gin.Context) {
+		*actor, _ = access.ActorFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+	r.POST("/pullRequest", m.Authenticate(), m.Require(access.ScopePRsWrite), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return r
+}
+
+func TestMiddleware(t *testing.T) {
+	testCases := []struct {
+		name           string
+		mode           string
+		method         string
+		path           string
+		authorization  string
+		expectedStatus int
+		expectedActor  string
+	}{
+		{
+			name:           "disabled",
+			mode:           config.AuthModeNone,
+			method:         http.MethodPost,
+			path:           "/pullRequest",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing_token",
+			mode:           config.AuthModeToken,
+			method:         http.MethodGet,
+			path:           "/stats",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "not_bearer",
+			mode:           config.AuthModeToken,
+			method:         http.MethodGet,
+			path:           "/stats",
+			authorization:  "Basic stats",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "unknown_token",
+			mode:           config.AuthModeToken,
+			method:         http.MethodGet,
+			path:           "/stats",
+			authorization:  "Bearer unknown",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "insufficient_scope",
+			mode:           config.AuthModeToken,
+			method:         http.MethodPost,
+			path:           "/pullRequest",
+			authorization:  "Bearer stats",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "allowed",
+			mode:           config.AuthModeToken,
+			method:         http.MethodGet,
+			path:           "/stats",
+			authorization:  "Bearer stats",
+			expectedStatus: http.StatusOK,
+			expectedActor:  "ci",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actor *access.Actor
+			r := newTestRouter(t, tc.mode, &actor)
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			if tc.expectedActor != "" {
+				require.NotNil(t, actor)
+				assert.Equal(t, tc.expectedActor, actor.ID)
+				assert.Equal(t, "t1", actor.TokenID)
+			}
+		})
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	_, err := NewAuthenticator(nil, &config.Auth{Mode: "basic"}, nil)
+	require.ErrorIs(t, err, ErrUnknownMode)
+
+	_, err = NewAuthenticator(nil, &config.Auth{Mode: config.AuthModeJWT}, nil)
+	require.ErrorIs(t, err, ErrJWKSURLRequired)
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reviewer-assigner/internal/domain/access"
+	tokensDomain "reviewer-assigner/internal/domain/tokens"
+	"reviewer-assigner/internal/service"
+)
+
+type TokenService interface {
+	Authenticate(ctx context.Context, plain string) (*tokensDomain.Token, error)
+}
+
+// TokenAuthenticator accepts static API tokens stored in Postgres.
+type TokenAuthenticator struct {
+	tokenService TokenService
+}
+
+func NewTokenAuthenticator(tokenService TokenService) *TokenAuthenticator {
+	return &TokenAuthenticator{tokenService: tokenService}
+}
+
+func (a *TokenAuthenticator) Authenticate(ctx context.Context, plain string) (*access.Actor, error) {
+	token, err := a.tokenService.Authenticate(ctx, plain)
+	if errors.Is(err, service.ErrTokenNotFound) || errors.Is(err, service.ErrTokenRevoked) {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &access.Actor{
+		ID:      token.Actor,
+		TokenID: token.ID,
+		Role:    token.Role,
+		Scopes:  token.Scopes,
+	}, nil
+}
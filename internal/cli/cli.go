@@ -0,0 +1,111 @@
+// Package cli is reviewer-assigner-cli, the admin tool that calls the API through pkg/client.
+package cli
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/s-khechnev/reviewer-assigner/pkg/client"
+)
+
+var ErrUnknownCommand = errors.New("unknown command")
+
+const (
+	envAddr  = "REVIEWER_ASSIGNER_ADDR"
+	envToken = "REVIEWER_ASSIGNER_TOKEN"
+
+	defaultAddr = "http://localhost:8080"
+
+	outputJSON  = "json"
+	outputTable = "table"
+)
+
+const usage = `usage: reviewer-assigner-cli [-addr <url>] [-token <token>] [-output table|json] <command>
+
+commands:
+  team add -name <team_name> -members <user_id:username,...> [-inactive <user_id,...>]
+  team get -name <team_name>
+  user activate -id <user_id>
+  user deactivate -id <user_id>
+  pr create -id <pr_id> -name <pr_name> -author <user_id> [-team <team_name>] [-lines <n>]
+            [-labels <label,...>] [-paths <path,...>] [-reviewers <user_id,...>]
+  pr merge -id <pr_id>
+  pr reassign -id <pr_id> -old <user_id>
+  stats assignments [-status OPEN|MERGED] [-active-only] [-limit <n>] [-offset <n>]
+  stats load [-team <team_name>] [-from <RFC 3339>] [-to <RFC 3339>] [-limit <n>] [-offset <n>]
+
+-addr and -token default to $` + envAddr + ` and $` + envToken + `.`
+
+type runner struct {
+	client *client.Client
+	out    io.Writer
+	output string
+}
+
+// Run parses the global flags and runs the command, getenv supplies the defaults of -addr and -token.
+func Run(ctx context.Context, args []string, getenv func(string) string, out io.Writer) error {
+	flags := flag.NewFlagSet("reviewer-assigner-cli", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprintln(flags.Output(), usage) }
+
+	addr := flags.String("addr", cmp.Or(getenv(envAddr), defaultAddr), "API base URL")
+	token := flags.String("token", getenv(envToken), "API token")
+	output := flags.String("output", outputTable, "output format: table or json")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *output != outputTable && *output != outputJSON {
+		return fmt.Errorf("unknown output format %q, want table or json", *output)
+	}
+
+	args = flags.Args()
+	if len(args) < 2 {
+		return fmt.Errorf("%w\n%s", ErrUnknownCommand, usage)
+	}
+
+	r := &runner{
+		client: client.New(*addr, *token, nil),
+		out:    out,
+		output: *output,
+	}
+
+	command := args[0] + " " + args[1]
+	switch command {
+	case "team add":
+		return r.addTeam(ctx, args[2:])
+	case "team get":
+		return r.getTeam(ctx, args[2:])
+	case "user activate":
+		return r.setUserActive(ctx, command, true, args[2:])
+	case "user deactivate":
+		return r.setUserActive(ctx, command, false, args[2:])
+	case "pr create":
+		return r.createPullRequest(ctx, args[2:])
+	case "pr merge":
+		return r.mergePullRequest(ctx, args[2:])
+	case "pr reassign":
+		return r.reassignPullRequest(ctx, args[2:])
+	case "stats assignments":
+		return r.reviewerAssignments(ctx, args[2:])
+	case "stats load":
+		return r.reviewersLoad(ctx, args[2:])
+	default:
+		return fmt.Errorf("%w: %s\n%s", ErrUnknownCommand, command, usage)
+	}
+}
+
+// splitList splits a comma separated flag value, blank items are dropped.
+func splitList(value string) []string {
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
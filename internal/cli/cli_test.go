@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		expectedPath string
+		expectedBody string
+		response     string
+		expectedOut  string
+	}{
+		{
+			name: "team add as table",
+			args: []string{
+				"team", "add", "-name", "payments", "-members", "u1:Alice,u2:Bob", "-inactive", "u2",
+			},
+			expectedPath: "/team/add",
+			expectedBody: `{"team_name":"payments","members":[` +
+				`{"user_id":"u1","username":"Alice","is_active":true},` +
+				`{"user_id":"u2","username":"Bob","is_active":false}]}`,
+			response: `{"team":{"team_name":"payments","members":[` +
+				`{"user_id":"u1","username":"Alice","is_active":true},` +
+				`{"user_id":"u2","username":"Bob","is_active":false}]}}`,
+			expectedOut: "team: payments\n" +
+				"USER_ID  USERNAME  ACTIVE\n" +
+				"u1       Alice     true\n" +
+				"u2       Bob       false\n",
+		},
+		{
+			name:         "pr reassign as json",
+			args:         []string{"-output", "json", "pr", "reassign", "-id", "pr1", "-old", "u2"},
+			expectedPath: "/pullRequest/reassign",
+			expectedBody: `{"pull_request_id":"pr1","old_reviewer_id":"u2"}`,
+			response: `{"pr":{"pull_request_id":"pr1","pull_request_name":"Add search","author_id":"u1",` +
+				`"status":"OPEN","assigned_reviewers":["u3"]},"replaced_by":"u3"}`,
+			expectedOut: `{
+  "pr": {
+    "pull_request_id": "pr1",
+    "pull_request_name": "Add search",
+    "author_id": "u1",
+    "status": "OPEN",
+    "assigned_reviewers": [
+      "u3"
+    ]
+  },
+  "replaced_by": "u3"
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			env := map[string]string{envAddr: server.URL, envToken: "secret"}
+			var out bytes.Buffer
+
+			err := Run(context.Background(), tt.args, func(key string) string { return env[key] }, &out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOut, out.String())
+		})
+	}
+}
+
+func TestRun_UnknownCommand(t *testing.T) {
+	err := Run(context.Background(), []string{"team", "remove"}, func(string) string { return "" }, io.Discard)
+	assert.ErrorIs(t, err, ErrUnknownCommand)
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// render prints v as indented JSON, or as the table the caller writes with printTable.
+func (r *runner) render(v any, printTable func(w io.Writer)) error {
+	if r.output == outputJSON {
+		encoder := json.NewEncoder(r.out)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(v)
+	}
+
+	w := tabwriter.NewWriter(r.out, 0, 0, 2, ' ', 0)
+	printTable(w)
+
+	return w.Flush()
+}
+
+// row writes a table row of the cells separated by tabs.
+func row(w io.Writer, cells ...any) {
+	line := make([]string, 0, len(cells))
+	for _, cell := range cells {
+		line = append(line, fmt.Sprint(cell))
+	}
+
+	fmt.Fprintln(w, strings.Join(line, "\t"))
+}
+
+// list joins the items of a table cell, an empty list is shown as a dash.
+func list(items []string) string {
+	if len(items) == 0 {
+		return "-"
+	}
+
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/s-khechnev/reviewer-assigner/pkg/client"
+)
+
+func (r *runner) createPullRequest(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("pr create", flag.ContinueOnError)
+	params := &client.CreatePullRequestParams{}
+	flags.StringVar(&params.PullRequestID, "id", "", "pull_request_id")
+	flags.StringVar(&params.PullRequestName, "name", "", "pull_request_name")
+	flags.StringVar(&params.AuthorID, "author", "", "author user_id")
+	flags.StringVar(&params.TeamName, "team", "", "team_name, the primary team of the author by default")
+	flags.IntVar(&params.LinesChanged, "lines", 0, "lines changed")
+	labels := flags.String("labels", "", "comma separated labels")
+	paths := flags.String("paths", "", "comma separated changed paths")
+	reviewers := flags.String("reviewers", "", "comma separated user_ids of requested reviewers")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	params.Labels = splitList(*labels)
+	params.Paths = splitList(*paths)
+	params.RequestedReviewers = splitList(*reviewers)
+
+	pr, err := r.client.CreatePullRequest(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return r.renderPullRequest(pr, pr)
+}
+
+func (r *runner) mergePullRequest(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("pr merge", flag.ContinueOnError)
+	pullRequestID := flags.String("id", "", "pull_request_id")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	pr, err := r.client.MergePullRequest(ctx, *pullRequestID)
+	if err != nil {
+		return err
+	}
+
+	return r.renderPullRequest(pr, pr)
+}
+
+func (r *runner) reassignPullRequest(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("pr reassign", flag.ContinueOnError)
+	pullRequestID := flags.String("id", "", "pull_request_id")
+	oldReviewerID := flags.String("old", "", "user_id of the reviewer to replace")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	result, err := r.client.ReassignPullRequest(ctx, *pullRequestID, *oldReviewerID)
+	if err != nil {
+		return err
+	}
+
+	if err = r.renderPullRequest(result, &result.PullRequest); err != nil {
+		return err
+	}
+	if r.output == outputTable {
+		_, err = fmt.Fprintf(r.out, "replaced_by: %s\n", result.ReplacedBy)
+	}
+
+	return err
+}
+
+// renderPullRequest prints v as JSON, or pr as a table.
+func (r *runner) renderPullRequest(v any, pr *client.PullRequest) error {
+	return r.render(v, func(w io.Writer) {
+		row(w, "PULL_REQUEST_ID", "NAME", "AUTHOR", "STATUS", "REVIEWERS", "APPROVED")
+		row(w, pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status,
+			list(pr.AssignedReviewers), list(pr.ApprovedReviewers))
+	})
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/s-khechnev/reviewer-assigner/pkg/client"
+)
+
+func (r *runner) reviewerAssignments(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("stats assignments", flag.ContinueOnError)
+	params := &client.ReviewerAssignmentsParams{}
+	flags.StringVar(&params.Status, "status", "", "count only OPEN or MERGED pull requests")
+	flags.BoolVar(&params.ActiveOnly, "active-only", false, "skip inactive reviewers")
+	flags.IntVar(&params.Limit, "limit", 0, "page size")
+	flags.IntVar(&params.Offset, "offset", 0, "page offset")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	assignments, err := r.client.GetReviewerAssignments(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return r.render(assignments, func(w io.Writer) {
+		row(w, "USER_ID", "USERNAME", "COUNT")
+		for _, assignment := range assignments {
+			row(w, assignment.UserID, assignment.Username, assignment.Count)
+		}
+	})
+}
+
+func (r *runner) reviewersLoad(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("stats load", flag.ContinueOnError)
+	params := &client.ReviewersLoadParams{}
+	flags.StringVar(&params.TeamName, "team", "", "team_name, all teams by default")
+	from := flags.String("from", "", "period start in RFC 3339")
+	to := flags.String("to", "", "period end in RFC 3339")
+	flags.IntVar(&params.Limit, "limit", 0, "page size")
+	flags.IntVar(&params.Offset, "offset", 0, "page offset")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var err error
+	if params.From, err = parseTime("from", *from); err != nil {
+		return err
+	}
+	if params.To, err = parseTime("to", *to); err != nil {
+		return err
+	}
+
+	load, err := r.client.GetReviewersLoad(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return r.render(load, func(w io.Writer) {
+		fmt.Fprintf(w, "period: %s - %s\n", load.From.Format(time.RFC3339), load.To.Format(time.RFC3339))
+		row(w, "TEAM", "USER_ID", "USERNAME", "ACTIVE", "OPEN_REVIEWS", "ASSIGNMENTS", "AVG_TIME_TO_MERGE", "GINI")
+		for _, team := range load.Teams {
+			for _, reviewer := range team.Reviewers {
+				row(w, team.TeamName, reviewer.UserID, reviewer.Username, reviewer.IsActive,
+					reviewer.OpenReviews, reviewer.Assignments, timeToMerge(reviewer.AvgTimeToMergeSeconds),
+					strconv.FormatFloat(team.Fairness.Gini, 'f', 2, 64))
+			}
+		}
+	})
+}
+
+// parseTime parses an optional time flag, an empty value is the zero time.
+func parseTime(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid -%s, want RFC 3339: %w", name, err)
+	}
+
+	return t, nil
+}
+
+func timeToMerge(seconds *float64) string {
+	if seconds == nil {
+		return "-"
+	}
+
+	return (time.Duration(*seconds) * time.Second).String()
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/s-khechnev/reviewer-assigner/pkg/client"
+)
+
+func (r *runner) addTeam(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("team add", flag.ContinueOnError)
+	name := flags.String("name", "", "team_name")
+	members := flags.String("members", "", "comma separated user_id:username pairs")
+	inactive := flags.String("inactive", "", "comma separated user_ids of inactive members")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	inactiveIDs := splitList(*inactive)
+	team := &client.Team{TeamName: *name, Members: []client.Member{}}
+	for _, pair := range splitList(*members) {
+		userID, username, ok := strings.Cut(pair, ":")
+		if !ok {
+			return fmt.Errorf("invalid member %q, want user_id:username", pair)
+		}
+
+		team.Members = append(team.Members, client.Member{
+			UserID:   userID,
+			Username: username,
+			IsActive: !slices.Contains(inactiveIDs, userID),
+		})
+	}
+
+	created, err := r.client.AddTeam(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	return r.render(created, func(w io.Writer) {
+		fmt.Fprintf(w, "team: %s\n", created.TeamName)
+		row(w, "USER_ID", "USERNAME", "ACTIVE")
+		for _, member := range created.Members {
+			row(w, member.UserID, member.Username, member.IsActive)
+		}
+	})
+}
+
+func (r *runner) getTeam(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("team get", flag.ContinueOnError)
+	name := flags.String("name", "", "team_name")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	team, err := r.client.GetTeam(ctx, *name)
+	if err != nil {
+		return err
+	}
+
+	return r.render(team, func(w io.Writer) {
+		fmt.Fprintf(w, "team: %s\n", team.TeamName)
+		if team.ParentTeamName != "" {
+			fmt.Fprintf(w, "parent: %s\n", team.ParentTeamName)
+		}
+		row(w, "USER_ID", "USERNAME", "ACTIVE", "PRIMARY")
+		for _, member := range team.Members {
+			row(w, member.UserID, member.Username, member.IsActive, member.IsPrimary)
+		}
+	})
+}
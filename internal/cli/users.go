@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"io"
+)
+
+func (r *runner) setUserActive(ctx context.Context, command string, isActive bool, args []string) error {
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	userID := flags.String("id", "", "user_id")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	user, err := r.client.SetUserActive(ctx, *userID, isActive)
+	if err != nil {
+		return err
+	}
+
+	return r.render(user, func(w io.Writer) {
+		row(w, "USER_ID", "USERNAME", "TEAMS", "ACTIVE", "OPEN_REVIEWS")
+		row(w, user.UserID, user.Username, list(user.TeamNames), user.IsActive, user.OpenReviews)
+	})
+}
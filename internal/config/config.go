@@ -14,9 +14,19 @@ const (
 	EnvProd  = "prod"
 )
 
+const (
+	AuthModeNone  = "none"
+	AuthModeToken = "token"
+	AuthModeJWT   = "jwt"
+)
+
 type Config struct {
 	Env        string     `yaml:"env"         env-default:"prod"`
 	HTTPServer HTTPServer `yaml:"http_server"`
+	Assignment Assignment `yaml:"assignment"`
+	Tracing    Tracing    `yaml:"tracing"`
+	Auth       Auth       `yaml:"auth"`
+	RateLimit  RateLimit  `yaml:"rate_limit"`
 	DB         DB
 }
 
@@ -25,6 +35,62 @@ type HTTPServer struct {
 	Port        int           `yaml:"port"         env-default:"8080"`
 	Timeout     time.Duration `yaml:"timeout"      env-default:"5s"`
 	IdleTimeout time.Duration `yaml:"idle_timeout" env-default:"60s"`
+	// TrustedProxies are the CIDRs or IPs whose X-Forwarded-For is believed, none by default.
+	// Without a trusted proxy the client IP is the peer address, clients cannot pick it with a header.
+	TrustedProxies []string `yaml:"trusted_proxies" env:"HTTP_TRUSTED_PROXIES"`
+}
+
+type Assignment struct {
+	// Seed makes reviewer picking reproducible, 0 picks a random seed on start.
+	Seed uint64 `yaml:"seed" env:"ASSIGNMENT_SEED" env-default:"0"`
+}
+
+type Tracing struct {
+	// Exporter is one of none, stdout, otlp.
+	Exporter string `yaml:"exporter" env:"TRACING_EXPORTER"        env-default:"none"`
+	// Endpoint is host:port of an OTLP/HTTP collector.
+	Endpoint string `yaml:"endpoint" env:"TRACING_OTLP_ENDPOINT"   env-default:"localhost:4318"`
+	Insecure bool   `yaml:"insecure" env:"TRACING_OTLP_INSECURE"   env-default:"true"`
+}
+
+type Auth struct {
+	// Mode is one of token, jwt or none. None turns authentication off and is meant for local development only.
+	Mode string `yaml:"mode" env:"AUTH_MODE" env-default:"token"`
+	JWT  JWT    `yaml:"jwt"`
+}
+
+type JWT struct {
+	JWKSURL  string        `yaml:"jwks_url"  env:"AUTH_JWT_JWKS_URL"`
+	CacheTTL time.Duration `yaml:"cache_ttl" env:"AUTH_JWT_CACHE_TTL" env-default:"10m"`
+	// Issuer and Audience are checked when set.
+	Issuer   string        `yaml:"issuer"    env:"AUTH_JWT_ISSUER"`
+	Audience string        `yaml:"audience"  env:"AUTH_JWT_AUDIENCE"`
+	Leeway   time.Duration `yaml:"leeway"    env:"AUTH_JWT_LEEWAY"    env-default:"30s"`
+	// UserIDClaim holds users.user_id, RolesClaim a role name or a list of them.
+	UserIDClaim string `yaml:"user_id_claim" env:"AUTH_JWT_USER_ID_CLAIM" env-default:"sub"`
+	RolesClaim  string `yaml:"roles_claim"   env:"AUTH_JWT_ROLES_CLAIM"   env-default:"roles"`
+	// DefaultScopes are given to tokens whose scope claim has none of the API scopes, e.g. a plain
+	// "openid profile email" or no claim at all. The read-only scopes when empty.
+	DefaultScopes []string `yaml:"default_scopes" env:"AUTH_JWT_DEFAULT_SCOPES"`
+}
+
+type RateLimit struct {
+	// Backend is one of none, memory, postgres. Postgres shares limits between replicas.
+	Backend string `yaml:"backend" env:"RATE_LIMIT_BACKEND" env-default:"none"`
+	// Default applies to route groups missing from Groups.
+	Default RateLimitRule `yaml:"default"`
+	// Groups are keyed by the first path segment: team, users, pullRequest, assignment, stats, export,
+	// tokens.
+	Groups map[string]RateLimitRule `yaml:"groups"`
+}
+
+// RateLimitRule defaults apply to RateLimit.Default only, cleanenv does not fill values of a map,
+// so a rule in Groups without rps is not limited.
+type RateLimitRule struct {
+	// RPS is the sustained rate per client, 0 turns the limit off.
+	RPS float64 `yaml:"rps"   env-default:"10"`
+	// Burst is the number of requests a client can make at once, 0 means RPS rounded up.
+	Burst int `yaml:"burst" env-default:"20"`
 }
 
 type DB struct {
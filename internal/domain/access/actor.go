@@ -0,0 +1,50 @@
+package access
+
+import (
+	"context"
+	"slices"
+)
+
+type Role string
+
+const (
+	// RoleAdmin may do everything.
+	RoleAdmin Role = "admin"
+	// RoleTeamAdmin manages members and rules of its own team.
+	RoleTeamAdmin Role = "team_admin"
+	// RoleMember may only take itself off a review.
+	RoleMember Role = "member"
+)
+
+func Roles() []Role {
+	return []Role{RoleAdmin, RoleTeamAdmin, RoleMember}
+}
+
+func (r Role) IsValid() bool {
+	return slices.Contains(Roles(), r)
+}
+
+// Actor is the authenticated caller of a request, ID is its users.user_id.
+type Actor struct {
+	ID      string
+	TokenID string
+	Role    Role
+	Scopes  []Scope
+}
+
+func (a *Actor) HasScope(scope Scope) bool {
+	return slices.Contains(a.Scopes, scope)
+}
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the caller, it is absent when authentication is disabled.
+func ActorFromContext(ctx context.Context) (*Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(*Actor)
+
+	return actor, ok
+}
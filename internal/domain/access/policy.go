@@ -0,0 +1,87 @@
+package access
+
+import (
+	"reviewer-assigner/internal/domain"
+	"slices"
+)
+
+// The checks take actorTeams, the teams of the actor itself, empty when the actor is not a team member.
+
+// CanManageTeam lets team admins manage every team they are a member of, not only the primary one.
+func (a *Actor) CanManageTeam(actorTeams []string, teamName string) error {
+	switch a.Role {
+	case RoleAdmin:
+		return nil
+	case RoleTeamAdmin:
+		if teamName != "" && slices.Contains(actorTeams, teamName) {
+			return nil
+		}
+	}
+
+	return domain.ErrAccessDenied
+}
+
+func (a *Actor) CanManageUser(actorTeams []string, userTeam string) error {
+	return a.CanManageTeam(actorTeams, userTeam)
+}
+
+func (a *Actor) CanReassign(actorTeams []string, reviewerID, reviewerTeam string) error {
+	if a.Role == RoleMember && a.ID == reviewerID {
+		return nil
+	}
+
+	return a.CanManageTeam(actorTeams, reviewerTeam)
+}
+
+// CanSetReviewers lets the author pick reviewers of their own PR next to the team managers.
+func (a *Actor) CanSetReviewers(actorTeams []string, authorID, teamName string) error {
+	if a.ID == authorID {
+		return nil
+	}
+
+	return a.CanManageTeam(actorTeams, teamName)
+}
+
+// CanDecline lets only the reviewer themselves decline, admins reassign instead.
+func (a *Actor) CanDecline(reviewerID string) error {
+	if a.ID == reviewerID {
+		return nil
+	}
+
+	return domain.ErrAccessDenied
+}
+
+// CanApprove lets only the reviewer themselves approve, nobody approves on their behalf.
+func (a *Actor) CanApprove(reviewerID string) error {
+	if a.ID == reviewerID {
+		return nil
+	}
+
+	return domain.ErrAccessDenied
+}
+
+// CanManageRequiredGroups is kept to admins, required groups apply to the PRs of every team.
+func (a *Actor) CanManageRequiredGroups() error {
+	if a.Role == RoleAdmin {
+		return nil
+	}
+
+	return domain.ErrAccessDenied
+}
+
+// CanImportPullRequests is kept to admins, imported PRs bypass the picker and the rules of every team.
+func (a *Actor) CanImportPullRequests() error {
+	if a.Role == RoleAdmin {
+		return nil
+	}
+
+	return domain.ErrAccessDenied
+}
+
+func (a *Actor) CanManageTokens() error {
+	if a.Role == RoleAdmin {
+		return nil
+	}
+
+	return domain.ErrAccessDenied
+}
@@ -0,0 +1,84 @@
+package access
+
+import (
+	"reviewer-assigner/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActor_Policy(t *testing.T) {
+	admin := &Actor{ID: "u1", Role: RoleAdmin}
+	teamAdmin := &Actor{ID: "u2", Role: RoleTeamAdmin}
+	member := &Actor{ID: "u3", Role: RoleMember}
+	backend := []string{"backend"}
+
+	testCases := []struct {
+		name    string
+		check   func() error
+		allowed bool
+	}{
+		{"admin_manages_any_team", func() error { return admin.CanManageTeam(nil, "payments") }, true},
+		{"team_admin_manages_own_team", func() error { return teamAdmin.CanManageTeam(backend, "backend") }, true},
+		{"team_admin_other_team", func() error { return teamAdmin.CanManageTeam(backend, "payments") }, false},
+		{
+			"team_admin_manages_secondary_team",
+			func() error { return teamAdmin.CanManageTeam([]string{"backend", "payments"}, "payments") },
+			true,
+		},
+		{"team_admin_without_team", func() error { return teamAdmin.CanManageTeam(nil, "") }, false},
+		{"member_manages_team", func() error { return member.CanManageTeam(backend, "backend") }, false},
+
+		{"admin_manages_user", func() error { return admin.CanManageUser(nil, "payments") }, true},
+		{"team_admin_manages_own_user", func() error { return teamAdmin.CanManageUser(backend, "backend") }, true},
+		{"team_admin_other_user", func() error { return teamAdmin.CanManageUser(backend, "payments") }, false},
+		{"member_manages_user", func() error { return member.CanManageUser(backend, "backend") }, false},
+
+		{"admin_reassigns", func() error { return admin.CanReassign(nil, "u5", "payments") }, true},
+		{"team_admin_reassigns_own", func() error { return teamAdmin.CanReassign(backend, "u5", "backend") }, true},
+		{"team_admin_reassigns_other", func() error { return teamAdmin.CanReassign(backend, "u5", "payments") }, false},
+		{"member_reassigns_self", func() error { return member.CanReassign(nil, "u3", "backend") }, true},
+		{"member_reassigns_other", func() error { return member.CanReassign(nil, "u5", "backend") }, false},
+
+		{"member_sets_own_reviewers", func() error { return member.CanSetReviewers(nil, "u3", "backend") }, true},
+		{"member_sets_other_reviewers", func() error { return member.CanSetReviewers(nil, "u5", "backend") }, false},
+		{
+			"team_admin_sets_reviewers",
+			func() error { return teamAdmin.CanSetReviewers(backend, "u5", "backend") },
+			true,
+		},
+		{
+			"team_admin_sets_other",
+			func() error { return teamAdmin.CanSetReviewers(backend, "u5", "payments") },
+			false,
+		},
+
+		{"member_declines_self", func() error { return member.CanDecline("u3") }, true},
+		{"member_declines_other", func() error { return member.CanDecline("u5") }, false},
+		{"admin_declines_other", func() error { return admin.CanDecline("u5") }, false},
+
+		{"member_approves_self", func() error { return member.CanApprove("u3") }, true},
+		{"admin_approves_other", func() error { return admin.CanApprove("u5") }, false},
+
+		{"admin_manages_required_groups", admin.CanManageRequiredGroups, true},
+		{"team_admin_manages_required_groups", teamAdmin.CanManageRequiredGroups, false},
+
+		{"admin_imports_pull_requests", admin.CanImportPullRequests, true},
+		{"team_admin_imports_pull_requests", teamAdmin.CanImportPullRequests, false},
+
+		{"admin_manages_tokens", admin.CanManageTokens, true},
+		{"team_admin_manages_tokens", teamAdmin.CanManageTokens, false},
+		{"member_manages_tokens", member.CanManageTokens, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.check()
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, domain.ErrAccessDenied)
+			}
+		})
+	}
+}
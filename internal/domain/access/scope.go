@@ -0,0 +1,30 @@
+package access
+
+type Scope string
+
+const (
+	ScopeTeamsRead   Scope = "teams:read"
+	ScopeTeamsWrite  Scope = "teams:write"
+	ScopeUsersRead   Scope = "users:read"
+	ScopeUsersWrite  Scope = "users:write"
+	ScopePRsWrite    Scope = "prs:write"
+	ScopeStatsRead   Scope = "stats:read"
+	ScopeTokensWrite Scope = "tokens:write"
+)
+
+// ReadScopes are the scopes that change nothing.
+func ReadScopes() []Scope {
+	return []Scope{ScopeTeamsRead, ScopeUsersRead, ScopeStatsRead}
+}
+
+func Scopes() []Scope {
+	return []Scope{
+		ScopeTeamsRead,
+		ScopeTeamsWrite,
+		ScopeUsersRead,
+		ScopeUsersWrite,
+		ScopePRsWrite,
+		ScopeStatsRead,
+		ScopeTokensWrite,
+	}
+}
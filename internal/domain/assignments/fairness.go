@@ -0,0 +1,45 @@
+package assignments
+
+import (
+	"slices"
+)
+
+type Fairness struct {
+	Gini    float64
+	MaxLoad int
+	MinLoad int
+}
+
+// NewFairness summarizes a load distribution. Gini is 0 for perfectly even loads
+// and tends to 1 when one reviewer takes everything.
+func NewFairness(loads []int) Fairness {
+	if len(loads) == 0 {
+		return Fairness{}
+	}
+
+	sorted := slices.Clone(loads)
+	slices.Sort(sorted)
+
+	return Fairness{
+		Gini:    gini(sorted),
+		MaxLoad: sorted[len(sorted)-1],
+		MinLoad: sorted[0],
+	}
+}
+
+// gini expects loads sorted in ascending order.
+func gini(sorted []int) float64 {
+	var total, weighted int
+	for i, load := range sorted {
+		total += load
+		weighted += (i + 1) * load
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	n := float64(len(sorted))
+
+	return 2*float64(weighted)/(n*float64(total)) - (n+1)/n
+}
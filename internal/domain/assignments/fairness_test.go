@@ -0,0 +1,51 @@
+package assignments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFairness(t *testing.T) {
+	tests := []struct {
+		name     string
+		loads    []int
+		expected Fairness
+	}{
+		{
+			name:     "no reviewers",
+			loads:    nil,
+			expected: Fairness{},
+		},
+		{
+			name:     "no load",
+			loads:    []int{0, 0, 0},
+			expected: Fairness{Gini: 0, MaxLoad: 0, MinLoad: 0},
+		},
+		{
+			name:     "even load",
+			loads:    []int{3, 3, 3, 3},
+			expected: Fairness{Gini: 0, MaxLoad: 3, MinLoad: 3},
+		},
+		{
+			name:     "one reviewer takes everything",
+			loads:    []int{0, 8, 0, 0},
+			expected: Fairness{Gini: 0.75, MaxLoad: 8, MinLoad: 0},
+		},
+		{
+			name:     "uneven load",
+			loads:    []int{3, 1, 2},
+			expected: Fairness{Gini: 2.0 / 9, MaxLoad: 3, MinLoad: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := NewFairness(tt.loads)
+
+			assert.InDelta(t, tt.expected.Gini, actual.Gini, 1e-9)
+			assert.Equal(t, tt.expected.MaxLoad, actual.MaxLoad)
+			assert.Equal(t, tt.expected.MinLoad, actual.MinLoad)
+		})
+	}
+}
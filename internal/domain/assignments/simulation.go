@@ -0,0 +1,102 @@
+package assignments
+
+import (
+	"fmt"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/rules"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+	"strings"
+)
+
+type ReviewerLoad struct {
+	UserID    string
+	Username  string
+	Simulated int
+	Actual    int
+}
+
+type Simulation struct {
+	PullRequests int
+	Reviewers    []ReviewerLoad
+	Simulated    Fairness
+	Actual       Fairness
+}
+
+// Simulate replays history against picker using the current members and rules of team.
+// History is never modified, so the result can be compared with what actually happened.
+func Simulate(
+	team *teamsDomain.Team,
+	teamRules []teamsDomain.Rule,
+	history []prsDomain.PullRequest,
+	picker prsDomain.ReviewerPicker,
+	countReviewers int,
+) (*Simulation, error) {
+	names := make(map[string]string, len(team.Members))
+	loads := make(map[string]*ReviewerLoad, len(team.Members))
+	for _, member := range team.Members {
+		names[member.ID] = member.Name
+		if member.IsActive {
+			loads[member.ID] = &ReviewerLoad{UserID: member.ID, Username: member.Name}
+		}
+	}
+
+	// reviewers who left the team or went inactive still count, they did the work
+	loadOf := func(userID string) *ReviewerLoad {
+		load, ok := loads[userID]
+		if !ok {
+			load = &ReviewerLoad{UserID: userID, Username: names[userID]}
+			loads[userID] = load
+		}
+		return load
+	}
+
+	for _, pr := range history {
+		for _, reviewerID := range pr.AssignedReviewers {
+			loadOf(reviewerID).Actual++
+		}
+
+		replayed := prsDomain.PullRequest{
+			PullRequestShort: prsDomain.PullRequestShort{
+				ID:       pr.ID,
+				AuthorID: pr.AuthorID,
+				Status:   prsDomain.StatusOpen,
+			},
+		}
+
+		err := replayed.AssignReviewers(
+			team.Members,
+			rules.NewPicker(picker, teamRules, pr.AuthorID),
+			countReviewers,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay pull request %s: %w", pr.ID, err)
+		}
+
+		for _, reviewerID := range replayed.AssignedReviewers {
+			loadOf(reviewerID).Simulated++
+		}
+	}
+
+	simulation := &Simulation{
+		PullRequests: len(history),
+		Reviewers:    make([]ReviewerLoad, 0, len(loads)),
+	}
+
+	simulatedLoads := make([]int, 0, len(loads))
+	actualLoads := make([]int, 0, len(loads))
+	for _, load := range loads {
+		simulation.Reviewers = append(simulation.Reviewers, *load)
+		simulatedLoads = append(simulatedLoads, load.Simulated)
+		actualLoads = append(actualLoads, load.Actual)
+	}
+
+	slices.SortFunc(simulation.Reviewers, func(a, b ReviewerLoad) int {
+		return strings.Compare(a.UserID, b.UserID)
+	})
+
+	simulation.Simulated = NewFairness(simulatedLoads)
+	simulation.Actual = NewFairness(actualLoads)
+
+	return simulation, nil
+}
@@ -0,0 +1,91 @@
+package assignments
+
+import (
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/pickers"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulate(t *testing.T) {
+	team := &teamsDomain.Team{
+		Name: "backend",
+		Members: []teamsDomain.Member{
+			{ID: "u1", Name: "Alice", IsActive: true},
+			{ID: "u2", Name: "Bob", IsActive: true},
+			{ID: "u3", Name: "John", IsActive: true},
+			{ID: "u4", Name: "Mike", IsActive: false},
+		},
+	}
+
+	history := []prsDomain.PullRequest{
+		{
+			PullRequestShort:  prsDomain.PullRequestShort{ID: "pr1", AuthorID: "u1"},
+			AssignedReviewers: []string{"u2", "u4"},
+		},
+		{
+			PullRequestShort:  prsDomain.PullRequestShort{ID: "pr2", AuthorID: "u1"},
+			AssignedReviewers: []string{"u2"},
+		},
+		{
+			PullRequestShort:  prsDomain.PullRequestShort{ID: "pr3", AuthorID: "u3", Status: prsDomain.StatusMerged},
+			AssignedReviewers: []string{"u2", "u5"},
+		},
+	}
+
+	simulation, err := Simulate(
+		team,
+		nil,
+		history,
+		pickers.NewLeastLoadedReviewerPicker(nil),
+		1,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, simulation.PullRequests)
+	assert.Equal(t, []ReviewerLoad{
+		{UserID: "u1", Username: "Alice", Simulated: 1, Actual: 0},
+		{UserID: "u2", Username: "Bob", Simulated: 1, Actual: 3},
+		{UserID: "u3", Username: "John", Simulated: 1, Actual: 0},
+		{UserID: "u4", Username: "Mike", Simulated: 0, Actual: 1},
+		{UserID: "u5", Username: "", Simulated: 0, Actual: 1},
+	}, simulation.Reviewers)
+
+	assert.Equal(t, 1, simulation.Simulated.MaxLoad)
+	assert.Equal(t, 0, simulation.Simulated.MinLoad)
+	assert.Equal(t, 3, simulation.Actual.MaxLoad)
+	assert.Equal(t, 0, simulation.Actual.MinLoad)
+	assert.Less(t, simulation.Simulated.Gini, simulation.Actual.Gini)
+
+	assert.Equal(t, []string{"u2", "u4"}, history[0].AssignedReviewers)
+	assert.Equal(t, prsDomain.StatusMerged, history[2].Status)
+}
+
+func TestSimulate_RespectsRules(t *testing.T) {
+	team := &teamsDomain.Team{
+		Members: []teamsDomain.Member{
+			{ID: "u1", IsActive: true},
+			{ID: "u2", IsActive: true},
+			{ID: "u3", IsActive: true},
+		},
+	}
+	teamRules := []teamsDomain.Rule{
+		{Kind: teamsDomain.RuleKindConflict, UserID: "u1", OtherUserID: "u2"},
+	}
+	history := []prsDomain.PullRequest{
+		{PullRequestShort: prsDomain.PullRequestShort{ID: "pr1", AuthorID: "u1"}},
+		{PullRequestShort: prsDomain.PullRequestShort{ID: "pr2", AuthorID: "u1"}},
+	}
+
+	simulation, err := Simulate(team, teamRules, history, pickers.NewRoundRobinReviewerPicker(), 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, []ReviewerLoad{
+		{UserID: "u1"},
+		{UserID: "u2"},
+		{UserID: "u3", Simulated: 2},
+	}, simulation.Reviewers)
+}
@@ -8,4 +8,29 @@ var (
 	ErrTeamMembersMismatch = errors.New("members mismatch")
 
 	ErrPullRequestAlreadyMerged = errors.New("pull request already merged")
+
+	ErrReviewerNotEligible = errors.New("reviewer must be an active team member other than the author")
+	ErrReviewerNotAssigned = errors.New("reviewer is not assigned")
+	ErrReviewerInGroupSlot = errors.New("reviewer fills a required group slot")
+	ErrApprovalRequired    = errors.New("required reviewer groups have not approved")
+
+	ErrImportRecordInvalid = errors.New("invalid pull request record")
+
+	ErrArchiveVersion = errors.New("unsupported archive version")
+	ErrArchiveInvalid = errors.New("invalid archive")
+
+	ErrUnknownStrategy = errors.New("unknown assignment strategy")
+
+	ErrRuleKindUnknown     = errors.New("rule kind must be CONFLICT_OF_INTEREST or PAIRING")
+	ErrRuleInvalid         = errors.New("rule must bind two distinct users")
+	ErrRuleMemberNotInTeam = errors.New("rule references user outside of team")
+	ErrRuleViolation       = errors.New("team rule violation")
+
+	ErrReviewRuleInvalid = errors.New("review rule must ask for 1-10 reviewers or an extra team")
+
+	ErrRequiredGroupInvalid = errors.New("required group must match non-empty labels or path prefixes")
+
+	ErrTokenInvalid = errors.New("token must have an actor, a known role and known scopes")
+
+	ErrAccessDenied = errors.New("access denied")
 )
@@ -0,0 +1,72 @@
+package pullrequests
+
+import (
+	"errors"
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+)
+
+type DeclineReason string
+
+const (
+	DeclineReasonNoContext          DeclineReason = "NO_CONTEXT"
+	DeclineReasonConflictOfInterest DeclineReason = "CONFLICT_OF_INTEREST"
+	DeclineReasonUnavailable        DeclineReason = "UNAVAILABLE"
+	DeclineReasonOther              DeclineReason = "OTHER"
+)
+
+func DeclineReasons() []DeclineReason {
+	return []DeclineReason{
+		DeclineReasonNoContext,
+		DeclineReasonConflictOfInterest,
+		DeclineReasonUnavailable,
+		DeclineReasonOther,
+	}
+}
+
+func (r DeclineReason) IsValid() bool {
+	return slices.Contains(DeclineReasons(), r)
+}
+
+// Decline records a reviewer taking themselves off a review.
+type Decline struct {
+	PullRequestID string
+	ReviewerID    string
+	Reason        DeclineReason
+	Comment       string
+}
+
+// Decline takes the reviewer off the PR for good: the replacement comes from the reassigner
+// and the reviewer is never picked for this PR again. A team slot stays empty when nobody is left,
+// a required group slot cannot, so the decline fails with ErrNotEnoughMembers.
+func (p *PullRequest) Decline(
+	reviewer *teamsDomain.Member,
+	members []teamsDomain.Member,
+	reassigner ReviewerReassigner,
+) (string, error) {
+	if p.Status == StatusMerged {
+		return "", domain.ErrPullRequestAlreadyMerged
+	}
+
+	p.DeclinedReviewers = append(p.DeclinedReviewers, reviewer.ID)
+
+	_, inGroupSlot := p.GroupReviewers[reviewer.ID]
+
+	replacedBy, err := p.Reassign(reviewer, members, reassigner)
+	if errors.Is(err, domain.ErrNotEnoughMembers) && !inGroupSlot {
+		p.ApprovedReviewers = slices.DeleteFunc(p.ApprovedReviewers, func(id string) bool {
+			return id == reviewer.ID
+		})
+		p.AssignedReviewers = slices.DeleteFunc(p.AssignedReviewers, func(id string) bool {
+			return id == reviewer.ID
+		})
+
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return replacedBy, nil
+}
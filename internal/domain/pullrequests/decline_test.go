@@ -0,0 +1,58 @@
+package pullrequests
+
+import (
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequest_Decline(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "author", IsActive: true},
+		{ID: "r1", IsActive: true},
+		{ID: "r2", IsActive: true},
+		{ID: "r3", IsActive: true},
+	}
+	firstCandidate := &MockReviewerReassigner{
+		ReassignFunc: func(_ *teamsDomain.Member, members []teamsDomain.Member) (*teamsDomain.Member, error) {
+			if len(members) == 0 {
+				return nil, domain.ErrNotEnoughMembers
+			}
+			return &members[0], nil
+		},
+	}
+
+	pullRequest := &PullRequest{
+		PullRequestShort:  PullRequestShort{AuthorID: "author", Status: StatusOpen},
+		AssignedReviewers: []string{"r1", "r2"},
+	}
+
+	replacedBy, err := pullRequest.Decline(&members[1], members, firstCandidate)
+	require.NoError(t, err)
+	assert.Equal(t, "r3", replacedBy)
+	assert.Equal(t, []string{"r3", "r2"}, pullRequest.AssignedReviewers)
+	assert.Equal(t, []string{"r1"}, pullRequest.DeclinedReviewers)
+
+	// r1 is the only one left but has declined already, so the slot stays empty
+	replacedBy, err = pullRequest.Decline(&members[2], members, firstCandidate)
+	require.NoError(t, err)
+	assert.Empty(t, replacedBy)
+	assert.Equal(t, []string{"r3"}, pullRequest.AssignedReviewers)
+	assert.Equal(t, []string{"r1", "r2"}, pullRequest.DeclinedReviewers)
+
+	// declined reviewers are not picked for free slots either
+	picker := &MockReviewerPicker{
+		PickFunc: func(members []teamsDomain.Member, _ int) []teamsDomain.Member {
+			return members
+		},
+	}
+	require.NoError(t, pullRequest.AssignReviewers(members, picker, 2))
+	assert.Equal(t, []string{"r3"}, pullRequest.AssignedReviewers)
+
+	pullRequest.Status = StatusMerged
+	_, err = pullRequest.Decline(&members[3], members, firstCandidate)
+	require.ErrorIs(t, err, domain.ErrPullRequestAlreadyMerged)
+}
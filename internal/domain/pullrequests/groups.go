@@ -0,0 +1,77 @@
+package pullrequests
+
+import (
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+)
+
+// AssignGroupReviewer fills the slot of a required group or an extra team with one of its members
+// on top of the team slots. A group has a single slot, so assigning it again changes nothing.
+func (p *PullRequest) AssignGroupReviewer(
+	groupName string,
+	members []teamsDomain.Member,
+	picker ReviewerPicker,
+) error {
+	if p.Status == StatusMerged {
+		return domain.ErrPullRequestAlreadyMerged
+	}
+
+	for _, group := range p.GroupReviewers {
+		if group == groupName {
+			return nil
+		}
+	}
+
+	reviewers := picker.Pick(p.candidates(members), 1)
+	if len(reviewers) == 0 {
+		return domain.ErrNotEnoughMembers
+	}
+
+	if p.GroupReviewers == nil {
+		p.GroupReviewers = make(map[string]string)
+	}
+	p.AssignedReviewers = append(p.AssignedReviewers, reviewers[0].ID)
+	p.GroupReviewers[reviewers[0].ID] = groupName
+
+	return nil
+}
+
+// SlotTeamName is the team the reviewer's slot is filled from: the group of a group slot,
+// the team of the PR otherwise.
+func (p *PullRequest) SlotTeamName(reviewerID string) string {
+	if group, ok := p.GroupReviewers[reviewerID]; ok {
+		return group
+	}
+
+	return p.TeamName
+}
+
+// Approve records the approval of an assigned reviewer, approving twice changes nothing.
+func (p *PullRequest) Approve(reviewerID string) error {
+	if p.Status == StatusMerged {
+		return domain.ErrPullRequestAlreadyMerged
+	}
+	if !slices.Contains(p.AssignedReviewers, reviewerID) {
+		return domain.ErrReviewerNotAssigned
+	}
+
+	if !slices.Contains(p.ApprovedReviewers, reviewerID) {
+		p.ApprovedReviewers = append(p.ApprovedReviewers, reviewerID)
+	}
+
+	return nil
+}
+
+// PendingGroups lists in order the groups whose reviewer has not approved yet.
+func (p *PullRequest) PendingGroups() []string {
+	pending := make([]string, 0, len(p.GroupReviewers))
+	for reviewerID, group := range p.GroupReviewers {
+		if !slices.Contains(p.ApprovedReviewers, reviewerID) {
+			pending = append(pending, group)
+		}
+	}
+	slices.Sort(pending)
+
+	return pending
+}
@@ -0,0 +1,76 @@
+package pullrequests
+
+import (
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequest_RequiredGroups(t *testing.T) {
+	security := []teamsDomain.Member{
+		{ID: "r1", IsActive: true},
+		{ID: "s1", IsActive: true},
+		{ID: "s2", IsActive: true},
+	}
+	firstCandidate := &MockReviewerPicker{
+		PickFunc: func(members []teamsDomain.Member, count int) []teamsDomain.Member {
+			return members[:min(count, len(members))]
+		},
+	}
+	reassigner := &MockReviewerReassigner{
+		ReassignFunc: func(_ *teamsDomain.Member, members []teamsDomain.Member) (*teamsDomain.Member, error) {
+			if len(members) == 0 {
+				return nil, domain.ErrNotEnoughMembers
+			}
+			return &members[0], nil
+		},
+	}
+
+	pullRequest := &PullRequest{
+		PullRequestShort:  PullRequestShort{AuthorID: "author", TeamName: "backend", Status: StatusOpen},
+		AssignedReviewers: []string{"r1", "r2"},
+	}
+
+	// r1 already fills a team slot, so the group slot goes to another member
+	require.NoError(t, pullRequest.AssignGroupReviewer("security", security, firstCandidate))
+	require.NoError(t, pullRequest.AssignGroupReviewer("security", security, firstCandidate))
+	assert.Equal(t, []string{"r1", "r2", "s1"}, pullRequest.AssignedReviewers)
+	assert.Equal(t, map[string]string{"s1": "security"}, pullRequest.GroupReviewers)
+	assert.Equal(t, []string{"security"}, pullRequest.PendingGroups())
+	assert.Equal(t, "security", pullRequest.SlotTeamName("s1"))
+	assert.Equal(t, "backend", pullRequest.SlotTeamName("r1"))
+
+	require.ErrorIs(t, pullRequest.RemoveReviewer("s1"), domain.ErrReviewerInGroupSlot)
+
+	// team approvals do not unblock the merge
+	require.NoError(t, pullRequest.Approve("r1"))
+	require.ErrorIs(t, pullRequest.Merge(), domain.ErrApprovalRequired)
+
+	// the replacement takes over the slot but has to approve on its own
+	require.NoError(t, pullRequest.Approve("s1"))
+	replacedBy, err := pullRequest.Reassign(&security[1], security, reassigner)
+	require.NoError(t, err)
+	assert.Equal(t, "s2", replacedBy)
+	assert.Equal(t, map[string]string{"s2": "security"}, pullRequest.GroupReviewers)
+	assert.Equal(t, []string{"r1"}, pullRequest.ApprovedReviewers)
+
+	replacedBy, err = pullRequest.Decline(&security[2], security, reassigner)
+	require.NoError(t, err)
+	assert.Equal(t, "s1", replacedBy)
+	assert.Equal(t, map[string]string{"s1": "security"}, pullRequest.GroupReviewers)
+
+	// nobody is left in the group to take over a declined slot
+	_, err = pullRequest.Decline(&security[1], security, reassigner)
+	require.ErrorIs(t, err, domain.ErrNotEnoughMembers)
+
+	require.ErrorIs(t, pullRequest.Approve("outsider"), domain.ErrReviewerNotAssigned)
+	require.NoError(t, pullRequest.Approve("s1"))
+	assert.Empty(t, pullRequest.PendingGroups())
+	require.NoError(t, pullRequest.Merge())
+
+	err = pullRequest.AssignGroupReviewer("dba", security, firstCandidate)
+	require.ErrorIs(t, err, domain.ErrPullRequestAlreadyMerged)
+}
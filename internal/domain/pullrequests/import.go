@@ -0,0 +1,54 @@
+package pullrequests
+
+import (
+	"fmt"
+	"reviewer-assigner/internal/domain"
+	"slices"
+)
+
+// ImportRecord is a PR from history with its original reviewers and times, Line points
+// to it in the imported file.
+type ImportRecord struct {
+	Line        int
+	PullRequest PullRequest
+}
+
+// ImportError explains why the record on Line was not imported.
+type ImportError struct {
+	Line          int
+	PullRequestID string
+	Reason        string
+}
+
+type ImportResult struct {
+	Imported int
+	Errors   []ImportError
+}
+
+// ValidateImported checks the record is consistent on its own, users and teams it refers to
+// are checked against storage. Neither the picker nor the team rules apply to imported PRs.
+func (p *PullRequest) ValidateImported() error {
+	if p.Status != StatusOpen && p.Status != StatusMerged {
+		return fmt.Errorf("%w: unknown status %q", domain.ErrImportRecordInvalid, p.Status)
+	}
+	if p.CreatedAt == nil {
+		return fmt.Errorf("%w: created_at is required", domain.ErrImportRecordInvalid)
+	}
+	if (p.Status == StatusMerged) != (p.MergedAt != nil) {
+		return fmt.Errorf("%w: merged_at must be set for MERGED PRs only", domain.ErrImportRecordInvalid)
+	}
+	if p.MergedAt != nil && p.MergedAt.Before(*p.CreatedAt) {
+		return fmt.Errorf("%w: merged_at is before created_at", domain.ErrImportRecordInvalid)
+	}
+	if slices.Contains(p.AssignedReviewers, p.AuthorID) {
+		return fmt.Errorf("%w: author cannot review their own PR", domain.ErrImportRecordInvalid)
+	}
+
+	reviewers := slices.Clone(p.AssignedReviewers)
+	slices.Sort(reviewers)
+	if len(slices.Compact(reviewers)) != len(p.AssignedReviewers) {
+		return fmt.Errorf("%w: reviewers repeat", domain.ErrImportRecordInvalid)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package pullrequests
+
+import (
+	"reviewer-assigner/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequest_ValidateImported(t *testing.T) {
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(time.Hour)
+	beforeCreated := createdAt.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		pullRequest PullRequest
+		valid       bool
+	}{
+		{
+			name: "open",
+			pullRequest: PullRequest{
+				PullRequestShort:  PullRequestShort{AuthorID: "u1", Status: StatusOpen},
+				AssignedReviewers: []string{"u2", "u3"},
+				CreatedAt:         &createdAt,
+			},
+			valid: true,
+		},
+		{
+			name: "merged",
+			pullRequest: PullRequest{
+				PullRequestShort: PullRequestShort{AuthorID: "u1", Status: StatusMerged},
+				CreatedAt:        &createdAt,
+				MergedAt:         &mergedAt,
+			},
+			valid: true,
+		},
+		{
+			name: "unknown status",
+			pullRequest: PullRequest{
+				PullRequestShort: PullRequestShort{AuthorID: "u1", Status: "CLOSED"},
+				CreatedAt:        &createdAt,
+			},
+		},
+		{
+			name: "no created_at",
+			pullRequest: PullRequest{
+				PullRequestShort: PullRequestShort{AuthorID: "u1", Status: StatusOpen},
+			},
+		},
+		{
+			name: "merged without merged_at",
+			pullRequest: PullRequest{
+				PullRequestShort: PullRequestShort{AuthorID: "u1", Status: StatusMerged},
+				CreatedAt:        &createdAt,
+			},
+		},
+		{
+			name: "open with merged_at",
+			pullRequest: PullRequest{
+				PullRequestShort: PullRequestShort{AuthorID: "u1", Status: StatusOpen},
+				CreatedAt:        &createdAt,
+				MergedAt:         &mergedAt,
+			},
+		},
+		{
+			name: "merged before created",
+			pullRequest: PullRequest{
+				PullRequestShort: PullRequestShort{AuthorID: "u1", Status: StatusMerged},
+				CreatedAt:        &createdAt,
+				MergedAt:         &beforeCreated,
+			},
+		},
+		{
+			name: "author reviews",
+			pullRequest: PullRequest{
+				PullRequestShort:  PullRequestShort{AuthorID: "u1", Status: StatusOpen},
+				AssignedReviewers: []string{"u1"},
+				CreatedAt:         &createdAt,
+			},
+		},
+		{
+			name: "repeated reviewer",
+			pullRequest: PullRequest{
+				PullRequestShort:  PullRequestShort{AuthorID: "u1", Status: StatusOpen},
+				AssignedReviewers: []string{"u2", "u3", "u2"},
+				CreatedAt:         &createdAt,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pullRequest.ValidateImported()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, domain.ErrImportRecordInvalid)
+			}
+		})
+	}
+}
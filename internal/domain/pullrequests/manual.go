@@ -0,0 +1,54 @@
+package pullrequests
+
+import (
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+)
+
+// RequestReviewer assigns a reviewer chosen by hand next to the picked ones. It must be an active
+// member of the pool other than the author who has not declined the PR, team rules are not checked
+// for an explicit choice. Requesting a reviewer already assigned changes nothing.
+func (p *PullRequest) RequestReviewer(reviewerID string, members []teamsDomain.Member) error {
+	if p.Status == StatusMerged {
+		return domain.ErrPullRequestAlreadyMerged
+	}
+
+	if slices.Contains(p.AssignedReviewers, reviewerID) {
+		return nil
+	}
+
+	eligible := slices.ContainsFunc(members, func(member teamsDomain.Member) bool {
+		return member.ID == reviewerID && member.IsActive
+	})
+	if !eligible || reviewerID == p.AuthorID || slices.Contains(p.DeclinedReviewers, reviewerID) {
+		return domain.ErrReviewerNotEligible
+	}
+
+	p.AssignedReviewers = append(p.AssignedReviewers, reviewerID)
+
+	return nil
+}
+
+// RemoveReviewer takes a reviewer off the PR without a replacement. Required group slots
+// cannot be left empty, their reviewers are reassigned instead.
+func (p *PullRequest) RemoveReviewer(reviewerID string) error {
+	if p.Status == StatusMerged {
+		return domain.ErrPullRequestAlreadyMerged
+	}
+
+	idx := slices.Index(p.AssignedReviewers, reviewerID)
+	if idx == -1 {
+		return domain.ErrReviewerNotAssigned
+	}
+	if _, ok := p.GroupReviewers[reviewerID]; ok {
+		return domain.ErrReviewerInGroupSlot
+	}
+
+	p.AssignedReviewers = slices.Delete(p.AssignedReviewers, idx, idx+1)
+	p.ApprovedReviewers = slices.DeleteFunc(p.ApprovedReviewers, func(id string) bool {
+		return id == reviewerID
+	})
+
+	return nil
+}
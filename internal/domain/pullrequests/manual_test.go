@@ -0,0 +1,49 @@
+package pullrequests
+
+import (
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequest_RequestReviewer(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "author", IsActive: true},
+		{ID: "r1", IsActive: true},
+		{ID: "r2", IsActive: true},
+		{ID: "r3", IsActive: true},
+		{ID: "inactive", IsActive: false},
+	}
+	pullRequest := &PullRequest{
+		PullRequestShort:  PullRequestShort{AuthorID: "author", Status: StatusOpen},
+		DeclinedReviewers: []string{"r2"},
+	}
+
+	require.NoError(t, pullRequest.RequestReviewer("r3", members))
+	require.NoError(t, pullRequest.RequestReviewer("r3", members))
+
+	// declined reviewers are never assigned again
+	for _, reviewerID := range []string{"author", "inactive", "outsider", "r2"} {
+		require.ErrorIs(t, pullRequest.RequestReviewer(reviewerID, members), domain.ErrReviewerNotEligible)
+	}
+
+	// the picker only fills the slot left next to the requested reviewer
+	picker := &MockReviewerPicker{
+		PickFunc: func(members []teamsDomain.Member, count int) []teamsDomain.Member {
+			return members[:count]
+		},
+	}
+	require.NoError(t, pullRequest.AssignReviewers(members, picker, 2))
+	assert.Equal(t, []string{"r3", "r1"}, pullRequest.AssignedReviewers)
+
+	require.NoError(t, pullRequest.RemoveReviewer("r3"))
+	require.ErrorIs(t, pullRequest.RemoveReviewer("r3"), domain.ErrReviewerNotAssigned)
+	assert.Equal(t, []string{"r1"}, pullRequest.AssignedReviewers)
+
+	pullRequest.Status = StatusMerged
+	require.ErrorIs(t, pullRequest.RequestReviewer("r2", members), domain.ErrPullRequestAlreadyMerged)
+	require.ErrorIs(t, pullRequest.RemoveReviewer("r1"), domain.ErrPullRequestAlreadyMerged)
+}
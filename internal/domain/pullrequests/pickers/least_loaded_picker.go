@@ -0,0 +1,58 @@
+package pickers
+
+import (
+	"cmp"
+	"maps"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// LeastLoadedReviewerPicker picks members with the fewest assignments made through it so far,
+// breaking ties by member ID.
+type LeastLoadedReviewerPicker struct {
+	mu    sync.Mutex
+	loads map[string]int
+}
+
+// NewLeastLoadedReviewerPicker starts counting from the given loads, which may be nil.
+func NewLeastLoadedReviewerPicker(loads map[string]int) *LeastLoadedReviewerPicker {
+	initial := make(map[string]int, len(loads))
+	maps.Copy(initial, loads)
+
+	return &LeastLoadedReviewerPicker{
+		loads: initial,
+	}
+}
+
+func (p *LeastLoadedReviewerPicker) Strategy() Strategy {
+	return StrategyLeastLoaded
+}
+
+func (p *LeastLoadedReviewerPicker) Pick(
+	members []teamsDomain.Member,
+	count int,
+) []teamsDomain.Member {
+	if len(members) == 0 || count <= 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ordered := slices.Clone(members)
+	slices.SortFunc(ordered, func(a, b teamsDomain.Member) int {
+		return cmp.Or(
+			cmp.Compare(p.loads[a.ID], p.loads[b.ID]),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+
+	reviewers := ordered[:min(count, len(ordered)):min(count, len(ordered))]
+	for _, reviewer := range reviewers {
+		p.loads[reviewer.ID]++
+	}
+
+	return reviewers
+}
@@ -3,25 +3,64 @@ package pickers
 import (
 	"math/rand/v2"
 	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+	"sync"
 )
 
-type RandomReviewerPicker struct{}
+// RandomReviewerPicker picks reviewers uniformly at random.
+// The zero value uses the global source, NewRandomReviewerPicker makes picks reproducible.
+type RandomReviewerPicker struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func NewRandomReviewerPicker(src rand.Source) *RandomReviewerPicker {
+	return &RandomReviewerPicker{
+		rng: rand.New(src), //nolint:gosec // reviewers choice is not security sensitive
+	}
+}
+
+// NewSource returns a seeded source, or a randomly seeded one when seed is 0.
+func NewSource(seed uint64) rand.Source {
+	if seed == 0 {
+		return rand.NewPCG(rand.Uint64(), rand.Uint64()) //nolint:gosec // see above
+	}
+
+	return rand.NewPCG(seed, seed)
+}
+
+func (p *RandomReviewerPicker) Strategy() Strategy {
+	return StrategyRandom
+}
 
 func (p *RandomReviewerPicker) Pick(members []teamsDomain.Member, count int) []teamsDomain.Member {
 	if len(members) == 0 || count <= 0 {
 		return nil
 	}
 
-	if len(members) <= count {
-		return members
+	// never reorder the caller's slice
+	reviewers := slices.Clone(members)
+	if len(reviewers) <= count {
+		return reviewers
 	}
 
-	rand.Shuffle(len(members), func(i, j int) {
+	p.shuffle(reviewers)
+
+	return reviewers[:count:count]
+}
+
+func (p *RandomReviewerPicker) shuffle(members []teamsDomain.Member) {
+	swap := func(i, j int) {
 		members[i], members[j] = members[j], members[i]
-	})
+	}
+
+	if p.rng == nil {
+		rand.Shuffle(len(members), swap) //nolint:gosec // see above
+		return
+	}
 
-	reviewers := make([]teamsDomain.Member, count)
-	copy(reviewers, members[:count])
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	return reviewers
+	p.rng.Shuffle(len(members), swap)
 }
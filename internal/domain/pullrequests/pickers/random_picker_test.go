@@ -2,6 +2,7 @@ package pickers
 
 import (
 	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -125,3 +126,51 @@ func TestRandomReviewerPicker_Pick_TableDriven(t *testing.T) {
 		})
 	}
 }
+
+func TestRandomReviewerPicker_Pick_Seeded(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "1", Name: "User1", IsActive: true},
+		{ID: "2", Name: "User2", IsActive: true},
+		{ID: "3", Name: "User3", IsActive: true},
+		{ID: "4", Name: "User4", IsActive: true},
+		{ID: "5", Name: "User5", IsActive: true},
+	}
+
+	const seed = 42
+
+	first := NewRandomReviewerPicker(NewSource(seed))
+	second := NewRandomReviewerPicker(NewSource(seed))
+
+	for range 10 {
+		assert.Equal(t, first.Pick(members, 2), second.Pick(members, 2))
+	}
+}
+
+func TestRandomReviewerPicker_Pick_DoesNotMutateMembers(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "1", Name: "User1", IsActive: true},
+		{ID: "2", Name: "User2", IsActive: true},
+		{ID: "3", Name: "User3", IsActive: true},
+		{ID: "4", Name: "User4", IsActive: true},
+	}
+	original := slices.Clone(members)
+
+	pickers := []*RandomReviewerPicker{
+		{},
+		NewRandomReviewerPicker(NewSource(1)),
+	}
+
+	for _, picker := range pickers {
+		for range 10 {
+			reviewers := picker.Pick(members, 2)
+			require.Len(t, reviewers, 2)
+
+			reviewers[0].Name = "changed"
+			require.Equal(t, original, members)
+		}
+
+		all := picker.Pick(members, len(members))
+		all[0].Name = "changed"
+		require.Equal(t, original, members)
+	}
+}
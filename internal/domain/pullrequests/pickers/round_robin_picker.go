@@ -0,0 +1,57 @@
+package pickers
+
+import (
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// RoundRobinReviewerPicker walks members ordered by ID, continuing after the last picked one.
+type RoundRobinReviewerPicker struct {
+	mu     sync.Mutex
+	lastID string
+}
+
+func NewRoundRobinReviewerPicker() *RoundRobinReviewerPicker {
+	return &RoundRobinReviewerPicker{}
+}
+
+func (p *RoundRobinReviewerPicker) Strategy() Strategy {
+	return StrategyRoundRobin
+}
+
+func (p *RoundRobinReviewerPicker) Pick(
+	members []teamsDomain.Member,
+	count int,
+) []teamsDomain.Member {
+	if len(members) == 0 || count <= 0 {
+		return nil
+	}
+
+	ordered := slices.Clone(members)
+	slices.SortFunc(ordered, func(a, b teamsDomain.Member) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	count = min(count, len(ordered))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	start, _ := slices.BinarySearchFunc(ordered, p.lastID, func(m teamsDomain.Member, id string) int {
+		if m.ID <= id {
+			return -1
+		}
+		return 1
+	})
+
+	reviewers := make([]teamsDomain.Member, 0, count)
+	for i := range count {
+		reviewers = append(reviewers, ordered[(start+i)%len(ordered)])
+	}
+
+	p.lastID = reviewers[len(reviewers)-1].ID
+
+	return reviewers
+}
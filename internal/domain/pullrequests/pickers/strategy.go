@@ -0,0 +1,33 @@
+package pickers
+
+import (
+	"math/rand/v2"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+)
+
+type Strategy string
+
+const (
+	StrategyRandom      Strategy = "random"
+	StrategyRoundRobin  Strategy = "round_robin"
+	StrategyLeastLoaded Strategy = "least_loaded"
+)
+
+func Strategies() []Strategy {
+	return []Strategy{StrategyRandom, StrategyRoundRobin, StrategyLeastLoaded}
+}
+
+// New builds a fresh picker for strategy, src is used only by random strategy.
+func New(strategy Strategy, src rand.Source) (prsDomain.ReviewerPicker, error) {
+	switch strategy {
+	case StrategyRandom:
+		return NewRandomReviewerPicker(src), nil
+	case StrategyRoundRobin:
+		return NewRoundRobinReviewerPicker(), nil
+	case StrategyLeastLoaded:
+		return NewLeastLoadedReviewerPicker(nil), nil
+	default:
+		return nil, domain.ErrUnknownStrategy
+	}
+}
@@ -0,0 +1,70 @@
+package pickers
+
+import (
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func memberIDs(members []teamsDomain.Member) []string {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+func TestRoundRobinReviewerPicker_Pick(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "3", IsActive: true},
+		{ID: "1", IsActive: true},
+		{ID: "4", IsActive: true},
+		{ID: "2", IsActive: true},
+	}
+	original := slices.Clone(members)
+
+	picker := NewRoundRobinReviewerPicker()
+
+	assert.Equal(t, []string{"1", "2"}, memberIDs(picker.Pick(members, 2)))
+	assert.Equal(t, []string{"3", "4"}, memberIDs(picker.Pick(members, 2)))
+	assert.Equal(t, []string{"1", "2", "3"}, memberIDs(picker.Pick(members, 3)))
+	// the author is usually excluded, rotation continues after the last picked member
+	assert.Equal(t, []string{"4", "1"}, memberIDs(picker.Pick(members[:3], 2)))
+	assert.Equal(t, []string{"2", "3", "4", "1"}, memberIDs(picker.Pick(members, 10)))
+	assert.Nil(t, picker.Pick(nil, 2))
+	assert.Equal(t, original, members)
+}
+
+func TestLeastLoadedReviewerPicker_Pick(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "1", IsActive: true},
+		{ID: "2", IsActive: true},
+		{ID: "3", IsActive: true},
+	}
+	original := slices.Clone(members)
+
+	picker := NewLeastLoadedReviewerPicker(map[string]int{"1": 2})
+
+	assert.Equal(t, []string{"2", "3"}, memberIDs(picker.Pick(members, 2)))
+	// ties are broken by ID
+	assert.Equal(t, []string{"2", "3"}, memberIDs(picker.Pick(members, 2)))
+	assert.Equal(t, []string{"2"}, memberIDs(picker.Pick(members[1:], 1)))
+	assert.Equal(t, []string{"1", "3", "2"}, memberIDs(picker.Pick(members, 5)))
+	assert.Nil(t, picker.Pick(members, 0))
+	assert.Equal(t, original, members)
+}
+
+func TestNew(t *testing.T) {
+	for _, strategy := range Strategies() {
+		picker, err := New(strategy, NewSource(1))
+		require.NoError(t, err)
+		assert.NotNil(t, picker)
+	}
+
+	_, err := New("unknown", NewSource(1))
+	require.ErrorIs(t, err, domain.ErrUnknownStrategy)
+}
@@ -0,0 +1,57 @@
+package pullrequests
+
+import (
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+)
+
+// DefaultReviewersCount is used when no review rule matching the PR sets the count.
+const DefaultReviewersCount = 2
+
+// Size describes the change under review, review rules and required groups match against it.
+type Size struct {
+	LinesChanged int
+	Labels       []string
+	// Paths are the files the PR touches.
+	Paths []string
+}
+
+// ReviewPlan is what the review of a PR needs.
+type ReviewPlan struct {
+	ReviewersCount int
+	// GroupNames are the extra teams of the matching review rules and the matching required groups.
+	// Each fills a slot of its own on top of ReviewersCount and has to approve before the PR is merged.
+	GroupNames []string
+}
+
+// PlanReviewers evaluates review rules and required groups against the size of the PR. The largest count
+// among matching rules wins, and groups are listed once, extra teams in the order of the rules first.
+func PlanReviewers(
+	size Size,
+	reviewRules []teamsDomain.ReviewRule,
+	requiredGroups []teamsDomain.RequiredGroup,
+) ReviewPlan {
+	plan := ReviewPlan{GroupNames: []string{}}
+	for _, rule := range reviewRules {
+		if !rule.Matches(size.LinesChanged, size.Labels) {
+			continue
+		}
+
+		plan.ReviewersCount = max(plan.ReviewersCount, rule.ReviewersCount)
+		if rule.ExtraTeamName != "" && !slices.Contains(plan.GroupNames, rule.ExtraTeamName) {
+			plan.GroupNames = append(plan.GroupNames, rule.ExtraTeamName)
+		}
+	}
+
+	for _, group := range requiredGroups {
+		if group.Matches(size.Labels, size.Paths) && !slices.Contains(plan.GroupNames, group.TeamName) {
+			plan.GroupNames = append(plan.GroupNames, group.TeamName)
+		}
+	}
+
+	if plan.ReviewersCount == 0 {
+		plan.ReviewersCount = DefaultReviewersCount
+	}
+
+	return plan
+}
@@ -0,0 +1,74 @@
+package pullrequests
+
+import (
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanReviewers(t *testing.T) {
+	reviewRules := []teamsDomain.ReviewRule{
+		{ReviewersCount: 1},
+		{MinLinesChanged: 500, ReviewersCount: 3},
+		{Label: "security", ExtraTeamName: "security"},
+		{MinLinesChanged: 100, Label: "security", ExtraTeamName: "security"},
+		{Label: "migration", ReviewersCount: 2, ExtraTeamName: "dba"},
+	}
+
+	tests := []struct {
+		name string
+		size Size
+		want ReviewPlan
+	}{
+		{
+			name: "small fix",
+			size: Size{LinesChanged: 5},
+			want: ReviewPlan{ReviewersCount: 1, GroupNames: []string{}},
+		},
+		{
+			name: "large change",
+			size: Size{LinesChanged: 800},
+			want: ReviewPlan{ReviewersCount: 3, GroupNames: []string{}},
+		},
+		{
+			name: "security label",
+			size: Size{LinesChanged: 200, Labels: []string{"security"}},
+			want: ReviewPlan{ReviewersCount: 1, GroupNames: []string{"security"}},
+		},
+		{
+			name: "large migration touching security",
+			size: Size{LinesChanged: 600, Labels: []string{"migration", "security"}},
+			want: ReviewPlan{ReviewersCount: 3, GroupNames: []string{"security", "dba"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PlanReviewers(tt.size, reviewRules, nil))
+		})
+	}
+
+	defaultPlan := ReviewPlan{ReviewersCount: DefaultReviewersCount, GroupNames: []string{}}
+	assert.Equal(t, defaultPlan, PlanReviewers(Size{}, nil, nil))
+}
+
+func TestPlanReviewers_RequiredGroups(t *testing.T) {
+	requiredGroups := []teamsDomain.RequiredGroup{
+		{TeamName: "security", Labels: []string{"security"}, PathPrefixes: []string{"internal/auth/"}},
+		{TeamName: "dba", PathPrefixes: []string{"migrations/"}},
+	}
+
+	plan := PlanReviewers(Size{Paths: []string{"internal/auth/jwt.go", "migrations/00013.sql"}}, nil, requiredGroups)
+	assert.Equal(t, []string{"security", "dba"}, plan.GroupNames)
+	assert.Equal(t, DefaultReviewersCount, plan.ReviewersCount)
+
+	plan = PlanReviewers(Size{Labels: []string{"frontend"}, Paths: []string{"web/app.ts"}}, nil, requiredGroups)
+	assert.Empty(t, plan.GroupNames)
+
+	// an extra team of a review rule and a required group share the slot
+	reviewRules := []teamsDomain.ReviewRule{{Label: "security", ExtraTeamName: "security"}}
+	size := Size{Labels: []string{"security"}, Paths: []string{"migrations/00013.sql"}}
+	plan = PlanReviewers(size, reviewRules, requiredGroups)
+	assert.Equal(t, []string{"security", "dba"}, plan.GroupNames)
+}
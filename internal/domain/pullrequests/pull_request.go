@@ -18,6 +18,8 @@ type PullRequestShort struct {
 	ID       string
 	Name     string
 	AuthorID string
+	// TeamName is the team reviewers come from, empty once the team is deleted.
+	TeamName string
 	Status   StatusPR
 }
 
@@ -25,10 +27,24 @@ type PullRequest struct {
 	PullRequestShort
 
 	AssignedReviewers []string
+	// DeclinedReviewers are never assigned to the PR again.
+	DeclinedReviewers []string
+	// GroupReviewers maps the reviewers filling required group and extra team slots to their group,
+	// the other assigned reviewers fill team slots.
+	GroupReviewers map[string]string
+	// ApprovedReviewers are the assigned reviewers who approved the PR.
+	ApprovedReviewers []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
 }
 
+// Reassignment records a review handed over from one reviewer to another.
+type Reassignment struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+}
+
 type ReviewerPicker interface {
 	Pick(members []teamsDomain.Member, count int) []teamsDomain.Member
 }
@@ -40,6 +56,9 @@ type ReviewerReassigner interface {
 	) (newReviewer *teamsDomain.Member, err error)
 }
 
+// AssignReviewers fills the slots left up to count with active members other than the author,
+// the reviewers already assigned and the ones who declined. Reviewers requested by hand keep their
+// slots, and it can be called again with a fallback pool.
 func (p *PullRequest) AssignReviewers(
 	members []teamsDomain.Member,
 	picker ReviewerPicker,
@@ -49,17 +68,15 @@ func (p *PullRequest) AssignReviewers(
 		return domain.ErrPullRequestAlreadyMerged
 	}
 
-	const activeMembersDefaultCap = 2
-	activeMembersExcludeAuthor := make([]teamsDomain.Member, 0, activeMembersDefaultCap)
-	for _, member := range members {
-		if member.IsActive && member.ID != p.AuthorID {
-			activeMembersExcludeAuthor = append(activeMembersExcludeAuthor, member)
-		}
+	free := count - len(p.AssignedReviewers)
+	if free <= 0 {
+		return nil
 	}
 
-	reviewers := picker.Pick(activeMembersExcludeAuthor, count)
+	reviewers := picker.Pick(p.candidates(members), free)
 
-	reviewerIDs := make([]string, 0, len(reviewers))
+	reviewerIDs := make([]string, 0, len(p.AssignedReviewers)+len(reviewers))
+	reviewerIDs = append(reviewerIDs, p.AssignedReviewers...)
 	for _, reviewer := range reviewers {
 		reviewerIDs = append(reviewerIDs, reviewer.ID)
 	}
@@ -69,10 +86,31 @@ func (p *PullRequest) AssignReviewers(
 	return nil
 }
 
+// candidates are the active members other than the author, the reviewers already assigned
+// and the ones who declined.
+func (p *PullRequest) candidates(members []teamsDomain.Member) []teamsDomain.Member {
+	const activeMembersDefaultCap = 2
+	candidates := make([]teamsDomain.Member, 0, activeMembersDefaultCap)
+	for _, member := range members {
+		if member.IsActive &&
+			member.ID != p.AuthorID &&
+			!slices.Contains(p.AssignedReviewers, member.ID) &&
+			!slices.Contains(p.DeclinedReviewers, member.ID) {
+			candidates = append(candidates, member)
+		}
+	}
+
+	return candidates
+}
+
+// Merge is blocked until the reviewers of all required group slots have approved.
 func (p *PullRequest) Merge() error {
 	if p.Status == StatusMerged {
 		return domain.ErrPullRequestAlreadyMerged
 	}
+	if len(p.PendingGroups()) > 0 {
+		return domain.ErrApprovalRequired
+	}
 
 	p.Status = StatusMerged
 	now := time.Now()
@@ -100,7 +138,8 @@ func (p *PullRequest) Reassign(
 		if member.IsActive &&
 			member.ID != oldReviewer.ID &&
 			member.ID != p.AuthorID &&
-			!isAlreadyReviewer(&member) {
+			!isAlreadyReviewer(&member) &&
+			!slices.Contains(p.DeclinedReviewers, member.ID) {
 			activeMembersExcludeAuthorReviewers = append(
 				activeMembersExcludeAuthorReviewers,
 				member,
@@ -120,5 +159,14 @@ func (p *PullRequest) Reassign(
 		}
 	}
 
+	// the replacement takes over the group slot, but not the approval
+	if group, ok := p.GroupReviewers[oldReviewer.ID]; ok {
+		delete(p.GroupReviewers, oldReviewer.ID)
+		p.GroupReviewers[newReviewer.ID] = group
+	}
+	p.ApprovedReviewers = slices.DeleteFunc(p.ApprovedReviewers, func(id string) bool {
+		return id == oldReviewer.ID
+	})
+
 	return newReviewer.ID, nil
 }
@@ -114,6 +114,29 @@ func TestPullRequest_AssignReviewers(t *testing.T) {
 			wantErr:           false,
 			expectedReviewers: []string{},
 		},
+		{
+			name: "success: fill the remaining slot from a fallback pool",
+			pr: &PullRequest{
+				PullRequestShort: PullRequestShort{
+					AuthorID: "author1",
+					Status:   StatusOpen,
+				},
+				AssignedReviewers: []string{"reviewer1"},
+			},
+			members: []teamsDomain.Member{
+				{ID: "reviewer1", Name: "Reviewer1", IsActive: true},
+				{ID: "reviewer2", Name: "Reviewer2", IsActive: true},
+				{ID: "reviewer3", Name: "Reviewer3", IsActive: true},
+			},
+			picker: &MockReviewerPicker{
+				PickFunc: func(members []teamsDomain.Member, count int) []teamsDomain.Member {
+					return members[:count] // reviewer1 is already assigned
+				},
+			},
+			count:             2,
+			wantErr:           false,
+			expectedReviewers: []string{"reviewer1", "reviewer2"},
+		},
 		{
 			name: "error: cannot assign reviewers to merged PR",
 			pr: &PullRequest{
@@ -10,9 +10,11 @@ type RandomReviewerReassigner struct {
 	picker *reviewerPickers.RandomReviewerPicker
 }
 
-func NewRandomReviewerReassigner() *RandomReviewerReassigner {
+func NewRandomReviewerReassigner(
+	picker *reviewerPickers.RandomReviewerPicker,
+) *RandomReviewerReassigner {
 	return &RandomReviewerReassigner{
-		picker: &reviewerPickers.RandomReviewerPicker{},
+		picker: picker,
 	}
 }
 
@@ -0,0 +1,152 @@
+package rules
+
+import (
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+)
+
+// Picker enforces team rules on top of any ReviewerPicker for a PR written by authorID.
+type Picker struct {
+	inner    prsDomain.ReviewerPicker
+	rules    []teamsDomain.Rule
+	authorID string
+
+	skipped []Violation
+	applied []Violation
+}
+
+func NewPicker(
+	inner prsDomain.ReviewerPicker,
+	rules []teamsDomain.Rule,
+	authorID string,
+) *Picker {
+	return &Picker{
+		inner:    inner,
+		rules:    rules,
+		authorID: authorID,
+	}
+}
+
+func (p *Picker) Pick(members []teamsDomain.Member, count int) []teamsDomain.Member {
+	p.skipped = nil
+
+	candidates := p.candidates(members)
+	reviewers := p.pair(slices.Clone(p.inner.Pick(slices.Clone(candidates), count)), candidates, count)
+
+	for _, violation := range p.skipped {
+		if !slices.Contains(p.applied, violation) {
+			p.applied = append(p.applied, violation)
+		}
+	}
+
+	return reviewers
+}
+
+// Skipped returns the rules that kept members out of the last Pick.
+func (p *Picker) Skipped() []Violation {
+	return p.skipped
+}
+
+// Applied returns the rules that kept members out of any Pick so far, each once.
+func (p *Picker) Applied() []Violation {
+	return p.applied
+}
+
+func (p *Picker) candidates(members []teamsDomain.Member) []teamsDomain.Member {
+	candidates := make([]teamsDomain.Member, 0, len(members))
+	for _, member := range members {
+		if rule, ok := isConflicting(p.rules, p.authorID, member.ID); ok {
+			p.skipped = append(p.skipped, conflictViolation(rule, member.ID, p.authorID))
+			continue
+		}
+		candidates = append(candidates, member)
+	}
+
+	// mentees are only eligible while their mentors are, which may cascade
+	return p.dropUnpaired(candidates)
+}
+
+// pair completes picked mentees with their mentors, replacing unbound reviewers
+// when there is no free slot left and dropping the mentee as a last resort.
+func (p *Picker) pair(
+	reviewers []teamsDomain.Member,
+	candidates []teamsDomain.Member,
+	count int,
+) []teamsDomain.Member {
+	for range 2*len(candidates) + 1 {
+		menteeIdx, rule, ok := p.firstUnpaired(reviewers)
+		if !ok {
+			return reviewers
+		}
+
+		// candidates never hold a mentee without its mentor
+		mentorIdx := slices.IndexFunc(candidates, func(m teamsDomain.Member) bool {
+			return m.ID == rule.OtherUserID
+		})
+
+		if len(reviewers) < count {
+			reviewers = append(reviewers, candidates[mentorIdx])
+			continue
+		}
+
+		if freeIdx := p.firstUnbound(reviewers, menteeIdx); freeIdx != -1 {
+			reviewers[freeIdx] = candidates[mentorIdx]
+			continue
+		}
+
+		reviewers = p.drop(reviewers, menteeIdx, rule)
+	}
+
+	return p.dropUnpaired(reviewers)
+}
+
+func (p *Picker) dropUnpaired(members []teamsDomain.Member) []teamsDomain.Member {
+	for {
+		idx, rule, ok := p.firstUnpaired(members)
+		if !ok {
+			return members
+		}
+		members = p.drop(members, idx, rule)
+	}
+}
+
+func (p *Picker) firstUnpaired(members []teamsDomain.Member) (int, teamsDomain.Rule, bool) {
+	for i, member := range members {
+		rule, ok := missingMentor(p.rules, member.ID, func(userID string) bool {
+			return containsMember(members, userID)
+		})
+		if ok {
+			return i, rule, true
+		}
+	}
+
+	return -1, teamsDomain.Rule{}, false
+}
+
+// firstUnbound finds a reviewer that is neither a mentee nor a mentor of a picked mentee.
+func (p *Picker) firstUnbound(reviewers []teamsDomain.Member, menteeIdx int) int {
+	ids := memberIDs(reviewers)
+
+	for i, reviewer := range reviewers {
+		if i == menteeIdx {
+			continue
+		}
+		if isMentee(p.rules, reviewer.ID) || isMentor(p.rules, reviewer.ID, ids) {
+			continue
+		}
+		return i
+	}
+
+	return -1
+}
+
+func (p *Picker) drop(
+	reviewers []teamsDomain.Member,
+	idx int,
+	rule teamsDomain.Rule,
+) []teamsDomain.Member {
+	p.skipped = append(p.skipped, pairingViolation(rule))
+
+	return slices.Delete(reviewers, idx, idx+1)
+}
@@ -0,0 +1,245 @@
+package rules
+
+import (
+	"errors"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// firstPicker picks members in the order they are given.
+type firstPicker struct{}
+
+func (p *firstPicker) Pick(members []teamsDomain.Member, count int) []teamsDomain.Member {
+	if len(members) <= count {
+		return members
+	}
+
+	return members[:count]
+}
+
+//nolint:funlen
+func TestPicker_AssignReviewers(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "author", Name: "Author", IsActive: true},
+		{ID: "u1", Name: "User1", IsActive: true},
+		{ID: "u2", Name: "User2", IsActive: true},
+		{ID: "u3", Name: "User3", IsActive: true},
+		{ID: "inactive", Name: "Inactive", IsActive: false},
+	}
+
+	tests := []struct {
+		name              string
+		rules             []teamsDomain.Rule
+		members           []teamsDomain.Member
+		count             int
+		expectedReviewers []string
+		expectedSkipped   int
+	}{
+		{
+			name:              "no rules keeps author exclusion",
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u1", "u2"},
+		},
+		{
+			name: "conflict with author excludes reviewer",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindConflict, UserID: "author", OtherUserID: "u1"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u2", "u3"},
+			expectedSkipped:   1,
+		},
+		{
+			name: "conflict is symmetric",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindConflict, UserID: "u1", OtherUserID: "author"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u2", "u3"},
+			expectedSkipped:   1,
+		},
+		{
+			name: "conflict between reviewers does not matter",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindConflict, UserID: "u1", OtherUserID: "u2"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u1", "u2"},
+		},
+		{
+			name: "conflicts leave no candidates",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindConflict, UserID: "author", OtherUserID: "u1"},
+				{Kind: teamsDomain.RuleKindConflict, UserID: "author", OtherUserID: "u2"},
+				{Kind: teamsDomain.RuleKindConflict, UserID: "author", OtherUserID: "u3"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{},
+			expectedSkipped:   3,
+		},
+		{
+			name: "mentor joins mentee in free slot",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u1", OtherUserID: "u3"},
+			},
+			members:           members,
+			count:             3,
+			expectedReviewers: []string{"u1", "u2", "u3"},
+		},
+		{
+			name: "mentor replaces unbound reviewer",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u1", OtherUserID: "u3"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u1", "u3"},
+		},
+		{
+			name: "mentee dropped when there is a single slot",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u1", OtherUserID: "u3"},
+			},
+			members:           members,
+			count:             1,
+			expectedReviewers: []string{},
+			expectedSkipped:   1,
+		},
+		{
+			name: "mentee excluded when mentor is the author",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u1", OtherUserID: "author"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u2", "u3"},
+			expectedSkipped:   1,
+		},
+		{
+			name: "mentee excluded when mentor is inactive",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u1", OtherUserID: "inactive"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u2", "u3"},
+			expectedSkipped:   1,
+		},
+		{
+			name: "mentee excluded when mentor conflicts with author",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u1", OtherUserID: "u2"},
+				{Kind: teamsDomain.RuleKindConflict, UserID: "author", OtherUserID: "u2"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u3"},
+			expectedSkipped:   2,
+		},
+		{
+			name: "mentee author is reviewed as usual",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "author", OtherUserID: "u3"},
+			},
+			members:           members,
+			count:             2,
+			expectedReviewers: []string{"u1", "u2"},
+		},
+		{
+			name: "mentoring chain is pulled in",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u1", OtherUserID: "u2"},
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u2", OtherUserID: "u3"},
+			},
+			members:           members,
+			count:             3,
+			expectedReviewers: []string{"u1", "u2", "u3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := &prsDomain.PullRequest{
+				PullRequestShort: prsDomain.PullRequestShort{
+					AuthorID: "author",
+					Status:   prsDomain.StatusOpen,
+				},
+			}
+			picker := NewPicker(&firstPicker{}, tt.rules, pr.AuthorID)
+
+			err := pr.AssignReviewers(tt.members, picker, tt.count)
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, tt.expectedReviewers, pr.AssignedReviewers)
+			assert.Len(t, picker.Skipped(), tt.expectedSkipped)
+			assert.Empty(t, Check(tt.rules, pr.AuthorID, pr.AssignedReviewers))
+		})
+	}
+}
+
+func TestPicker_DoesNotMutateMembers(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+	}
+	rules := []teamsDomain.Rule{
+		{Kind: teamsDomain.RuleKindPairing, UserID: "u1", OtherUserID: "u3"},
+	}
+
+	reviewers := NewPicker(&firstPicker{}, rules, "author").Pick(members, 2)
+
+	assert.Equal(t, []string{"u1", "u3"}, memberIDs(reviewers))
+	assert.Equal(t, []string{"u1", "u2", "u3"}, memberIDs(members))
+}
+
+func TestPicker_Applied(t *testing.T) {
+	team := []teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+	}
+	parent := []teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u3", IsActive: true},
+	}
+	rules := []teamsDomain.Rule{
+		{Kind: teamsDomain.RuleKindConflict, UserID: "author", OtherUserID: "u1"},
+	}
+
+	picker := NewPicker(&firstPicker{}, rules, "author")
+	picker.Pick(team, 1)
+	picker.Pick(parent, 1)
+	picker.Pick(team[1:], 1)
+
+	// the conflict kept u1 out of two picks, the last pick was clean
+	assert.Empty(t, picker.Skipped())
+	assert.Equal(t, []Violation{conflictViolation(rules[0], "u1", "author")}, picker.Applied())
+}
+
+func TestCheck(t *testing.T) {
+	rules := []teamsDomain.Rule{
+		{Kind: teamsDomain.RuleKindConflict, UserID: "u1", OtherUserID: "author"},
+		{Kind: teamsDomain.RuleKindPairing, UserID: "u2", OtherUserID: "u3"},
+	}
+
+	violations := Check(rules, "author", []string{"u1", "u2"})
+	require.Len(t, violations, 2)
+
+	err := &ViolationError{Violations: violations}
+	require.True(t, errors.Is(err, domain.ErrRuleViolation))
+	assert.Equal(t,
+		"CONFLICT_OF_INTEREST(u1, author): u1 must not review PRs of author; "+
+			"PAIRING(u2, u3): u2 must be paired with u3",
+		err.Error(),
+	)
+}
@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"fmt"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+)
+
+// Reassigner enforces team rules on top of any ReviewerReassigner for a single PR.
+type Reassigner struct {
+	inner     prsDomain.ReviewerReassigner
+	rules     []teamsDomain.Rule
+	authorID  string
+	reviewers []string
+}
+
+func NewReassigner(
+	inner prsDomain.ReviewerReassigner,
+	rules []teamsDomain.Rule,
+	pullRequest *prsDomain.PullRequest,
+) *Reassigner {
+	return &Reassigner{
+		inner:     inner,
+		rules:     rules,
+		authorID:  pullRequest.AuthorID,
+		reviewers: slices.Clone(pullRequest.AssignedReviewers),
+	}
+}
+
+func (r *Reassigner) Reassign(
+	oldReviewer *teamsDomain.Member,
+	members []teamsDomain.Member,
+) (*teamsDomain.Member, error) {
+	remaining := slices.DeleteFunc(slices.Clone(r.reviewers), func(id string) bool {
+		return id == oldReviewer.ID
+	})
+
+	var violations []Violation
+	for _, rule := range r.rules {
+		if rule.Kind == teamsDomain.RuleKindPairing &&
+			rule.OtherUserID == oldReviewer.ID &&
+			slices.Contains(remaining, rule.UserID) {
+			violations = append(violations, Violation{
+				Rule: rule,
+				Reason: fmt.Sprintf(
+					"mentor %s cannot leave while %s reviews",
+					rule.OtherUserID,
+					rule.UserID,
+				),
+			})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, &ViolationError{Violations: violations}
+	}
+
+	candidates := make([]teamsDomain.Member, 0, len(members))
+	for _, member := range members {
+		if rule, ok := isConflicting(r.rules, r.authorID, member.ID); ok {
+			violations = append(violations, conflictViolation(rule, member.ID, r.authorID))
+			continue
+		}
+
+		// a single free slot cannot fit both a mentee and its absent mentor
+		rule, ok := missingMentor(r.rules, member.ID, func(userID string) bool {
+			return slices.Contains(remaining, userID)
+		})
+		if ok {
+			violations = append(violations, pairingViolation(rule))
+			continue
+		}
+
+		candidates = append(candidates, member)
+	}
+
+	if len(candidates) == 0 && len(violations) > 0 {
+		return nil, &ViolationError{Violations: violations}
+	}
+
+	return r.inner.Reassign(oldReviewer, candidates)
+}
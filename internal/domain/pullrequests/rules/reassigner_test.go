@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"errors"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// firstReassigner takes the first candidate it is given.
+type firstReassigner struct{}
+
+func (r *firstReassigner) Reassign(
+	_ *teamsDomain.Member,
+	members []teamsDomain.Member,
+) (*teamsDomain.Member, error) {
+	if len(members) == 0 {
+		return nil, domain.ErrNotEnoughMembers
+	}
+
+	return &members[0], nil
+}
+
+//nolint:funlen
+func TestReassigner_Reassign(t *testing.T) {
+	members := []teamsDomain.Member{
+		{ID: "author", IsActive: true},
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+		{ID: "u4", IsActive: true},
+	}
+
+	tests := []struct {
+		name              string
+		rules             []teamsDomain.Rule
+		reviewers         []string
+		oldReviewer       string
+		expectedReviewers []string
+		expectedErr       error
+	}{
+		{
+			name:              "no rules",
+			reviewers:         []string{"u1", "u2"},
+			oldReviewer:       "u1",
+			expectedReviewers: []string{"u3", "u2"},
+		},
+		{
+			name: "conflicting candidate skipped",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindConflict, UserID: "author", OtherUserID: "u3"},
+			},
+			reviewers:         []string{"u1", "u2"},
+			oldReviewer:       "u1",
+			expectedReviewers: []string{"u4", "u2"},
+		},
+		{
+			name: "all candidates conflict",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindConflict, UserID: "author", OtherUserID: "u3"},
+				{Kind: teamsDomain.RuleKindConflict, UserID: "u4", OtherUserID: "author"},
+			},
+			reviewers:   []string{"u1", "u2"},
+			oldReviewer: "u1",
+			expectedErr: domain.ErrRuleViolation,
+		},
+		{
+			name: "mentee candidate needs mentor among remaining reviewers",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u3", OtherUserID: "u2"},
+			},
+			reviewers:         []string{"u1", "u2"},
+			oldReviewer:       "u1",
+			expectedReviewers: []string{"u3", "u2"},
+		},
+		{
+			name: "mentee candidate skipped without mentor",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u3", OtherUserID: "u1"},
+			},
+			reviewers:         []string{"u1", "u2"},
+			oldReviewer:       "u1",
+			expectedReviewers: []string{"u4", "u2"},
+		},
+		{
+			name: "mentor cannot leave mentee alone",
+			rules: []teamsDomain.Rule{
+				{Kind: teamsDomain.RuleKindPairing, UserID: "u2", OtherUserID: "u1"},
+			},
+			reviewers:   []string{"u1", "u2"},
+			oldReviewer: "u1",
+			expectedErr: domain.ErrRuleViolation,
+		},
+		{
+			name:        "no candidates without rules keeps original error",
+			reviewers:   []string{"u1", "u2", "u3", "u4"},
+			oldReviewer: "u1",
+			expectedErr: domain.ErrNotEnoughMembers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := &prsDomain.PullRequest{
+				PullRequestShort: prsDomain.PullRequestShort{
+					AuthorID: "author",
+					Status:   prsDomain.StatusOpen,
+				},
+				AssignedReviewers: tt.reviewers,
+			}
+			reassigner := NewReassigner(&firstReassigner{}, tt.rules, pr)
+
+			_, err := pr.Reassign(&teamsDomain.Member{ID: tt.oldReviewer}, members, reassigner)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+
+				var violationErr *ViolationError
+				assert.Equal(t,
+					errors.Is(tt.expectedErr, domain.ErrRuleViolation),
+					errors.As(err, &violationErr),
+				)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReviewers, pr.AssignedReviewers)
+			assert.Empty(t, Check(tt.rules, pr.AuthorID, pr.AssignedReviewers))
+		})
+	}
+}
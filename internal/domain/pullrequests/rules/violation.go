@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+	"strings"
+)
+
+type Violation struct {
+	Rule   teamsDomain.Rule
+	Reason string
+}
+
+func (v *Violation) String() string {
+	return fmt.Sprintf("%s(%s, %s): %s", v.Rule.Kind, v.Rule.UserID, v.Rule.OtherUserID, v.Reason)
+}
+
+type ViolationError struct {
+	Violations []Violation
+}
+
+func (e *ViolationError) Error() string {
+	reasons := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		reasons = append(reasons, v.String())
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+func (e *ViolationError) Unwrap() error {
+	return domain.ErrRuleViolation
+}
+
+// Check returns every rule broken by the given reviewers of a PR written by authorID.
+func Check(rules []teamsDomain.Rule, authorID string, reviewerIDs []string) []Violation {
+	var violations []Violation
+
+	for _, rule := range rules {
+		switch rule.Kind {
+		case teamsDomain.RuleKindConflict:
+			for _, reviewerID := range reviewerIDs {
+				if rule.Involves(authorID, reviewerID) {
+					violations = append(violations, conflictViolation(rule, reviewerID, authorID))
+				}
+			}
+		case teamsDomain.RuleKindPairing:
+			if slices.Contains(reviewerIDs, rule.UserID) &&
+				!slices.Contains(reviewerIDs, rule.OtherUserID) {
+				violations = append(violations, pairingViolation(rule))
+			}
+		}
+	}
+
+	return violations
+}
+
+func conflictViolation(rule teamsDomain.Rule, reviewerID, authorID string) Violation {
+	return Violation{
+		Rule:   rule,
+		Reason: fmt.Sprintf("%s must not review PRs of %s", reviewerID, authorID),
+	}
+}
+
+func pairingViolation(rule teamsDomain.Rule) Violation {
+	return Violation{
+		Rule:   rule,
+		Reason: fmt.Sprintf("%s must be paired with %s", rule.UserID, rule.OtherUserID),
+	}
+}
+
+func isConflicting(rules []teamsDomain.Rule, authorID, memberID string) (teamsDomain.Rule, bool) {
+	for _, rule := range rules {
+		if rule.Kind == teamsDomain.RuleKindConflict && rule.Involves(authorID, memberID) {
+			return rule, true
+		}
+	}
+
+	return teamsDomain.Rule{}, false
+}
+
+// missingMentor returns the pairing rule of memberID whose mentor is not available.
+func missingMentor(
+	rules []teamsDomain.Rule,
+	memberID string,
+	isAvailable func(userID string) bool,
+) (teamsDomain.Rule, bool) {
+	for _, rule := range rules {
+		if rule.Kind == teamsDomain.RuleKindPairing &&
+			rule.UserID == memberID &&
+			!isAvailable(rule.OtherUserID) {
+			return rule, true
+		}
+	}
+
+	return teamsDomain.Rule{}, false
+}
+
+func isMentee(rules []teamsDomain.Rule, memberID string) bool {
+	return slices.ContainsFunc(rules, func(rule teamsDomain.Rule) bool {
+		return rule.Kind == teamsDomain.RuleKindPairing && rule.UserID == memberID
+	})
+}
+
+func isMentor(rules []teamsDomain.Rule, memberID string, menteeIDs []string) bool {
+	for _, rule := range rules {
+		if rule.Kind == teamsDomain.RuleKindPairing &&
+			rule.OtherUserID == memberID &&
+			slices.Contains(menteeIDs, rule.UserID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func memberIDs(members []teamsDomain.Member) []string {
+	ids := make([]string, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.ID)
+	}
+
+	return ids
+}
+
+func containsMember(members []teamsDomain.Member, memberID string) bool {
+	return slices.ContainsFunc(members, func(m teamsDomain.Member) bool {
+		return m.ID == memberID
+	})
+}
@@ -0,0 +1,286 @@
+package state
+
+import (
+	"fmt"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+	"time"
+)
+
+// Version is bumped whenever the archive layout changes, an archive restores only into its own version.
+const Version = 1
+
+// Archive is the state of the service referring to users, teams and PRs by their public ids only,
+// so it restores into any database. API tokens are left out on purpose.
+type Archive struct {
+	Version      int
+	ExportedAt   time.Time
+	Users        []User
+	Teams        []Team
+	PullRequests []PullRequest
+}
+
+type User struct {
+	ID       string
+	Name     string
+	IsActive bool
+}
+
+type Member struct {
+	UserID    string
+	IsPrimary bool
+}
+
+// Team carries the team policies along with its members.
+type Team struct {
+	Name        string
+	ParentName  string
+	Members     []Member
+	Rules       []teamsDomain.Rule
+	ReviewRules []teamsDomain.ReviewRule
+	// RequiredGroup is nil unless the team reviews PRs of other teams.
+	RequiredGroup *teamsDomain.RequiredGroup
+}
+
+type Reviewer struct {
+	UserID string
+	// GroupTeamName is the required group the reviewer fills a slot of, empty for team slots.
+	GroupTeamName string
+	ApprovedAt    *time.Time
+}
+
+type Decline struct {
+	ReviewerID string
+	Reason     prsDomain.DeclineReason
+	Comment    string
+	DeclinedAt time.Time
+}
+
+type PullRequest struct {
+	ID        string
+	Name      string
+	AuthorID  string
+	TeamName  string
+	Status    prsDomain.StatusPR
+	CreatedAt time.Time
+	MergedAt  *time.Time
+	Reviewers []Reviewer
+	Declines  []Decline
+}
+
+// Validate checks the archive refers only to what it has itself, the database constraints
+// would catch the rest, but without telling which record is wrong.
+func (a *Archive) Validate() error {
+	if a.Version != Version {
+		return fmt.Errorf("%w: %d, expected %d", domain.ErrArchiveVersion, a.Version, Version)
+	}
+
+	users := make(map[string]struct{}, len(a.Users))
+	for _, user := range a.Users {
+		if user.ID == "" || user.Name == "" {
+			return fmt.Errorf("%w: user must have an id and a name", domain.ErrArchiveInvalid)
+		}
+		if _, ok := users[user.ID]; ok {
+			return fmt.Errorf("%w: user %s repeats", domain.ErrArchiveInvalid, user.ID)
+		}
+		users[user.ID] = struct{}{}
+	}
+
+	teams, err := a.validateTeams(users)
+	if err != nil {
+		return err
+	}
+
+	return a.validatePullRequests(users, teams)
+}
+
+func (a *Archive) validateTeams(users map[string]struct{}) (map[string]string, error) {
+	// teams maps every team to its parent
+	teams := make(map[string]string, len(a.Teams))
+	for _, team := range a.Teams {
+		if team.Name == "" {
+			return nil, fmt.Errorf("%w: team must have a name", domain.ErrArchiveInvalid)
+		}
+		if _, ok := teams[team.Name]; ok {
+			return nil, fmt.Errorf("%w: team %s repeats", domain.ErrArchiveInvalid, team.Name)
+		}
+		teams[team.Name] = team.ParentName
+	}
+
+	primaryTeams := make(map[string]string, len(users))
+	for i := range a.Teams {
+		team := &a.Teams[i]
+
+		if err := validateParent(team.Name, teams); err != nil {
+			return nil, err
+		}
+		if err := team.validateMembers(users, primaryTeams); err != nil {
+			return nil, err
+		}
+		if err := team.validatePolicies(teams); err != nil {
+			return nil, err
+		}
+	}
+
+	return teams, nil
+}
+
+// validateParent walks up from the team, the walk ends at a top-level team unless the parents loop.
+func validateParent(teamName string, teams map[string]string) error {
+	seen := map[string]struct{}{teamName: {}}
+	for name := teams[teamName]; name != ""; name = teams[name] {
+		if _, ok := teams[name]; !ok {
+			return fmt.Errorf("%w: team %s has unknown parent %s", domain.ErrArchiveInvalid, teamName, name)
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("%w: team %s is nested in itself", domain.ErrArchiveInvalid, teamName)
+		}
+		seen[name] = struct{}{}
+	}
+
+	return nil
+}
+
+func (t *Team) validateMembers(users map[string]struct{}, primaryTeams map[string]string) error {
+	members := make(map[string]struct{}, len(t.Members))
+	for _, member := range t.Members {
+		if _, ok := users[member.UserID]; !ok {
+			return fmt.Errorf("%w: team %s has unknown member %s", domain.ErrArchiveInvalid, t.Name, member.UserID)
+		}
+		if _, ok := members[member.UserID]; ok {
+			return fmt.Errorf("%w: member %s repeats in team %s", domain.ErrArchiveInvalid, member.UserID, t.Name)
+		}
+		members[member.UserID] = struct{}{}
+
+		if !member.IsPrimary {
+			continue
+		}
+		if primary, ok := primaryTeams[member.UserID]; ok {
+			return fmt.Errorf(
+				"%w: user %s has two primary teams %s and %s",
+				domain.ErrArchiveInvalid, member.UserID, primary, t.Name,
+			)
+		}
+		primaryTeams[member.UserID] = t.Name
+	}
+
+	return nil
+}
+
+func (t *Team) validatePolicies(teams map[string]string) error {
+	team := teamsDomain.Team{Name: t.Name, Members: make([]teamsDomain.Member, 0, len(t.Members))}
+	for _, member := range t.Members {
+		team.Members = append(team.Members, teamsDomain.Member{ID: member.UserID})
+	}
+
+	for _, rule := range t.Rules {
+		if err := team.ValidateRule(&rule); err != nil {
+			return fmt.Errorf("%w: team %s: %w", domain.ErrArchiveInvalid, t.Name, err)
+		}
+	}
+
+	for _, reviewRule := range t.ReviewRules {
+		if err := reviewRule.Validate(); err != nil {
+			return fmt.Errorf("%w: team %s: %w", domain.ErrArchiveInvalid, t.Name, err)
+		}
+		if _, ok := teams[reviewRule.ExtraTeamName]; reviewRule.ExtraTeamName != "" && !ok {
+			return fmt.Errorf(
+				"%w: team %s review rule asks for unknown team %s",
+				domain.ErrArchiveInvalid, t.Name, reviewRule.ExtraTeamName,
+			)
+		}
+	}
+
+	if t.RequiredGroup != nil {
+		if err := t.RequiredGroup.Validate(); err != nil {
+			return fmt.Errorf("%w: team %s: %w", domain.ErrArchiveInvalid, t.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *Archive) validatePullRequests(users map[string]struct{}, teams map[string]string) error {
+	pullRequests := make(map[string]struct{}, len(a.PullRequests))
+	for i := range a.PullRequests {
+		pullRequest := &a.PullRequests[i]
+
+		if pullRequest.ID == "" || pullRequest.Name == "" {
+			return fmt.Errorf("%w: PR must have an id and a name", domain.ErrArchiveInvalid)
+		}
+		if _, ok := pullRequests[pullRequest.ID]; ok {
+			return fmt.Errorf("%w: PR %s repeats", domain.ErrArchiveInvalid, pullRequest.ID)
+		}
+		pullRequests[pullRequest.ID] = struct{}{}
+
+		if err := pullRequest.validate(users, teams); err != nil {
+			return fmt.Errorf("%w: PR %s: %w", domain.ErrArchiveInvalid, pullRequest.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *PullRequest) validate(users map[string]struct{}, teams map[string]string) error {
+	if _, ok := users[p.AuthorID]; !ok {
+		return fmt.Errorf("unknown author %s", p.AuthorID)
+	}
+	if _, ok := teams[p.TeamName]; p.TeamName != "" && !ok {
+		return fmt.Errorf("unknown team %s", p.TeamName)
+	}
+
+	// the PR must hold together the same way an imported one does
+	imported := p.toDomain()
+	if err := imported.ValidateImported(); err != nil {
+		return err
+	}
+
+	for _, reviewer := range p.Reviewers {
+		if _, ok := users[reviewer.UserID]; !ok {
+			return fmt.Errorf("unknown reviewer %s", reviewer.UserID)
+		}
+		if _, ok := teams[reviewer.GroupTeamName]; reviewer.GroupTeamName != "" && !ok {
+			return fmt.Errorf("unknown group %s", reviewer.GroupTeamName)
+		}
+	}
+
+	declined := make(map[string]struct{}, len(p.Declines))
+	for _, decline := range p.Declines {
+		if _, ok := users[decline.ReviewerID]; !ok {
+			return fmt.Errorf("unknown declined reviewer %s", decline.ReviewerID)
+		}
+		if !decline.Reason.IsValid() {
+			return fmt.Errorf("unknown decline reason %s", decline.Reason)
+		}
+		_, ok := declined[decline.ReviewerID]
+		if ok || slices.Contains(imported.AssignedReviewers, decline.ReviewerID) {
+			return fmt.Errorf("reviewer %s declined more than once or is still assigned", decline.ReviewerID)
+		}
+		declined[decline.ReviewerID] = struct{}{}
+	}
+
+	return nil
+}
+
+func (p *PullRequest) toDomain() *prsDomain.PullRequest {
+	createdAt := p.CreatedAt
+	pullRequest := &prsDomain.PullRequest{
+		PullRequestShort: prsDomain.PullRequestShort{
+			ID:       p.ID,
+			Name:     p.Name,
+			AuthorID: p.AuthorID,
+			TeamName: p.TeamName,
+			Status:   p.Status,
+		},
+		AssignedReviewers: make([]string, 0, len(p.Reviewers)),
+		CreatedAt:         &createdAt,
+		MergedAt:          p.MergedAt,
+	}
+	for _, reviewer := range p.Reviewers {
+		pullRequest.AssignedReviewers = append(pullRequest.AssignedReviewers, reviewer.UserID)
+	}
+
+	return pullRequest
+}
@@ -0,0 +1,144 @@
+package state
+
+import (
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validArchive() *Archive {
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(time.Hour)
+
+	return &Archive{
+		Version: Version,
+		Users: []User{
+			{ID: "u1", Name: "Alice", IsActive: true},
+			{ID: "u2", Name: "Bob", IsActive: true},
+			{ID: "sec1", Name: "Sam", IsActive: true},
+		},
+		Teams: []Team{
+			{
+				Name:        "payments",
+				Members:     []Member{{UserID: "u1", IsPrimary: true}, {UserID: "u2", IsPrimary: true}},
+				Rules:       []teamsDomain.Rule{{Kind: teamsDomain.RuleKindConflict, UserID: "u1", OtherUserID: "u2"}},
+				ReviewRules: []teamsDomain.ReviewRule{{Label: "auth", ExtraTeamName: "security"}},
+			},
+			{
+				Name:          "security",
+				ParentName:    "payments",
+				Members:       []Member{{UserID: "sec1", IsPrimary: true}, {UserID: "u2"}},
+				RequiredGroup: &teamsDomain.RequiredGroup{TeamName: "security", Labels: []string{"auth"}},
+			},
+		},
+		PullRequests: []PullRequest{
+			{
+				ID:        "pr-1",
+				Name:      "Add login",
+				AuthorID:  "u1",
+				TeamName:  "payments",
+				Status:    prsDomain.StatusMerged,
+				CreatedAt: createdAt,
+				MergedAt:  &mergedAt,
+				Reviewers: []Reviewer{{UserID: "sec1", GroupTeamName: "security", ApprovedAt: &mergedAt}},
+				Declines: []Decline{
+					{ReviewerID: "u2", Reason: prsDomain.DeclineReasonUnavailable, DeclinedAt: createdAt},
+				},
+			},
+		},
+	}
+}
+
+func TestArchive_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(a *Archive)
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(*Archive) {},
+		},
+		{
+			name:    "other version",
+			modify:  func(a *Archive) { a.Version = Version + 1 },
+			wantErr: domain.ErrArchiveVersion,
+		},
+		{
+			name:    "repeated user",
+			modify:  func(a *Archive) { a.Users = append(a.Users, User{ID: "u1", Name: "Alice"}) },
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name:    "unknown parent",
+			modify:  func(a *Archive) { a.Teams[1].ParentName = "engineering" },
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name: "nested in itself",
+			modify: func(a *Archive) {
+				a.Teams[0].ParentName = "security"
+			},
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name:    "unknown member",
+			modify:  func(a *Archive) { a.Teams[0].Members = append(a.Teams[0].Members, Member{UserID: "u9"}) },
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name:    "two primary teams",
+			modify:  func(a *Archive) { a.Teams[1].Members[1].IsPrimary = true },
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name: "rule outside of team",
+			modify: func(a *Archive) {
+				a.Teams[1].Rules = []teamsDomain.Rule{
+					{Kind: teamsDomain.RuleKindPairing, UserID: "sec1", OtherUserID: "u1"},
+				}
+			},
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name:    "review rule for unknown team",
+			modify:  func(a *Archive) { a.Teams[0].ReviewRules[0].ExtraTeamName = "dba" },
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name:    "unknown reviewer",
+			modify:  func(a *Archive) { a.PullRequests[0].Reviewers[0].UserID = "u9" },
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name:    "merged without merged_at",
+			modify:  func(a *Archive) { a.PullRequests[0].MergedAt = nil },
+			wantErr: domain.ErrArchiveInvalid,
+		},
+		{
+			name: "declined reviewer still assigned",
+			modify: func(a *Archive) {
+				a.PullRequests[0].Reviewers = append(a.PullRequests[0].Reviewers, Reviewer{UserID: "u2"})
+			},
+			wantErr: domain.ErrArchiveInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive := validArchive()
+			tt.modify(archive)
+
+			err := archive.Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package teams
+
+// MemberChange holds a member as stored and as sent in the roster.
+type MemberChange struct {
+	Before Member
+	After  Member
+}
+
+// MembersDiff describes how a team roster differs from the current members.
+// A member renamed and deactivated at once shows up in both Renamed and ActivityChanged.
+type MembersDiff struct {
+	Added           []Member
+	Removed         []Member
+	Renamed         []MemberChange
+	ActivityChanged []MemberChange
+}
+
+func (d *MembersDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Renamed) == 0 && len(d.ActivityChanged) == 0
+}
+
+// Updated returns the roster state of every member kept in the team with a new name or activity.
+func (d *MembersDiff) Updated() []Member {
+	updated := make([]Member, 0, len(d.Renamed)+len(d.ActivityChanged))
+	seen := make(map[string]struct{}, cap(updated))
+
+	for _, changes := range [][]MemberChange{d.Renamed, d.ActivityChanged} {
+		for _, change := range changes {
+			if _, ok := seen[change.After.ID]; ok {
+				continue
+			}
+			seen[change.After.ID] = struct{}{}
+			updated = append(updated, change.After)
+		}
+	}
+
+	return updated
+}
+
+// Diff compares the team with the full roster, results follow the roster order and then the team order.
+func (t *Team) Diff(roster []Member) MembersDiff {
+	current := make(map[string]Member, len(t.Members))
+	for _, member := range t.Members {
+		current[member.ID] = member
+	}
+
+	var diff MembersDiff
+	inRoster := make(map[string]struct{}, len(roster))
+	for _, member := range roster {
+		inRoster[member.ID] = struct{}{}
+
+		old, ok := current[member.ID]
+		if !ok {
+			diff.Added = append(diff.Added, member)
+			continue
+		}
+
+		if old.Name != member.Name {
+			diff.Renamed = append(diff.Renamed, MemberChange{Before: old, After: member})
+		}
+		if old.IsActive != member.IsActive {
+			diff.ActivityChanged = append(diff.ActivityChanged, MemberChange{Before: old, After: member})
+		}
+	}
+
+	for _, member := range t.Members {
+		if _, ok := inRoster[member.ID]; !ok {
+			diff.Removed = append(diff.Removed, member)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,99 @@
+package teams
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTeam_Diff(t *testing.T) {
+	team := &Team{
+		Name: "Test Team",
+		Members: []Member{
+			{ID: "1", Name: "Alice", IsActive: true},
+			{ID: "2", Name: "Bob", IsActive: true},
+			{ID: "3", Name: "Charlie", IsActive: false},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		roster   []Member
+		wantDiff MembersDiff
+		wantUpd  []Member
+	}{
+		{
+			name:     "same roster",
+			roster:   team.Members,
+			wantDiff: MembersDiff{},
+			wantUpd:  []Member{},
+		},
+		{
+			name: "added and removed",
+			roster: []Member{
+				{ID: "1", Name: "Alice", IsActive: true},
+				{ID: "4", Name: "Dave", IsActive: true},
+			},
+			wantDiff: MembersDiff{
+				Added: []Member{{ID: "4", Name: "Dave", IsActive: true}},
+				Removed: []Member{
+					{ID: "2", Name: "Bob", IsActive: true},
+					{ID: "3", Name: "Charlie", IsActive: false},
+				},
+			},
+			wantUpd: []Member{},
+		},
+		{
+			name: "renamed and activity changed",
+			roster: []Member{
+				{ID: "1", Name: "Alice Smith", IsActive: false},
+				{ID: "2", Name: "Bob", IsActive: true},
+				{ID: "3", Name: "Charlie", IsActive: true},
+			},
+			wantDiff: MembersDiff{
+				Renamed: []MemberChange{
+					{
+						Before: Member{ID: "1", Name: "Alice", IsActive: true},
+						After:  Member{ID: "1", Name: "Alice Smith", IsActive: false},
+					},
+				},
+				ActivityChanged: []MemberChange{
+					{
+						Before: Member{ID: "1", Name: "Alice", IsActive: true},
+						After:  Member{ID: "1", Name: "Alice Smith", IsActive: false},
+					},
+					{
+						Before: Member{ID: "3", Name: "Charlie", IsActive: false},
+						After:  Member{ID: "3", Name: "Charlie", IsActive: true},
+					},
+				},
+			},
+			wantUpd: []Member{
+				{ID: "1", Name: "Alice Smith", IsActive: false},
+				{ID: "3", Name: "Charlie", IsActive: true},
+			},
+		},
+		{
+			name:   "empty roster",
+			roster: []Member{},
+			wantDiff: MembersDiff{
+				Removed: team.Members,
+			},
+			wantUpd: []Member{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := team.Diff(tt.roster)
+			if !reflect.DeepEqual(diff, tt.wantDiff) {
+				t.Errorf("Diff() = %+v, want %+v", diff, tt.wantDiff)
+			}
+			if diff.IsEmpty() != reflect.DeepEqual(tt.wantDiff, MembersDiff{}) {
+				t.Errorf("IsEmpty() = %v", diff.IsEmpty())
+			}
+			if updated := diff.Updated(); !reflect.DeepEqual(updated, tt.wantUpd) {
+				t.Errorf("Updated() = %+v, want %+v", updated, tt.wantUpd)
+			}
+		})
+	}
+}
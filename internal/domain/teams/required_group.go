@@ -0,0 +1,44 @@
+package teams
+
+import (
+	"reviewer-assigner/internal/domain"
+	"slices"
+	"strings"
+)
+
+// RequiredGroup makes the team review every PR labelled with one of Labels or touching a path
+// under one of PathPrefixes, whatever team the PR comes from.
+type RequiredGroup struct {
+	TeamName     string
+	Labels       []string
+	PathPrefixes []string
+}
+
+func (g *RequiredGroup) Validate() error {
+	if len(g.Labels) == 0 && len(g.PathPrefixes) == 0 {
+		return domain.ErrRequiredGroupInvalid
+	}
+	if slices.Contains(g.Labels, "") || slices.Contains(g.PathPrefixes, "") {
+		return domain.ErrRequiredGroupInvalid
+	}
+
+	return nil
+}
+
+func (g *RequiredGroup) Matches(labels, paths []string) bool {
+	for _, label := range labels {
+		if slices.Contains(g.Labels, label) {
+			return true
+		}
+	}
+
+	for _, path := range paths {
+		for _, prefix := range g.PathPrefixes {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
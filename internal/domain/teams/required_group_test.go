@@ -0,0 +1,63 @@
+package teams
+
+import (
+	"errors"
+	"reviewer-assigner/internal/domain"
+	"testing"
+)
+
+func TestRequiredGroup_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		group   RequiredGroup
+		wantErr error
+	}{
+		{
+			name:    "labels",
+			group:   RequiredGroup{TeamName: "security", Labels: []string{"security"}},
+			wantErr: nil,
+		},
+		{
+			name:    "path prefixes",
+			group:   RequiredGroup{TeamName: "dba", PathPrefixes: []string{"migrations/"}},
+			wantErr: nil,
+		},
+		{
+			name:    "matches nothing",
+			group:   RequiredGroup{TeamName: "security"},
+			wantErr: domain.ErrRequiredGroupInvalid,
+		},
+		{
+			name:    "empty prefix",
+			group:   RequiredGroup{TeamName: "dba", PathPrefixes: []string{""}},
+			wantErr: domain.ErrRequiredGroupInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.group.Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequiredGroup_Matches(t *testing.T) {
+	group := RequiredGroup{
+		TeamName:     "security",
+		Labels:       []string{"security"},
+		PathPrefixes: []string{"internal/auth/"},
+	}
+
+	if !group.Matches([]string{"backend", "security"}, nil) {
+		t.Errorf("group must match a labelled PR")
+	}
+	if !group.Matches(nil, []string{"README.md", "internal/auth/jwt.go"}) {
+		t.Errorf("group must match a PR touching its paths")
+	}
+	if group.Matches([]string{"backend"}, []string{"internal/authz.go"}) {
+		t.Errorf("group must not match other labels and paths")
+	}
+}
@@ -0,0 +1,39 @@
+package teams
+
+import (
+	"reviewer-assigner/internal/domain"
+	"slices"
+)
+
+// MaxReviewersCount bounds the reviewers count a review rule can ask for.
+const MaxReviewersCount = 10
+
+// ReviewRule sizes the review of the team's PRs. It matches PRs changing at least MinLinesChanged
+// lines and labelled with Label, zero and empty match any PR.
+type ReviewRule struct {
+	MinLinesChanged int
+	Label           string
+	// ReviewersCount is how many reviewers the PR needs, zero leaves the count to other rules.
+	ReviewersCount int
+	// ExtraTeamName is a team the PR needs one more reviewer from, empty for none.
+	ExtraTeamName string
+}
+
+func (r *ReviewRule) Validate() error {
+	if r.MinLinesChanged < 0 || r.ReviewersCount < 0 || r.ReviewersCount > MaxReviewersCount {
+		return domain.ErrReviewRuleInvalid
+	}
+	if r.ReviewersCount == 0 && r.ExtraTeamName == "" {
+		return domain.ErrReviewRuleInvalid
+	}
+
+	return nil
+}
+
+func (r *ReviewRule) Matches(linesChanged int, labels []string) bool {
+	if linesChanged < r.MinLinesChanged {
+		return false
+	}
+
+	return r.Label == "" || slices.Contains(labels, r.Label)
+}
@@ -0,0 +1,74 @@
+package teams
+
+import (
+	"errors"
+	"reviewer-assigner/internal/domain"
+	"testing"
+)
+
+func TestReviewRule_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    ReviewRule
+		wantErr error
+	}{
+		{
+			name:    "count by size",
+			rule:    ReviewRule{MinLinesChanged: 500, ReviewersCount: 3},
+			wantErr: nil,
+		},
+		{
+			name:    "extra team by label",
+			rule:    ReviewRule{Label: "security", ExtraTeamName: "security"},
+			wantErr: nil,
+		},
+		{
+			name:    "team default",
+			rule:    ReviewRule{ReviewersCount: 1},
+			wantErr: nil,
+		},
+		{
+			name:    "asks for nothing",
+			rule:    ReviewRule{MinLinesChanged: 500},
+			wantErr: domain.ErrReviewRuleInvalid,
+		},
+		{
+			name:    "too many reviewers",
+			rule:    ReviewRule{ReviewersCount: MaxReviewersCount + 1},
+			wantErr: domain.ErrReviewRuleInvalid,
+		},
+		{
+			name:    "negative lines",
+			rule:    ReviewRule{MinLinesChanged: -1, ReviewersCount: 3},
+			wantErr: domain.ErrReviewRuleInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReviewRule_Matches(t *testing.T) {
+	rule := ReviewRule{MinLinesChanged: 500, Label: "security", ReviewersCount: 3}
+
+	if !rule.Matches(500, []string{"backend", "security"}) {
+		t.Errorf("rule must match a large labelled PR")
+	}
+	if rule.Matches(499, []string{"security"}) {
+		t.Errorf("rule must not match a smaller PR")
+	}
+	if rule.Matches(1000, []string{"backend"}) {
+		t.Errorf("rule must not match a PR without the label")
+	}
+
+	anySize := ReviewRule{ReviewersCount: 1}
+	if !anySize.Matches(0, nil) {
+		t.Errorf("rule without conditions must match any PR")
+	}
+}
@@ -0,0 +1,52 @@
+package teams
+
+import "reviewer-assigner/internal/domain"
+
+type RuleKind string
+
+const (
+	// RuleKindConflict forbids UserID and OtherUserID to review each other's PRs.
+	RuleKindConflict RuleKind = "CONFLICT_OF_INTEREST"
+	// RuleKindPairing requires mentor OtherUserID to review every PR reviewed by UserID.
+	RuleKindPairing RuleKind = "PAIRING"
+)
+
+type Rule struct {
+	Kind        RuleKind
+	UserID      string
+	OtherUserID string
+}
+
+func (r *Rule) Validate() error {
+	if r.Kind != RuleKindConflict && r.Kind != RuleKindPairing {
+		return domain.ErrRuleKindUnknown
+	}
+	if r.UserID == "" || r.OtherUserID == "" || r.UserID == r.OtherUserID {
+		return domain.ErrRuleInvalid
+	}
+
+	return nil
+}
+
+// Involves reports whether both users are bound by the rule, in either direction for conflicts.
+func (r *Rule) Involves(userID, otherUserID string) bool {
+	if r.UserID == userID && r.OtherUserID == otherUserID {
+		return true
+	}
+
+	return r.Kind == RuleKindConflict && r.UserID == otherUserID && r.OtherUserID == userID
+}
+
+func (t *Team) ValidateRule(rule *Rule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	for _, userID := range []string{rule.UserID, rule.OtherUserID} {
+		if !t.HasMember(userID) {
+			return domain.ErrRuleMemberNotInTeam
+		}
+	}
+
+	return nil
+}
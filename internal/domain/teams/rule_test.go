@@ -0,0 +1,75 @@
+package teams
+
+import (
+	"errors"
+	"reviewer-assigner/internal/domain"
+	"testing"
+)
+
+func TestTeam_ValidateRule(t *testing.T) {
+	team := &Team{
+		Name: "Test Team",
+		Members: []Member{
+			{ID: "1", Name: "Alice", IsActive: true},
+			{ID: "2", Name: "Bob", IsActive: true},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr error
+	}{
+		{
+			name:    "valid conflict",
+			rule:    Rule{Kind: RuleKindConflict, UserID: "1", OtherUserID: "2"},
+			wantErr: nil,
+		},
+		{
+			name:    "valid pairing",
+			rule:    Rule{Kind: RuleKindPairing, UserID: "2", OtherUserID: "1"},
+			wantErr: nil,
+		},
+		{
+			name:    "unknown kind",
+			rule:    Rule{Kind: "UNKNOWN", UserID: "1", OtherUserID: "2"},
+			wantErr: domain.ErrRuleKindUnknown,
+		},
+		{
+			name:    "same user",
+			rule:    Rule{Kind: RuleKindConflict, UserID: "1", OtherUserID: "1"},
+			wantErr: domain.ErrRuleInvalid,
+		},
+		{
+			name:    "empty user",
+			rule:    Rule{Kind: RuleKindPairing, UserID: "", OtherUserID: "1"},
+			wantErr: domain.ErrRuleInvalid,
+		},
+		{
+			name:    "user outside of team",
+			rule:    Rule{Kind: RuleKindPairing, UserID: "1", OtherUserID: "3"},
+			wantErr: domain.ErrRuleMemberNotInTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := team.ValidateRule(&tt.rule)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRule_Involves(t *testing.T) {
+	conflict := Rule{Kind: RuleKindConflict, UserID: "1", OtherUserID: "2"}
+	pairing := Rule{Kind: RuleKindPairing, UserID: "1", OtherUserID: "2"}
+
+	if !conflict.Involves("1", "2") || !conflict.Involves("2", "1") {
+		t.Errorf("conflict rule must involve both directions")
+	}
+	if !pairing.Involves("1", "2") || pairing.Involves("2", "1") {
+		t.Errorf("pairing rule must involve only mentee -> mentor direction")
+	}
+}
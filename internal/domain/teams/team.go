@@ -9,13 +9,27 @@ type Member struct {
 	ID       string
 	Name     string
 	IsActive bool
+	// IsPrimary is set when the team the member was read from is the member's primary team.
+	IsPrimary bool
 }
 
 type Team struct {
-	Name    string
-	Members []Member
+	Name string
+	// ParentName is the team this one is nested under, empty for top-level teams.
+	ParentName string
+	Members    []Member
 }
 
+// OpenReviews decides what happens to the open reviews of a member who leaves a team.
+type OpenReviews string
+
+const (
+	// OpenReviewsKeep leaves the member assigned to the reviews.
+	OpenReviewsKeep OpenReviews = "KEEP"
+	// OpenReviewsReassign hands the reviews over to other members of the team being left.
+	OpenReviewsReassign OpenReviews = "REASSIGN"
+)
+
 func (m *Member) Equal(o *Member) bool {
 	if m.IsActive != o.IsActive {
 		return false
@@ -30,6 +44,16 @@ func (m *Member) Equal(o *Member) bool {
 	return true
 }
 
+func (t *Team) HasMember(userID string) bool {
+	return slices.ContainsFunc(t.Members, func(m Member) bool {
+		return m.ID == userID
+	})
+}
+
+func (t *Team) IsEmpty() bool {
+	return len(t.Members) == 0
+}
+
 func (t *Team) UpdateMembers(updatedMembers []Member) error {
 	if !hasSameMemberIDs(t.Members, updatedMembers) {
 		return domain.ErrTeamMembersMismatch
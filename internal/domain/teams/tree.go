@@ -0,0 +1,55 @@
+package teams
+
+// TeamRef is a team placed in the hierarchy, ParentName is empty for top-level teams.
+type TeamRef struct {
+	Name         string
+	ParentName   string
+	MembersCount int
+}
+
+// TreeNode is a team with its subteams.
+type TreeNode struct {
+	Name         string
+	MembersCount int
+	Children     []TreeNode
+}
+
+// BuildTree nests the teams under their parents keeping the given order among siblings.
+// Teams whose parent is not listed become roots, so any subtree can be built on its own.
+func BuildTree(refs []TeamRef) []TreeNode {
+	listed := make(map[string]bool, len(refs))
+	children := make(map[string][]TeamRef, len(refs))
+	for _, ref := range refs {
+		listed[ref.Name] = true
+		children[ref.ParentName] = append(children[ref.ParentName], ref)
+	}
+
+	var build func(ref TeamRef, seen map[string]bool) TreeNode
+	build = func(ref TeamRef, seen map[string]bool) TreeNode {
+		seen[ref.Name] = true
+
+		node := TreeNode{
+			Name:         ref.Name,
+			MembersCount: ref.MembersCount,
+			Children:     []TreeNode{},
+		}
+		for _, child := range children[ref.Name] {
+			// storage rejects cycles, this only guards against looping forever on broken data
+			if !seen[child.Name] {
+				node.Children = append(node.Children, build(child, seen))
+			}
+		}
+
+		return node
+	}
+
+	roots := make([]TreeNode, 0)
+	seen := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		if ref.ParentName == "" || !listed[ref.ParentName] {
+			roots = append(roots, build(ref, seen))
+		}
+	}
+
+	return roots
+}
@@ -0,0 +1,70 @@
+package teams
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildTree(t *testing.T) {
+	tests := []struct {
+		name string
+		refs []TeamRef
+		want []TreeNode
+	}{
+		{
+			name: "empty",
+			refs: nil,
+			want: []TreeNode{},
+		},
+		{
+			name: "nested",
+			refs: []TeamRef{
+				{Name: "org"},
+				{Name: "infra", MembersCount: 2},
+				{Name: "payments", ParentName: "org", MembersCount: 1},
+				{Name: "checkout", ParentName: "payments", MembersCount: 3},
+				{Name: "billing", ParentName: "payments"},
+			},
+			want: []TreeNode{
+				{
+					Name: "org",
+					Children: []TreeNode{
+						{
+							Name:         "payments",
+							MembersCount: 1,
+							Children: []TreeNode{
+								{Name: "checkout", MembersCount: 3, Children: []TreeNode{}},
+								{Name: "billing", Children: []TreeNode{}},
+							},
+						},
+					},
+				},
+				{Name: "infra", MembersCount: 2, Children: []TreeNode{}},
+			},
+		},
+		{
+			name: "subtree",
+			refs: []TeamRef{
+				{Name: "payments", ParentName: "org"},
+				{Name: "checkout", ParentName: "payments"},
+			},
+			want: []TreeNode{
+				{
+					Name: "payments",
+					Children: []TreeNode{
+						{Name: "checkout", Children: []TreeNode{}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildTree(tt.refs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BuildTree() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"reviewer-assigner/internal/domain"
+	"reviewer-assigner/internal/domain/access"
+	"slices"
+	"time"
+)
+
+const (
+	prefix      = "ra_"
+	secretBytes = 32
+	idBytes     = 8
+)
+
+// Token is an API token, only its hash is stored, the plain value is shown once on issue.
+type Token struct {
+	ID        string
+	Actor     string
+	Role      access.Role
+	Hash      string
+	Scopes    []access.Scope
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+func (t *Token) HasScope(scope access.Scope) bool {
+	return slices.Contains(t.Scopes, scope)
+}
+
+func (t *Token) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// New generates a token for actor and returns it together with its plain value.
+func New(actor string, role access.Role, scopes []access.Scope) (*Token, string, error) {
+	if actor == "" || !role.IsValid() || len(scopes) == 0 {
+		return nil, "", domain.ErrTokenInvalid
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(access.Scopes(), scope) {
+			return nil, "", domain.ErrTokenInvalid
+		}
+	}
+
+	plain := prefix + randomString(secretBytes)
+
+	return &Token{
+		ID:     randomString(idBytes),
+		Actor:  actor,
+		Role:   role,
+		Hash:   Hash(plain),
+		Scopes: slices.Compact(slices.Sorted(slices.Values(scopes))),
+	}, plain, nil
+}
+
+// Hash is a sha256 of the plain token, tokens are random enough to not need a salt.
+func Hash(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b) // crypto/rand.Read never returns an error
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,92 @@
+package tokens
+
+import (
+	"reviewer-assigner/internal/domain"
+	"reviewer-assigner/internal/domain/access"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name        string
+		actor       string
+		role        access.Role
+		scopes      []access.Scope
+		expected    []access.Scope
+		expectedErr error
+	}{
+		{
+			name:     "sorted_and_deduplicated",
+			actor:    "ci",
+			role:     access.RoleMember,
+			scopes:   []access.Scope{access.ScopeStatsRead, access.ScopePRsWrite, access.ScopeStatsRead},
+			expected: []access.Scope{access.ScopePRsWrite, access.ScopeStatsRead},
+		},
+		{
+			name:        "unknown_role",
+			actor:       "ci",
+			role:        "owner",
+			scopes:      []access.Scope{access.ScopeStatsRead},
+			expectedErr: domain.ErrTokenInvalid,
+		},
+		{
+			name:        "empty_actor",
+			role:        access.RoleMember,
+			scopes:      []access.Scope{access.ScopeStatsRead},
+			expectedErr: domain.ErrTokenInvalid,
+		},
+		{
+			name:        "no_scopes",
+			actor:       "ci",
+			role:        access.RoleMember,
+			expectedErr: domain.ErrTokenInvalid,
+		},
+		{
+			name:        "unknown_scope",
+			actor:       "ci",
+			role:        access.RoleMember,
+			scopes:      []access.Scope{"prs:delete"},
+			expectedErr: domain.ErrTokenInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, plain, err := New(tc.actor, tc.role, tc.scopes)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.True(t, strings.HasPrefix(plain, prefix))
+			assert.Equal(t, Hash(plain), token.Hash)
+			assert.NotEqual(t, plain, token.Hash)
+			assert.NotEmpty(t, token.ID)
+			assert.Equal(t, tc.actor, token.Actor)
+			assert.Equal(t, tc.role, token.Role)
+			assert.Equal(t, tc.expected, token.Scopes)
+		})
+	}
+}
+
+func TestNew_Unique(t *testing.T) {
+	first, firstPlain, err := New("ci", access.RoleMember, []access.Scope{access.ScopeStatsRead})
+	require.NoError(t, err)
+	second, secondPlain, err := New("ci", access.RoleMember, []access.Scope{access.ScopeStatsRead})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.NotEqual(t, firstPlain, secondPlain)
+}
+
+func TestToken_HasScope(t *testing.T) {
+	token := Token{Scopes: []access.Scope{access.ScopeTeamsRead}}
+
+	assert.True(t, token.HasScope(access.ScopeTeamsRead))
+	assert.False(t, token.HasScope(access.ScopeTeamsWrite))
+}
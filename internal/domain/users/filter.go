@@ -0,0 +1,13 @@
+package users
+
+// Filter narrows the user directory, zero values match every user.
+type Filter struct {
+	// TeamName matches members of the team, primary or not.
+	TeamName string
+	IsActive *bool
+	// NamePrefix matches the beginning of the user name, case-insensitively.
+	NamePrefix string
+	// Limit caps the page, zero means no limit.
+	Limit  int
+	Offset int
+}
@@ -1,11 +1,23 @@
 package users
 
-import teamsDomain "reviewer-assigner/internal/domain/teams"
+import (
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"slices"
+)
 
 type User struct {
 	teamsDomain.Member
 
+	// TeamName is the primary team, pull requests of the user target it unless told otherwise.
 	TeamName string
+	// TeamNames lists every team of the user, the primary one first.
+	TeamNames []string
+	// OpenReviews counts the open pull requests the user is assigned to.
+	OpenReviews int
+}
+
+func (u *User) InTeam(teamName string) bool {
+	return slices.Contains(u.TeamNames, teamName)
 }
 
 func (u *User) SetIsActive(isActive bool) error {
@@ -0,0 +1,77 @@
+package assignments
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/service/assignments"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = validator.New()
+
+type AssignmentHandler struct {
+	assignmentService *assignments.AssignmentService
+	log               *slog.Logger
+}
+
+func NewAssignmentHandler(
+	log *slog.Logger,
+	assignmentService *assignments.AssignmentService,
+) *AssignmentHandler {
+	return &AssignmentHandler{
+		log:               log,
+		assignmentService: assignmentService,
+	}
+}
+
+func (h *AssignmentHandler) Simulate(c *gin.Context) {
+	const op = "handlers.assignments.Simulate"
+	log := h.log.With(slog.String("op", op))
+
+	var req SimulateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(c.Request.Context(), "failed to decode json body", logger.ErrAttr(err))
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
+
+	if err := validate.Struct(req); err != nil {
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
+
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return
+	}
+
+	params := simulateRequestToParams(&req)
+
+	simulation, err := h.assignmentService.Simulate(c.Request.Context(), params)
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrAssignmentUnknownStrategy) {
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToSimulateResponse(params, simulation))
+}
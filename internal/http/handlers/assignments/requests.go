@@ -0,0 +1,42 @@
+package assignments
+
+import (
+	"reviewer-assigner/internal/domain/pullrequests/pickers"
+	"reviewer-assigner/internal/service/assignments"
+)
+
+const (
+	defaultDays           = 30
+	defaultReviewersCount = 2
+)
+
+type SimulateRequest struct {
+	TeamName string                `json:"team_name" validate:"required"`
+	Strategy string                `json:"strategy"  validate:"required,oneof=random round_robin least_loaded"`
+	Days     int                   `json:"days"      validate:"omitempty,min=1,max=365"`
+	Params   SimulateParamsRequest `json:"params"`
+}
+
+type SimulateParamsRequest struct {
+	ReviewersCount int    `json:"reviewers_count" validate:"omitempty,min=1,max=10"`
+	Seed           uint64 `json:"seed"`
+}
+
+func simulateRequestToParams(req *SimulateRequest) *assignments.SimulateParams {
+	params := &assignments.SimulateParams{
+		TeamName:       req.TeamName,
+		Strategy:       pickers.Strategy(req.Strategy),
+		Days:           req.Days,
+		ReviewersCount: req.Params.ReviewersCount,
+		Seed:           req.Params.Seed,
+	}
+
+	if params.Days == 0 {
+		params.Days = defaultDays
+	}
+	if params.ReviewersCount == 0 {
+		params.ReviewersCount = defaultReviewersCount
+	}
+
+	return params
+}
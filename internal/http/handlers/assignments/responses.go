@@ -0,0 +1,62 @@
+package assignments
+
+import (
+	assignmentsDomain "reviewer-assigner/internal/domain/assignments"
+	"reviewer-assigner/internal/service/assignments"
+)
+
+type SimulateResponse struct {
+	TeamName     string                 `json:"team_name"`
+	Strategy     string                 `json:"strategy"`
+	Days         int                    `json:"days"`
+	PullRequests int                    `json:"pull_requests"`
+	Reviewers    []ReviewerLoadResponse `json:"reviewers"`
+	Simulated    FairnessResponse       `json:"simulated"`
+	Actual       FairnessResponse       `json:"actual"`
+}
+
+type ReviewerLoadResponse struct {
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Simulated int    `json:"simulated"`
+	Actual    int    `json:"actual"`
+}
+
+type FairnessResponse struct {
+	Gini    float64 `json:"gini"`
+	MaxLoad int     `json:"max_load"`
+	MinLoad int     `json:"min_load"`
+}
+
+func domainToSimulateResponse(
+	params *assignments.SimulateParams,
+	simulation *assignmentsDomain.Simulation,
+) *SimulateResponse {
+	reviewers := make([]ReviewerLoadResponse, 0, len(simulation.Reviewers))
+	for _, load := range simulation.Reviewers {
+		reviewers = append(reviewers, ReviewerLoadResponse{
+			UserID:    load.UserID,
+			Username:  load.Username,
+			Simulated: load.Simulated,
+			Actual:    load.Actual,
+		})
+	}
+
+	return &SimulateResponse{
+		TeamName:     params.TeamName,
+		Strategy:     string(params.Strategy),
+		Days:         params.Days,
+		PullRequests: simulation.PullRequests,
+		Reviewers:    reviewers,
+		Simulated:    domainToFairnessResponse(&simulation.Simulated),
+		Actual:       domainToFairnessResponse(&simulation.Actual),
+	}
+}
+
+func domainToFairnessResponse(fairness *assignmentsDomain.Fairness) FairnessResponse {
+	return FairnessResponse{
+		Gini:    fairness.Gini,
+		MaxLoad: fairness.MaxLoad,
+		MinLoad: fairness.MinLoad,
+	}
+}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
+	"reviewer-assigner/internal/logger"
 )
 
 type ErrCode string
@@ -10,16 +12,34 @@ const (
 	ErrCodeInvalidJSON       ErrCode = "INVALID_JSON"
 	ErrCodeInvalidQueryParam ErrCode = "INVALID_QUERY_PARAM"
 	ErrCodeInvalidBody       ErrCode = "INVALID_BODY"
+	ErrCodeBodyTooLarge      ErrCode = "BODY_TOO_LARGE"
 
-	ErrCodeTeamExists ErrCode = "TEAM_EXISTS"
+	ErrCodeTeamExists       ErrCode = "TEAM_EXISTS"
+	ErrCodeTeamNotEmpty     ErrCode = "TEAM_NOT_EMPTY"
+	ErrCodeTeamHasSubteams  ErrCode = "TEAM_HAS_SUBTEAMS"
+	ErrCodeTeamCycle        ErrCode = "TEAM_CYCLE"
+	ErrCodeTeamMemberExists ErrCode = "MEMBER_EXISTS"
+
+	ErrCodeTeamRuleInvalid   ErrCode = "RULE_INVALID"
+	ErrCodeTeamRuleExists    ErrCode = "RULE_EXISTS"
+	ErrCodeTeamRuleViolation ErrCode = "RULE_VIOLATION"
 
 	ErrCodePullRequestExists      ErrCode = "PR_EXISTS"
 	ErrCodePullRequestMerged      ErrCode = "PR_MERGED"
 	ErrCodePullRequestNotAssigned ErrCode = "NOT_ASSIGNED"
 	ErrCodePullRequestNoCandidate ErrCode = "NO_CANDIDATE"
+	ErrCodeReviewerInvalid        ErrCode = "REVIEWER_INVALID"
+	ErrCodeReviewerInGroupSlot    ErrCode = "GROUP_SLOT"
+	ErrCodeApprovalRequired       ErrCode = "APPROVAL_REQUIRED"
 
 	ErrCodeResourceNotFound ErrCode = "NOT_FOUND"
 
+	ErrCodeUnauthorized      ErrCode = "UNAUTHORIZED"
+	ErrCodeInsufficientScope ErrCode = "INSUFFICIENT_SCOPE"
+	ErrCodeForbidden         ErrCode = "FORBIDDEN"
+
+	ErrCodeRateLimited ErrCode = "RATE_LIMITED"
+
 	ErrCodeUnknown ErrCode = "UNKNOWN"
 )
 
@@ -27,21 +47,40 @@ var errCodeMessages = map[ErrCode]string{
 	ErrCodeInvalidJSON:       "invalid JSON format",
 	ErrCodeInvalidQueryParam: "invalid query parameter",
 	ErrCodeInvalidBody:       "invalid request body",
+	ErrCodeBodyTooLarge:      "request body exceeds %d bytes",
 
-	ErrCodeTeamExists: "%s already exists",
+	ErrCodeTeamExists:       "%s already exists",
+	ErrCodeTeamNotEmpty:     "team %s still has members",
+	ErrCodeTeamHasSubteams:  "team %s still has subteams",
+	ErrCodeTeamCycle:        "team %s cannot be nested under itself or its subteam",
+	ErrCodeTeamMemberExists: "user %s is already a member of a team",
+
+	ErrCodeTeamRuleInvalid:   "invalid team rule: %s",
+	ErrCodeTeamRuleExists:    "team rule already exists",
+	ErrCodeTeamRuleViolation: "team rules violated: %s",
 
 	ErrCodePullRequestExists:      "PR %s already exists",
 	ErrCodePullRequestMerged:      "cannot reassign on merged PR",
 	ErrCodePullRequestNotAssigned: "reviewer is not assigned to this PR",
 	ErrCodePullRequestNoCandidate: "no active replacement candidate in team",
+	ErrCodeReviewerInvalid:        "user %s cannot review this PR",
+	ErrCodeReviewerInGroupSlot:    "user %s fills a required group slot, reassign instead",
+	ErrCodeApprovalRequired:       "required reviewer groups have not approved this PR",
 
 	ErrCodeResourceNotFound: "resource not found",
 
+	ErrCodeUnauthorized:      "missing, invalid or revoked credentials",
+	ErrCodeInsufficientScope: "token lacks scope %s",
+	ErrCodeForbidden:         "not allowed for the caller's role",
+
+	ErrCodeRateLimited: "rate limit exceeded, retry in %d s",
+
 	ErrCodeUnknown: "unknown error",
 }
 
 type ErrorResponse struct {
-	Error ErrorDetails `json:"error"`
+	Error     ErrorDetails `json:"error"`
+	RequestID string       `json:"request_id,omitempty"`
 }
 
 type ErrorDetails struct {
@@ -57,11 +96,13 @@ func (e ErrCode) Message(args ...any) string {
 	return "Unknown error"
 }
 
-func NewErrorResponse(code ErrCode, args ...any) *ErrorResponse {
+// NewErrorResponse builds the error body, the request ID from ctx lets a client report the failing request.
+func NewErrorResponse(ctx context.Context, code ErrCode, args ...any) *ErrorResponse {
 	return &ErrorResponse{
 		Error: ErrorDetails{
 			Code:    code,
 			Message: code.Message(args...),
 		},
+		RequestID: logger.RequestID(ctx),
 	}
 }
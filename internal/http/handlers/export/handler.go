@@ -0,0 +1,168 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/logger"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportRepository passes rows to yield as they are read, an error from yield stops the export.
+type ExportRepository interface {
+	ExportTeams(ctx context.Context, yield func(TeamRow) error) error
+	ExportPullRequests(ctx context.Context, filter *Filter, yield func(PullRequestRow) error) error
+	ExportAssignments(ctx context.Context, filter *Filter, yield func(AssignmentRow) error) error
+}
+
+type ExportHandler struct {
+	log        *slog.Logger
+	exportRepo ExportRepository
+}
+
+func NewExportHandler(log *slog.Logger, exportRepo ExportRepository) *ExportHandler {
+	return &ExportHandler{
+		log:        log,
+		exportRepo: exportRepo,
+	}
+}
+
+func (h *ExportHandler) ExportTeams(c *gin.Context) {
+	const op = "handlers.export.ExportTeams"
+	log := h.log.With(slog.String("op", op))
+
+	format, ok := parseFormat(c)
+	if !ok {
+		log.WarnContext(c.Request.Context(), "invalid format")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), "query param decoded", slog.String("format", string(format)))
+
+	w := newRowWriter(c, format, "teams", teamsCSVHeader)
+	stream(c, log, w, func(ctx context.Context) error {
+		return h.exportRepo.ExportTeams(ctx, func(r TeamRow) error { return w.write(r) })
+	})
+}
+
+func (h *ExportHandler) ExportPullRequests(c *gin.Context) {
+	const op = "handlers.export.ExportPullRequests"
+	log := h.log.With(slog.String("op", op))
+
+	format, filter, ok := parseQuery(c)
+	if !ok {
+		log.WarnContext(c.Request.Context(), "invalid query params")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(),
+		"query param decoded",
+		slog.String("format", string(format)),
+		slog.Any("filter", filter),
+	)
+
+	w := newRowWriter(c, format, "pull_requests", pullRequestsCSVHeader)
+	stream(c, log, w, func(ctx context.Context) error {
+		return h.exportRepo.ExportPullRequests(ctx, filter, func(r PullRequestRow) error { return w.write(r) })
+	})
+}
+
+func (h *ExportHandler) ExportAssignments(c *gin.Context) {
+	const op = "handlers.export.ExportAssignments"
+	log := h.log.With(slog.String("op", op))
+
+	format, filter, ok := parseQuery(c)
+	if !ok {
+		log.WarnContext(c.Request.Context(), "invalid query params")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(),
+		"query param decoded",
+		slog.String("format", string(format)),
+		slog.Any("filter", filter),
+	)
+
+	w := newRowWriter(c, format, "assignments", assignmentsCSVHeader)
+	stream(c, log, w, func(ctx context.Context) error {
+		return h.exportRepo.ExportAssignments(ctx, filter, func(r AssignmentRow) error { return w.write(r) })
+	})
+}
+
+// stream runs export and finishes the response. Once rows went out the status can't change,
+// so a failure midway only cuts the stream short and gets logged.
+func stream(c *gin.Context, log *slog.Logger, w *rowWriter, export func(ctx context.Context) error) {
+	err := w.extendWriteDeadline()
+	if err == nil {
+		err = export(c.Request.Context())
+	}
+	if err == nil {
+		err = w.close()
+	}
+
+	if err != nil && !w.started {
+		log.ErrorContext(c.Request.Context(), "failed to export", logger.ErrAttr(err))
+
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+	if err != nil {
+		log.ErrorContext(c.Request.Context(), "export interrupted", logger.ErrAttr(err), slog.Int("rows", w.rows))
+
+		c.Abort()
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), "exported", slog.Int("rows", w.rows))
+}
+
+func parseFormat(c *gin.Context) (Format, bool) {
+	format := Format(strings.ToLower(c.DefaultQuery("format", string(FormatNDJSON))))
+
+	return format, format.isValid()
+}
+
+func parseQuery(c *gin.Context) (Format, *Filter, bool) {
+	format, ok := parseFormat(c)
+	if !ok {
+		return "", nil, false
+	}
+
+	from, okFrom := parseTimeParam(c, "from")
+	to, okTo := parseTimeParam(c, "to")
+	if !okFrom || !okTo || (from != nil && to != nil && !from.Before(*to)) {
+		return "", nil, false
+	}
+
+	return format, &Filter{
+		From:     from,
+		To:       to,
+		TeamName: c.Query("team_name"),
+	}, true
+}
+
+// parseTimeParam reads an optional RFC 3339 param as UTC, nil when it is missing.
+func parseTimeParam(c *gin.Context, param string) (*time.Time, bool) {
+	raw, ok := c.GetQuery(param)
+	if !ok {
+		return nil, true
+	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, false
+	}
+	t = t.UTC()
+
+	return &t, true
+}
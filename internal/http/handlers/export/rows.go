@@ -0,0 +1,125 @@
+package export
+
+import (
+	"strings"
+	"time"
+)
+
+// listSeparator joins ids in a CSV cell, the same way /pullRequest/import reads them.
+const listSeparator = ";"
+
+type Format string
+
+const (
+	FormatNDJSON Format = "ndjson"
+	FormatCSV    Format = "csv"
+)
+
+func (f Format) isValid() bool {
+	return f == FormatNDJSON || f == FormatCSV
+}
+
+func (f Format) contentType() string {
+	if f == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+
+	return "application/x-ndjson"
+}
+
+// Filter bounds exported PRs by creation time, nil bounds are open.
+// A team filter rolls up the PRs of the team and all its subteams.
+type Filter struct {
+	From     *time.Time
+	To       *time.Time
+	TeamName string
+}
+
+type TeamRow struct {
+	TeamName       string   `json:"team_name"`
+	ParentTeamName string   `json:"parent_team_name"`
+	MemberIDs      []string `json:"member_ids"`
+}
+
+var teamsCSVHeader = []string{"team_name", "parent_team_name", "member_ids"}
+
+func (r TeamRow) csvRecord() []string {
+	return []string{r.TeamName, r.ParentTeamName, strings.Join(r.MemberIDs, listSeparator)}
+}
+
+// PullRequestRow has the same fields /pullRequest/import accepts, so an export can be loaded back.
+type PullRequestRow struct {
+	ID          string     `json:"pull_request_id"`
+	Name        string     `json:"pull_request_name"`
+	AuthorID    string     `json:"author_id"`
+	TeamName    string     `json:"team_name"`
+	Status      string     `json:"status"`
+	ReviewerIDs []string   `json:"reviewer_ids"`
+	CreatedAt   time.Time  `json:"created_at"`
+	MergedAt    *time.Time `json:"merged_at"`
+}
+
+var pullRequestsCSVHeader = []string{
+	"pull_request_id",
+	"pull_request_name",
+	"author_id",
+	"team_name",
+	"status",
+	"reviewer_ids",
+	"created_at",
+	"merged_at",
+}
+
+func (r PullRequestRow) csvRecord() []string {
+	return []string{
+		r.ID,
+		r.Name,
+		r.AuthorID,
+		r.TeamName,
+		r.Status,
+		strings.Join(r.ReviewerIDs, listSeparator),
+		formatTime(&r.CreatedAt),
+		formatTime(r.MergedAt),
+	}
+}
+
+// AssignmentRow is a reviewer offered a PR: still assigned, approved or declined.
+type AssignmentRow struct {
+	PullRequestID string     `json:"pull_request_id"`
+	TeamName      string     `json:"team_name"`
+	ReviewerID    string     `json:"reviewer_id"`
+	GroupTeamName string     `json:"group_team_name"`
+	ApprovedAt    *time.Time `json:"approved_at"`
+	DeclinedAt    *time.Time `json:"declined_at"`
+	DeclineReason string     `json:"decline_reason"`
+}
+
+var assignmentsCSVHeader = []string{
+	"pull_request_id",
+	"team_name",
+	"reviewer_id",
+	"group_team_name",
+	"approved_at",
+	"declined_at",
+	"decline_reason",
+}
+
+func (r AssignmentRow) csvRecord() []string {
+	return []string{
+		r.PullRequestID,
+		r.TeamName,
+		r.ReviewerID,
+		r.GroupTeamName,
+		formatTime(r.ApprovedAt),
+		formatTime(r.DeclinedAt),
+		r.DeclineReason,
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
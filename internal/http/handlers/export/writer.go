@@ -0,0 +1,126 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// flushEvery rows the buffered output is sent to the client.
+	flushEvery = 500
+	// writeTimeout replaces the server write timeout for every flushed chunk,
+	// an export may take far longer than a regular response.
+	writeTimeout = 30 * time.Second
+)
+
+type row interface {
+	csvRecord() []string
+}
+
+// rowWriter streams rows into the response. The status and the headers go out with the first row,
+// so until then a failed export can still be answered with an error.
+type rowWriter struct {
+	c        *gin.Context
+	format   Format
+	fileName string
+	header   []string
+	csv      *csv.Writer
+	json     *json.Encoder
+	started  bool
+	rows     int
+}
+
+func newRowWriter(c *gin.Context, format Format, fileName string, header []string) *rowWriter {
+	return &rowWriter{
+		c:        c,
+		format:   format,
+		fileName: fileName,
+		header:   header,
+		csv:      csv.NewWriter(c.Writer),
+		json:     json.NewEncoder(c.Writer),
+	}
+}
+
+func (w *rowWriter) start() error {
+	w.started = true
+
+	w.c.Header("Content-Type", w.format.contentType())
+	w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, w.fileName, w.format))
+	w.c.Status(http.StatusOK)
+
+	if w.format == FormatCSV {
+		if err := w.csv.Write(w.header); err != nil {
+			return fmt.Errorf("failed to write CSV header: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (w *rowWriter) write(r row) error {
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if w.format == FormatCSV {
+		err = w.csv.Write(r.csvRecord())
+	} else {
+		err = w.json.Encode(r)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write row: %w", err)
+	}
+
+	w.rows++
+	if w.rows%flushEvery == 0 {
+		return w.flush()
+	}
+
+	return nil
+}
+
+// close sends what is left, an empty export still gets the headers and the CSV header line.
+func (w *rowWriter) close() error {
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+
+	return w.flush()
+}
+
+func (w *rowWriter) flush() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV: %w", err)
+	}
+
+	if err := w.extendWriteDeadline(); err != nil {
+		return err
+	}
+
+	if err := http.NewResponseController(w.c.Writer).Flush(); err != nil {
+		return fmt.Errorf("failed to flush response: %w", err)
+	}
+
+	return nil
+}
+
+func (w *rowWriter) extendWriteDeadline() error {
+	err := http.NewResponseController(w.c.Writer).SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("failed to extend write deadline: %w", err)
+	}
+
+	return nil
+}
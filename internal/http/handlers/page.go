@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	limitParam  = "limit"
+	offsetParam = "offset"
+
+	maxLimit = 100
+)
+
+// Page limits a listing, zero Limit means no limit.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// ParsePage reads optional limit and offset query params, falling back to defaultLimit.
+func ParsePage(c *gin.Context, defaultLimit int) (Page, bool) {
+	page := Page{Limit: defaultLimit}
+
+	if raw, ok := c.GetQuery(limitParam); ok {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return Page{}, false
+		}
+		page.Limit = limit
+	}
+
+	if raw, ok := c.GetQuery(offsetParam); ok {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return Page{}, false
+		}
+		page.Offset = offset
+	}
+
+	return page, true
+}
@@ -0,0 +1,86 @@
+package pullrequests
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/rules"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *PullRequestHandler) Decline(c *gin.Context) {
+	const op = "handlers.pull_requests.Decline"
+	log := h.log.With(slog.String("op", op))
+
+	var req DeclinePullRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
+
+	if err := validate.Struct(req); err != nil {
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
+
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return
+	}
+
+	pullRequest, replacedBy, err := h.pullRequestService.Decline(c.Request.Context(), &prsDomain.Decline{
+		PullRequestID: req.ID,
+		ReviewerID:    req.ReviewerID,
+		Reason:        prsDomain.DeclineReason(req.Reason),
+		Comment:       req.Comment,
+	})
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestNotFound) || errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestAlreadyMerged) {
+		c.JSON(http.StatusConflict, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestMerged))
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestNotAssigned) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestNotAssigned),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestNoCandidates) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestNoCandidate),
+		)
+		return
+	}
+	var violationErr *rules.ViolationError
+	if errors.As(err, &violationErr) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleViolation, violationErr.Error()),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToDeclinePullRequestResponse(pullRequest, replacedBy))
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/rules"
 	"reviewer-assigner/internal/http/handlers"
 	"reviewer-assigner/internal/logger"
 	"reviewer-assigner/internal/service"
@@ -36,47 +38,64 @@ func (h *PullRequestHandler) Create(c *gin.Context) {
 
 	var req CreatePullRequestRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Warn("invalid json body", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
 
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidJSON))
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
 		return
 	}
 
-	log.Info("request decoded", slog.Any("request", req))
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
 
 	if err := validate.Struct(req); err != nil {
-		log.Warn("validation error", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
 
 		c.JSON(
 			http.StatusUnprocessableEntity,
-			handlers.NewErrorResponse(handlers.ErrCodeInvalidBody),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
 		)
 		return
 	}
 
-	pullRequest, err := h.pullRequestService.Create(
+	pullRequest, applied, err := h.pullRequestService.Create(
 		c.Request.Context(),
 		req.ID,
 		req.Name,
 		req.AuthorID,
+		req.TeamName,
+		prsDomain.Size{LinesChanged: req.LinesChanged, Labels: req.Labels, Paths: req.Paths},
+		req.RequestedReviewers,
 	)
-	if errors.Is(err, service.ErrUserNotFound) || errors.Is(err, service.ErrTeamNotFound) {
-		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(handlers.ErrCodeResourceNotFound))
+	var memberErr *service.MemberError
+	if errors.As(err, &memberErr) {
+		respondReviewerError(c, memberErr)
+		return
+	}
+	if errors.Is(err, service.ErrUserNotFound) ||
+		errors.Is(err, service.ErrTeamNotFound) ||
+		errors.Is(err, service.ErrTeamMemberNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
 		return
 	}
 	if errors.Is(err, service.ErrPullRequestAlreadyExists) {
 		c.JSON(
 			http.StatusConflict,
-			handlers.NewErrorResponse(handlers.ErrCodePullRequestExists, req.ID),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestExists, req.ID),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestNoCandidates) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestNoCandidate),
 		)
 		return
 	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(handlers.ErrCodeUnknown))
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
 		return
 	}
 
-	c.JSON(http.StatusCreated, domainToCreatePullRequestResponse(pullRequest))
+	c.JSON(http.StatusCreated, domainToCreatePullRequestResponse(pullRequest, applied))
 }
 
 func (h *PullRequestHandler) Merge(c *gin.Context) {
@@ -85,31 +104,35 @@ func (h *PullRequestHandler) Merge(c *gin.Context) {
 
 	var req MergePullRequestRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Warn("invalid json body", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
 
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidJSON))
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
 		return
 	}
 
-	log.Info("request decoded", slog.Any("request", req))
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
 
 	if err := validate.Struct(req); err != nil {
-		log.Warn("validation error", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
 
 		c.JSON(
 			http.StatusUnprocessableEntity,
-			handlers.NewErrorResponse(handlers.ErrCodeInvalidBody),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
 		)
 		return
 	}
 
 	pullRequest, err := h.pullRequestService.Merge(c.Request.Context(), req.ID)
 	if errors.Is(err, service.ErrPullRequestNotFound) {
-		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(handlers.ErrCodeResourceNotFound))
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestNotApproved) {
+		c.JSON(http.StatusConflict, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeApprovalRequired))
 		return
 	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(handlers.ErrCodeUnknown))
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
 		return
 	}
 
@@ -122,20 +145,20 @@ func (h *PullRequestHandler) Reassign(c *gin.Context) {
 
 	var req ReassignPullRequestRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Warn("invalid json body", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
 
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidJSON))
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
 		return
 	}
 
-	log.Info("request decoded", slog.Any("request", req))
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
 
 	if err := validate.Struct(req); err != nil {
-		log.Warn("validation error", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
 
 		c.JSON(
 			http.StatusUnprocessableEntity,
-			handlers.NewErrorResponse(handlers.ErrCodeInvalidBody),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
 		)
 		return
 	}
@@ -145,30 +168,42 @@ func (h *PullRequestHandler) Reassign(c *gin.Context) {
 		req.ID,
 		req.OldReviewerID,
 	)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
 	if errors.Is(err, service.ErrPullRequestNotFound) {
-		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(handlers.ErrCodeResourceNotFound))
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
 		return
 	}
 	if errors.Is(err, service.ErrPullRequestAlreadyMerged) {
-		c.JSON(http.StatusConflict, handlers.NewErrorResponse(handlers.ErrCodePullRequestMerged))
+		c.JSON(http.StatusConflict, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestMerged))
 		return
 	}
 	if errors.Is(err, service.ErrPullRequestNotAssigned) {
 		c.JSON(
 			http.StatusConflict,
-			handlers.NewErrorResponse(handlers.ErrCodePullRequestNotAssigned),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestNotAssigned),
 		)
 		return
 	}
 	if errors.Is(err, service.ErrPullRequestNoCandidates) {
 		c.JSON(
 			http.StatusConflict,
-			handlers.NewErrorResponse(handlers.ErrCodePullRequestNoCandidate),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestNoCandidate),
+		)
+		return
+	}
+	var violationErr *rules.ViolationError
+	if errors.As(err, &violationErr) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleViolation, violationErr.Error()),
 		)
 		return
 	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(handlers.ErrCodeUnknown))
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
 		return
 	}
 
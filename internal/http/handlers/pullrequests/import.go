@@ -0,0 +1,276 @@
+package pullrequests
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"reflect"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	csvContentType = "text/csv"
+	// maxImportSize bounds the whole file, it is decoded in memory before the import.
+	maxImportSize = 64 << 20
+	// maxImportLineSize bounds a single NDJSON line.
+	maxImportLineSize = 1 << 20
+	// csvReviewersSeparator separates reviewer_ids inside a CSV cell.
+	csvReviewersSeparator = ";"
+)
+
+// importValidate names fields by their json tags, so line errors use the column names of the file.
+var importValidate = newImportValidator()
+
+func newImportValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		return name
+	})
+
+	return v
+}
+
+// Import loads PRs from history. The body is NDJSON, or CSV with a header line when sent as text/csv.
+// Lines that cannot be imported are listed in the response, the others are imported.
+func (h *PullRequestHandler) Import(c *gin.Context) {
+	const op = "handlers.pull_requests.Import"
+	log := h.log.With(slog.String("op", op), slog.String("content_type", c.ContentType()))
+
+	parse := parseNDJSONImport
+	if c.ContentType() == csvContentType {
+		parse = parseCSVImport
+	}
+
+	records, lineErrors, err := parse(http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize))
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		log.WarnContext(c.Request.Context(), "import file is too large")
+
+		c.JSON(
+			http.StatusRequestEntityTooLarge,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeBodyTooLarge, maxBytesErr.Limit),
+		)
+		return
+	}
+	if err != nil {
+		log.WarnContext(c.Request.Context(), "invalid import file", logger.ErrAttr(err))
+
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), "import file decoded",
+		slog.Int("records", len(records)),
+		slog.Int("invalid_lines", len(lineErrors)),
+	)
+
+	result, err := h.pullRequestService.Import(c.Request.Context(), records)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestAlreadyExists) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(
+				c.Request.Context(),
+				handlers.ErrCodePullRequestExists,
+				"stored during the import",
+			),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	result.Errors = append(result.Errors, lineErrors...)
+	slices.SortStableFunc(result.Errors, func(a, b prsDomain.ImportError) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+
+	c.JSON(http.StatusOK, domainToImportPullRequestsResponse(result))
+}
+
+// parseNDJSONImport reads a record per line, blank lines are skipped.
+func parseNDJSONImport(body io.Reader) ([]prsDomain.ImportRecord, []prsDomain.ImportError, error) {
+	var records []prsDomain.ImportRecord
+	var lineErrors []prsDomain.ImportError
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxImportLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		var record ImportPullRequestRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			lineErrors = append(lineErrors, prsDomain.ImportError{Line: line, Reason: "invalid JSON"})
+			continue
+		}
+
+		importRecord, lineErr := importRecordToDomain(line, &record)
+		if lineErr != nil {
+			lineErrors = append(lineErrors, *lineErr)
+			continue
+		}
+
+		records = append(records, *importRecord)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read NDJSON: %w", err)
+	}
+
+	return records, lineErrors, nil
+}
+
+// parseCSVImport reads records by the header line, pull_request_id, pull_request_name, author_id,
+// status and created_at columns are required.
+func parseCSVImport(body io.Reader) ([]prsDomain.ImportRecord, []prsDomain.ImportError, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.TrimSpace(column)] = i
+	}
+	for _, required := range []string{"pull_request_id", "pull_request_name", "author_id", "status", "created_at"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("CSV header has no %s column", required)
+		}
+	}
+
+	var records []prsDomain.ImportRecord
+	var lineErrors []prsDomain.ImportError
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			lineErrors = append(lineErrors, prsDomain.ImportError{Line: parseErr.StartLine, Reason: "invalid CSV"})
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		record, err := csvRowToRecord(row, columns)
+		if err != nil {
+			lineErrors = append(lineErrors, prsDomain.ImportError{
+				Line:          line,
+				PullRequestID: record.ID,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		importRecord, lineErr := importRecordToDomain(line, record)
+		if lineErr != nil {
+			lineErrors = append(lineErrors, *lineErr)
+			continue
+		}
+
+		records = append(records, *importRecord)
+	}
+
+	return records, lineErrors, nil
+}
+
+// csvRowToRecord reads the row by the header columns, times are in RFC 3339.
+// The record is returned along with the error so the line can be reported with its PR.
+func csvRowToRecord(row []string, columns map[string]int) (*ImportPullRequestRecord, error) {
+	cell := func(column string) string {
+		if i, ok := columns[column]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	record := &ImportPullRequestRecord{
+		ID:       cell("pull_request_id"),
+		Name:     cell("pull_request_name"),
+		AuthorID: cell("author_id"),
+		TeamName: cell("team_name"),
+		Status:   cell("status"),
+	}
+	if reviewerIDs := cell("reviewer_ids"); reviewerIDs != "" {
+		record.ReviewerIDs = strings.Split(reviewerIDs, csvReviewersSeparator)
+	}
+
+	if value := cell("created_at"); value != "" {
+		createdAt, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return record, errors.New("invalid created_at")
+		}
+		record.CreatedAt = &createdAt
+	}
+	if value := cell("merged_at"); value != "" {
+		mergedAt, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return record, errors.New("invalid merged_at")
+		}
+		record.MergedAt = &mergedAt
+	}
+
+	return record, nil
+}
+
+func importRecordToDomain(line int, record *ImportPullRequestRecord) (*prsDomain.ImportRecord, *prsDomain.ImportError) {
+	err := importValidate.Struct(record)
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return nil, &prsDomain.ImportError{
+			Line:          line,
+			PullRequestID: record.ID,
+			Reason:        "invalid " + validationErrs[0].Field(),
+		}
+	}
+	if err != nil {
+		return nil, &prsDomain.ImportError{Line: line, PullRequestID: record.ID, Reason: err.Error()}
+	}
+
+	return &prsDomain.ImportRecord{
+		Line: line,
+		PullRequest: prsDomain.PullRequest{
+			PullRequestShort: prsDomain.PullRequestShort{
+				ID:       record.ID,
+				Name:     record.Name,
+				AuthorID: record.AuthorID,
+				TeamName: record.TeamName,
+				Status:   prsDomain.StatusPR(record.Status),
+			},
+			AssignedReviewers: record.ReviewerIDs,
+			CreatedAt:         record.CreatedAt,
+			MergedAt:          record.MergedAt,
+		},
+	}, nil
+}
@@ -1,9 +1,20 @@
 package pullrequests
 
+import "time"
+
+// CreatePullRequestRequest targets the author's primary team when TeamName is empty,
+// the picker fills only the slots RequestedReviewers leave. LinesChanged and Labels
+// are matched against the review rules of the team to size the review, Labels and Paths
+// against the required reviewer groups.
 type CreatePullRequestRequest struct {
-	ID       string `json:"pull_request_id"   validate:"required"`
-	Name     string `json:"pull_request_name" validate:"required"`
-	AuthorID string `json:"author_id"         validate:"required"`
+	ID                 string   `json:"pull_request_id"     validate:"required"`
+	Name               string   `json:"pull_request_name"   validate:"required"`
+	AuthorID           string   `json:"author_id"           validate:"required"`
+	TeamName           string   `json:"team_name"`
+	LinesChanged       int      `json:"lines_changed"       validate:"min=0"`
+	Labels             []string `json:"labels"              validate:"dive,required"`
+	Paths              []string `json:"paths"               validate:"dive,required"`
+	RequestedReviewers []string `json:"requested_reviewers" validate:"dive,required"`
 }
 
 type MergePullRequestRequest struct {
@@ -14,3 +25,36 @@ type ReassignPullRequestRequest struct {
 	ID            string `json:"pull_request_id" validate:"required"`
 	OldReviewerID string `json:"old_reviewer_id" validate:"required"`
 }
+
+type SetReviewersRequest struct {
+	ID     string   `json:"pull_request_id" validate:"required"`
+	Add    []string `json:"add"             validate:"required_without=Remove,dive,required"`
+	Remove []string `json:"remove"          validate:"dive,required"`
+}
+
+// DeclinePullRequestRequest is sent by the reviewer, Comment is free text next to the reason code.
+type DeclinePullRequestRequest struct {
+	ID         string `json:"pull_request_id" validate:"required"`
+	ReviewerID string `json:"reviewer_id" validate:"required"`
+	Reason     string `json:"reason" validate:"required,oneof=NO_CONTEXT CONFLICT_OF_INTEREST UNAVAILABLE OTHER"`
+	Comment    string `json:"comment" validate:"max=512"`
+}
+
+// ApprovePullRequestRequest is sent by the reviewer themselves.
+type ApprovePullRequestRequest struct {
+	ID         string `json:"pull_request_id" validate:"required"`
+	ReviewerID string `json:"reviewer_id"     validate:"required"`
+}
+
+// ImportPullRequestRecord is a line of the imported NDJSON or CSV file, in CSV reviewer_ids
+// are separated by semicolons.
+type ImportPullRequestRecord struct {
+	ID          string     `json:"pull_request_id"   validate:"required,max=64"`
+	Name        string     `json:"pull_request_name" validate:"required,max=256"`
+	AuthorID    string     `json:"author_id"         validate:"required"`
+	TeamName    string     `json:"team_name"`
+	Status      string     `json:"status"            validate:"required,oneof=OPEN MERGED"`
+	ReviewerIDs []string   `json:"reviewer_ids"      validate:"dive,required"`
+	CreatedAt   *time.Time `json:"created_at"        validate:"required"`
+	MergedAt    *time.Time `json:"merged_at"`
+}
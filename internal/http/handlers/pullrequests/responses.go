@@ -2,11 +2,22 @@ package pullrequests
 
 import (
 	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/rules"
 	"time"
 )
 
+// CreatePullRequestResponse lists in AppliedRules the team rules that kept members from reviewing.
 type CreatePullRequestResponse struct {
 	PullRequestResponse `json:"pr"`
+
+	AppliedRules []AppliedRuleResponse `json:"applied_rules,omitempty"`
+}
+
+type AppliedRuleResponse struct {
+	Kind        string `json:"kind"`
+	UserID      string `json:"user_id"`
+	OtherUserID string `json:"other_user_id"`
+	Reason      string `json:"reason"`
 }
 
 type MergePullRequestResponse struct {
@@ -19,19 +30,54 @@ type ReassignPullRequestResponse struct {
 	ReplacedBy string `json:"replaced_by"`
 }
 
+type SetReviewersResponse struct {
+	PullRequestResponse `json:"pr"`
+}
+
+// DeclinePullRequestResponse has a null ReplacedBy when nobody was left to take the review.
+type DeclinePullRequestResponse struct {
+	PullRequestResponse `json:"pr"`
+
+	ReplacedBy *string `json:"replaced_by"`
+}
+
+type ApprovePullRequestResponse struct {
+	PullRequestResponse `json:"pr"`
+
+	PendingGroups []string `json:"pending_groups"`
+}
+
+// PullRequestResponse lists in GroupReviewers the reviewers filling required group slots
+// along with their group.
 type PullRequestResponse struct {
-	ID                string     `json:"pull_request_id"`
-	Name              string     `json:"pull_request_name"`
-	AuthorID          string     `json:"author_id"`
-	Status            string     `json:"status"`
-	AssignedReviewers []string   `json:"assigned_reviewers"`
-	CreatedAt         *time.Time `json:"created_at,omitempty"`
-	MergedAt          *time.Time `json:"merged_at,omitempty"`
+	ID                string            `json:"pull_request_id"`
+	Name              string            `json:"pull_request_name"`
+	AuthorID          string            `json:"author_id"`
+	Status            string            `json:"status"`
+	AssignedReviewers []string          `json:"assigned_reviewers"`
+	GroupReviewers    map[string]string `json:"group_reviewers,omitempty"`
+	ApprovedReviewers []string          `json:"approved_reviewers,omitempty"`
+	CreatedAt         *time.Time        `json:"created_at,omitempty"`
+	MergedAt          *time.Time        `json:"merged_at,omitempty"`
 }
 
-func domainToCreatePullRequestResponse(pr *prsDomain.PullRequest) *CreatePullRequestResponse {
+func domainToCreatePullRequestResponse(
+	pr *prsDomain.PullRequest,
+	applied []rules.Violation,
+) *CreatePullRequestResponse {
+	appliedResponse := make([]AppliedRuleResponse, 0, len(applied))
+	for _, violation := range applied {
+		appliedResponse = append(appliedResponse, AppliedRuleResponse{
+			Kind:        string(violation.Rule.Kind),
+			UserID:      violation.Rule.UserID,
+			OtherUserID: violation.Rule.OtherUserID,
+			Reason:      violation.Reason,
+		})
+	}
+
 	return &CreatePullRequestResponse{
 		PullRequestResponse: *domainToPullRequestResponse(pr),
+		AppliedRules:        appliedResponse,
 	}
 }
 
@@ -51,6 +97,33 @@ func domainToReassignPullRequestResponse(
 	}
 }
 
+func domainToSetReviewersResponse(pr *prsDomain.PullRequest) *SetReviewersResponse {
+	return &SetReviewersResponse{
+		PullRequestResponse: *domainToPullRequestResponse(pr),
+	}
+}
+
+func domainToApprovePullRequestResponse(pr *prsDomain.PullRequest) *ApprovePullRequestResponse {
+	return &ApprovePullRequestResponse{
+		PullRequestResponse: *domainToPullRequestResponse(pr),
+		PendingGroups:       pr.PendingGroups(),
+	}
+}
+
+func domainToDeclinePullRequestResponse(
+	pr *prsDomain.PullRequest,
+	replacedBy string,
+) *DeclinePullRequestResponse {
+	response := &DeclinePullRequestResponse{
+		PullRequestResponse: *domainToPullRequestResponse(pr),
+	}
+	if replacedBy != "" {
+		response.ReplacedBy = &replacedBy
+	}
+
+	return response
+}
+
 func domainToPullRequestResponse(pr *prsDomain.PullRequest) *PullRequestResponse {
 	return &PullRequestResponse{
 		ID:                pr.ID,
@@ -58,7 +131,36 @@ func domainToPullRequestResponse(pr *prsDomain.PullRequest) *PullRequestResponse
 		AuthorID:          pr.AuthorID,
 		Status:            string(pr.Status),
 		AssignedReviewers: pr.AssignedReviewers,
+		GroupReviewers:    pr.GroupReviewers,
+		ApprovedReviewers: pr.ApprovedReviewers,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
 	}
 }
+
+type ImportPullRequestsResponse struct {
+	Imported int                   `json:"imported"`
+	Errors   []ImportErrorResponse `json:"errors"`
+}
+
+type ImportErrorResponse struct {
+	Line          int    `json:"line"`
+	PullRequestID string `json:"pull_request_id,omitempty"`
+	Message       string `json:"message"`
+}
+
+func domainToImportPullRequestsResponse(result *prsDomain.ImportResult) *ImportPullRequestsResponse {
+	errorsResponse := make([]ImportErrorResponse, 0, len(result.Errors))
+	for _, importErr := range result.Errors {
+		errorsResponse = append(errorsResponse, ImportErrorResponse{
+			Line:          importErr.Line,
+			PullRequestID: importErr.PullRequestID,
+			Message:       importErr.Reason,
+		})
+	}
+
+	return &ImportPullRequestsResponse{
+		Imported: result.Imported,
+		Errors:   errorsResponse,
+	}
+}
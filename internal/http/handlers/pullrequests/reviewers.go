@@ -0,0 +1,89 @@
+package pullrequests
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *PullRequestHandler) SetReviewers(c *gin.Context) {
+	const op = "handlers.pull_requests.SetReviewers"
+	log := h.log.With(slog.String("op", op))
+
+	var req SetReviewersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
+
+	if err := validate.Struct(req); err != nil {
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
+
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return
+	}
+
+	pullRequest, err := h.pullRequestService.SetReviewers(c.Request.Context(), req.ID, req.Add, req.Remove)
+	var memberErr *service.MemberError
+	if errors.As(err, &memberErr) {
+		respondReviewerError(c, memberErr)
+		return
+	}
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestNotFound) || errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestAlreadyMerged) {
+		c.JSON(http.StatusConflict, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestMerged))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToSetReviewersResponse(pullRequest))
+}
+
+// respondReviewerError reports a reviewer named in the request that could not be added or removed.
+func respondReviewerError(c *gin.Context, err *service.MemberError) {
+	if errors.Is(err, service.ErrReviewerNotEligible) {
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeReviewerInvalid, err.UserID),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestNotAssigned) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestNotAssigned),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrReviewerInGroupSlot) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeReviewerInGroupSlot, err.UserID),
+		)
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+}
@@ -0,0 +1,30 @@
+package stats
+
+import (
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"time"
+)
+
+type DeclinesFilter struct {
+	From     time.Time
+	To       time.Time
+	TeamName string
+}
+
+// ReviewerDeclines counts reviews offered to a reviewer on PRs created in the period,
+// declined ones included, and how many of them were declined by reason.
+type ReviewerDeclines struct {
+	UserID      string
+	Name        string
+	Assignments int
+	Declines    int
+	Reasons     map[prsDomain.DeclineReason]int
+}
+
+func (r *ReviewerDeclines) rate() float64 {
+	if r.Assignments == 0 {
+		return 0
+	}
+
+	return float64(r.Declines) / float64(r.Assignments)
+}
@@ -8,6 +8,7 @@ import (
 	"reviewer-assigner/internal/http/handlers"
 	"reviewer-assigner/internal/logger"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -17,7 +18,14 @@ type StatRepository interface {
 		ctx context.Context,
 		status string,
 		activeOnly bool,
+		page handlers.Page,
 	) ([]UserAssignment, error)
+	GetStatsReviewersLoad(ctx context.Context, filter *LoadFilter) ([]TeamLoad, int, error)
+	GetStatsPullRequestsTimeseries(
+		ctx context.Context,
+		filter *TimeseriesFilter,
+	) ([]TimeseriesPoint, error)
+	GetStatsReviewersDeclines(ctx context.Context, filter *DeclinesFilter) ([]ReviewerDeclines, error)
 }
 
 type StatHandler struct {
@@ -41,40 +49,218 @@ func (h *StatHandler) GetStatsReviewersAssignments(c *gin.Context) {
 
 	status := strings.ToUpper(c.Query(statusParam))
 	if !isValidStatus(status) {
-		log.Warn("invalid status", slog.String("status", status))
+		log.WarnContext(c.Request.Context(), "invalid status", slog.String("status", status))
 
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidQueryParam))
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
 		return
 	}
 
 	_, ok := c.GetQuery(activeOnlyParam)
 	activeOnly := ok
 
-	log.Info(
+	// no limit by default to keep old clients working
+	page, ok := handlers.ParsePage(c, 0)
+	if !ok {
+		log.WarnContext(c.Request.Context(), "invalid pagination")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(),
 		"query param decoded",
 		slog.String(statusParam, status),
 		slog.Bool(activeOnlyParam, activeOnly),
+		slog.Any("page", page),
 	)
 
 	usersAssignments, err := h.statsRepo.GetStatsReviewersAssignments(
 		c.Request.Context(),
 		status,
 		activeOnly,
+		page,
 	)
 	if err != nil {
-		log.Error("failed to get stats reviewer assignments", logger.ErrAttr(err))
+		log.ErrorContext(c.Request.Context(), "failed to get stats reviewer assignments", logger.ErrAttr(err))
 
-		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(handlers.ErrCodeUnknown))
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
 		return
 	}
 
-	log.Info("got stats reviewer assignments", slog.Int("len", len(usersAssignments)))
+	log.InfoContext(c.Request.Context(), "got stats reviewer assignments", slog.Int("len", len(usersAssignments)))
 
 	c.JSON(http.StatusOK, GetStatsUserAssignmentsResponse{
 		UserAssignments: usersAssignments,
 	})
 }
 
+func (h *StatHandler) GetStatsReviewersLoad(c *gin.Context) {
+	const op = "handlers.stats.GetStatsReviewersLoad"
+	log := h.log.With(slog.String("op", op))
+
+	const (
+		teamNameParam = "team_name"
+
+		defaultPeriod = 30 * 24 * time.Hour
+		defaultLimit  = 20
+	)
+
+	now := time.Now().UTC()
+
+	from, okFrom := parseTimeParam(c, "from", now.Add(-defaultPeriod))
+	to, okTo := parseTimeParam(c, "to", now)
+	if !okFrom || !okTo || !from.Before(to) {
+		log.WarnContext(c.Request.Context(), "invalid period")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	page, ok := handlers.ParsePage(c, defaultLimit)
+	if !ok {
+		log.WarnContext(c.Request.Context(), "invalid pagination")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	filter := &LoadFilter{
+		From:     from,
+		To:       to,
+		TeamName: c.Query(teamNameParam),
+		Page:     page,
+	}
+
+	log.InfoContext(c.Request.Context(), "query param decoded", slog.Any("filter", filter))
+
+	teamsLoad, total, err := h.statsRepo.GetStatsReviewersLoad(c.Request.Context(), filter)
+	if err != nil {
+		log.ErrorContext(c.Request.Context(), "failed to get stats reviewers load", logger.ErrAttr(err))
+
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	log.InfoContext(
+		c.Request.Context(),
+		"got stats reviewers load",
+		slog.Int("len", len(teamsLoad)),
+		slog.Int("total", total),
+	)
+
+	c.JSON(http.StatusOK, toReviewersLoadResponse(filter, teamsLoad, total))
+}
+
+func (h *StatHandler) GetStatsPullRequestsTimeseries(c *gin.Context) {
+	const op = "handlers.stats.GetStatsPullRequestsTimeseries"
+	log := h.log.With(slog.String("op", op))
+
+	const (
+		teamNameParam = "team_name"
+		bucketParam   = "bucket"
+
+		defaultPeriod = 12 * 7 * 24 * time.Hour
+		maxBuckets    = 1000
+	)
+
+	now := time.Now().UTC()
+
+	from, okFrom := parseTimeParam(c, "from", now.Add(-defaultPeriod))
+	to, okTo := parseTimeParam(c, "to", now)
+	if !okFrom || !okTo || !from.Before(to) {
+		log.WarnContext(c.Request.Context(), "invalid period")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	bucket := Bucket(strings.ToLower(c.DefaultQuery(bucketParam, string(BucketWeek))))
+	if !bucket.isValid() || to.Sub(from)/bucket.approxDuration() > maxBuckets {
+		log.WarnContext(c.Request.Context(), "invalid bucket", slog.String(bucketParam, string(bucket)))
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	filter := &TimeseriesFilter{
+		From:     from,
+		To:       to,
+		Bucket:   bucket,
+		TeamName: c.Query(teamNameParam),
+	}
+
+	log.InfoContext(c.Request.Context(), "query param decoded", slog.Any("filter", filter))
+
+	points, err := h.statsRepo.GetStatsPullRequestsTimeseries(c.Request.Context(), filter)
+	if err != nil {
+		log.ErrorContext(c.Request.Context(), "failed to get stats pull requests timeseries", logger.ErrAttr(err))
+
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), "got stats pull requests timeseries", slog.Int("len", len(points)))
+
+	c.JSON(http.StatusOK, toTimeseriesResponse(filter, points))
+}
+
+func (h *StatHandler) GetStatsReviewersDeclines(c *gin.Context) {
+	const op = "handlers.stats.GetStatsReviewersDeclines"
+	log := h.log.With(slog.String("op", op))
+
+	const (
+		teamNameParam = "team_name"
+
+		defaultPeriod = 30 * 24 * time.Hour
+	)
+
+	now := time.Now().UTC()
+
+	from, okFrom := parseTimeParam(c, "from", now.Add(-defaultPeriod))
+	to, okTo := parseTimeParam(c, "to", now)
+	if !okFrom || !okTo || !from.Before(to) {
+		log.WarnContext(c.Request.Context(), "invalid period")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	filter := &DeclinesFilter{
+		From:     from,
+		To:       to,
+		TeamName: c.Query(teamNameParam),
+	}
+
+	log.InfoContext(c.Request.Context(), "query param decoded", slog.Any("filter", filter))
+
+	reviewers, err := h.statsRepo.GetStatsReviewersDeclines(c.Request.Context(), filter)
+	if err != nil {
+		log.ErrorContext(c.Request.Context(), "failed to get stats reviewers declines", logger.ErrAttr(err))
+
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), "got stats reviewers declines", slog.Int("len", len(reviewers)))
+
+	c.JSON(http.StatusOK, toReviewersDeclinesResponse(filter, reviewers))
+}
+
+// parseTimeParam reads an optional RFC 3339 param as UTC.
+func parseTimeParam(c *gin.Context, param string, defaultValue time.Time) (time.Time, bool) {
+	raw, ok := c.GetQuery(param)
+	if !ok {
+		return defaultValue, true
+	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t.UTC(), true
+}
+
 func isValidStatus(status string) bool {
 	// can be empty, it means all statuses
 	if status == "" {
@@ -0,0 +1,41 @@
+package stats
+
+import (
+	assignmentsDomain "reviewer-assigner/internal/domain/assignments"
+	"reviewer-assigner/internal/http/handlers"
+	"time"
+)
+
+type LoadFilter struct {
+	From     time.Time
+	To       time.Time
+	TeamName string
+	Page     handlers.Page
+}
+
+type TeamLoad struct {
+	TeamName  string
+	Reviewers []ReviewerLoad
+}
+
+type ReviewerLoad struct {
+	UserID      string
+	Name        string
+	IsActive    bool
+	OpenReviews int
+	Assignments int
+	// AvgTimeToMerge is nil when none of the reviewed PRs were merged in the period.
+	AvgTimeToMerge *time.Duration
+}
+
+// fairness scores assignments in the period, inactive reviewers count only if they took any.
+func (t *TeamLoad) fairness() assignmentsDomain.Fairness {
+	loads := make([]int, 0, len(t.Reviewers))
+	for _, reviewer := range t.Reviewers {
+		if reviewer.IsActive || reviewer.Assignments > 0 {
+			loads = append(loads, reviewer.Assignments)
+		}
+	}
+
+	return assignmentsDomain.NewFairness(loads)
+}
@@ -1,5 +1,10 @@
 package stats
 
+import (
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"time"
+)
+
 type GetStatsUserAssignmentsResponse struct {
 	UserAssignments []UserAssignment `json:"assignments"`
 }
@@ -9,3 +14,171 @@ type UserAssignment struct {
 	Name   string `json:"username"`
 	Count  int    `json:"count"`
 }
+
+type GetStatsReviewersLoadResponse struct {
+	From   time.Time          `json:"from"`
+	To     time.Time          `json:"to"`
+	Limit  int                `json:"limit"`
+	Offset int                `json:"offset"`
+	Total  int                `json:"total"`
+	Teams  []TeamLoadResponse `json:"teams"`
+}
+
+type TeamLoadResponse struct {
+	TeamName  string                 `json:"team_name"`
+	Fairness  FairnessResponse       `json:"fairness"`
+	Reviewers []ReviewerLoadResponse `json:"reviewers"`
+}
+
+type FairnessResponse struct {
+	Gini    float64 `json:"gini"`
+	MaxLoad int     `json:"max_load"`
+	MinLoad int     `json:"min_load"`
+}
+
+type ReviewerLoadResponse struct {
+	UserID                string   `json:"user_id"`
+	Name                  string   `json:"username"`
+	IsActive              bool     `json:"is_active"`
+	OpenReviews           int      `json:"open_reviews"`
+	Assignments           int      `json:"assignments"`
+	AvgTimeToMergeSeconds *float64 `json:"avg_time_to_merge_seconds"`
+}
+
+func toReviewersLoadResponse(
+	filter *LoadFilter,
+	teams []TeamLoad,
+	total int,
+) *GetStatsReviewersLoadResponse {
+	response := &GetStatsReviewersLoadResponse{
+		From:   filter.From,
+		To:     filter.To,
+		Limit:  filter.Page.Limit,
+		Offset: filter.Page.Offset,
+		Total:  total,
+		Teams:  make([]TeamLoadResponse, 0, len(teams)),
+	}
+
+	for _, team := range teams {
+		fairness := team.fairness()
+
+		teamResponse := TeamLoadResponse{
+			TeamName: team.TeamName,
+			Fairness: FairnessResponse{
+				Gini:    fairness.Gini,
+				MaxLoad: fairness.MaxLoad,
+				MinLoad: fairness.MinLoad,
+			},
+			Reviewers: make([]ReviewerLoadResponse, 0, len(team.Reviewers)),
+		}
+
+		for _, reviewer := range team.Reviewers {
+			reviewerResponse := ReviewerLoadResponse{
+				UserID:      reviewer.UserID,
+				Name:        reviewer.Name,
+				IsActive:    reviewer.IsActive,
+				OpenReviews: reviewer.OpenReviews,
+				Assignments: reviewer.Assignments,
+			}
+			if reviewer.AvgTimeToMerge != nil {
+				seconds := reviewer.AvgTimeToMerge.Seconds()
+				reviewerResponse.AvgTimeToMergeSeconds = &seconds
+			}
+
+			teamResponse.Reviewers = append(teamResponse.Reviewers, reviewerResponse)
+		}
+
+		response.Teams = append(response.Teams, teamResponse)
+	}
+
+	return response
+}
+
+type GetStatsPullRequestsTimeseriesResponse struct {
+	From     time.Time                 `json:"from"`
+	To       time.Time                 `json:"to"`
+	Bucket   Bucket                    `json:"bucket"`
+	TeamName *string                   `json:"team_name"`
+	Points   []TimeseriesPointResponse `json:"points"`
+}
+
+type TimeseriesPointResponse struct {
+	BucketStart              time.Time `json:"bucket_start"`
+	Created                  int       `json:"created"`
+	Merged                   int       `json:"merged"`
+	MedianTimeToMergeSeconds *float64  `json:"median_time_to_merge_seconds"`
+}
+
+func toTimeseriesResponse(
+	filter *TimeseriesFilter,
+	points []TimeseriesPoint,
+) *GetStatsPullRequestsTimeseriesResponse {
+	response := &GetStatsPullRequestsTimeseriesResponse{
+		From:   filter.From,
+		To:     filter.To,
+		Bucket: filter.Bucket,
+		Points: make([]TimeseriesPointResponse, 0, len(points)),
+	}
+	if filter.TeamName != "" {
+		response.TeamName = &filter.TeamName
+	}
+
+	for _, point := range points {
+		pointResponse := TimeseriesPointResponse{
+			BucketStart: point.BucketStart,
+			Created:     point.Created,
+			Merged:      point.Merged,
+		}
+		if point.MedianTimeToMerge != nil {
+			seconds := point.MedianTimeToMerge.Seconds()
+			pointResponse.MedianTimeToMergeSeconds = &seconds
+		}
+
+		response.Points = append(response.Points, pointResponse)
+	}
+
+	return response
+}
+
+type GetStatsReviewersDeclinesResponse struct {
+	From      time.Time                  `json:"from"`
+	To        time.Time                  `json:"to"`
+	TeamName  *string                    `json:"team_name"`
+	Reviewers []ReviewerDeclinesResponse `json:"reviewers"`
+}
+
+type ReviewerDeclinesResponse struct {
+	UserID      string                          `json:"user_id"`
+	Name        string                          `json:"username"`
+	Assignments int                             `json:"assignments"`
+	Declines    int                             `json:"declines"`
+	DeclineRate float64                         `json:"decline_rate"`
+	Reasons     map[prsDomain.DeclineReason]int `json:"reasons"`
+}
+
+func toReviewersDeclinesResponse(
+	filter *DeclinesFilter,
+	reviewers []ReviewerDeclines,
+) *GetStatsReviewersDeclinesResponse {
+	response := &GetStatsReviewersDeclinesResponse{
+		From:      filter.From,
+		To:        filter.To,
+		Reviewers: make([]ReviewerDeclinesResponse, 0, len(reviewers)),
+	}
+	if filter.TeamName != "" {
+		response.TeamName = &filter.TeamName
+	}
+
+	for _, reviewer := range reviewers {
+		response.Reviewers = append(response.Reviewers, ReviewerDeclinesResponse{
+			UserID:      reviewer.UserID,
+			Name:        reviewer.Name,
+			Assignments: reviewer.Assignments,
+			Declines:    reviewer.Declines,
+			DeclineRate: reviewer.rate(),
+			Reasons:     reviewer.Reasons,
+		})
+	}
+
+	return response
+}
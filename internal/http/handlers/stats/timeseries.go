@@ -0,0 +1,51 @@
+package stats
+
+import "time"
+
+type Bucket string
+
+const (
+	BucketDay   Bucket = "day"
+	BucketWeek  Bucket = "week"
+	BucketMonth Bucket = "month"
+)
+
+// approxDuration is only used to bound the number of buckets in a response.
+func (b Bucket) approxDuration() time.Duration {
+	const (
+		day   = 24 * time.Hour
+		week  = 7 * day
+		month = 30 * day
+	)
+
+	switch b {
+	case BucketDay:
+		return day
+	case BucketWeek:
+		return week
+	case BucketMonth:
+		return month
+	default:
+		return 0
+	}
+}
+
+func (b Bucket) isValid() bool {
+	return b.approxDuration() != 0
+}
+
+type TimeseriesFilter struct {
+	From     time.Time
+	To       time.Time
+	Bucket   Bucket
+	TeamName string
+}
+
+// TimeseriesPoint counts PRs created and merged within a bucket starting at BucketStart.
+type TimeseriesPoint struct {
+	BucketStart time.Time
+	Created     int
+	Merged      int
+	// MedianTimeToMerge is nil when nothing was merged in the bucket.
+	MedianTimeToMerge *time.Duration
+}
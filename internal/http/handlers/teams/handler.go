@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"reviewer-assigner/internal/domain"
 	"reviewer-assigner/internal/http/handlers"
 	"reviewer-assigner/internal/logger"
 	"reviewer-assigner/internal/service"
@@ -33,20 +34,20 @@ func (h *TeamHandler) AddTeam(c *gin.Context) {
 
 	var req AddTeamRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Warn("failed to decode json body", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "failed to decode json body", logger.ErrAttr(err))
 
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidJSON))
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
 		return
 	}
 
-	log.Info("request decoded", slog.Any("request", req))
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
 
 	if err := validate.Struct(req); err != nil {
-		log.Warn("invalid json body", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
 
 		c.JSON(
 			http.StatusUnprocessableEntity,
-			handlers.NewErrorResponse(handlers.ErrCodeInvalidBody),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
 		)
 		return
 	}
@@ -56,15 +57,19 @@ func (h *TeamHandler) AddTeam(c *gin.Context) {
 		req.TeamName,
 		membersToDomain(req.Members),
 	)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
 	if errors.Is(err, service.ErrTeamAlreadyExists) {
 		c.JSON(
 			http.StatusBadRequest,
-			handlers.NewErrorResponse(handlers.ErrCodeTeamExists, req.TeamName),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamExists, req.TeamName),
 		)
 		return
 	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(handlers.ErrCodeUnknown))
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
 		return
 	}
 
@@ -79,27 +84,178 @@ func (h *TeamHandler) GetTeam(c *gin.Context) {
 
 	teamName, ok := c.GetQuery(teamNameParam)
 	if !ok {
-		log.Warn(teamNameParam + " not found in query params")
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidQueryParam))
+		log.WarnContext(c.Request.Context(), teamNameParam+" not found in query params")
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
 		return
 	}
 	if teamName == "" {
-		log.Warn(teamNameParam + " is empty")
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidQueryParam))
+		log.WarnContext(c.Request.Context(), teamNameParam+" is empty")
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
 		return
 	}
 
-	log.Info(teamNameParam+" param decoded", slog.Any(teamNameParam, teamName))
+	log.InfoContext(c.Request.Context(), teamNameParam+" param decoded", slog.Any(teamNameParam, teamName))
 
 	team, err := h.teamService.GetTeam(c.Request.Context(), teamName)
 	if errors.Is(err, service.ErrTeamNotFound) {
-		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(handlers.ErrCodeResourceNotFound))
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
 		return
 	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(handlers.ErrCodeUnknown))
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
 		return
 	}
 
 	c.JSON(http.StatusOK, domainToGetTeamResponse(team))
 }
+
+func (h *TeamHandler) AddRule(c *gin.Context) {
+	const op = "handlers.teams.AddRule"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := h.bindRuleRequest(c, log)
+	if !ok {
+		return
+	}
+
+	rule := ruleToDomain(req)
+
+	err := h.teamService.AddRule(c.Request.Context(), req.TeamName, rule)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrTeamRuleInvalid) {
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleInvalid, ruleInvalidReason(err)),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrTeamRuleAlreadyExists) {
+		c.JSON(http.StatusConflict, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleExists))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusCreated, domainToTeamRuleResponse(rule))
+}
+
+func (h *TeamHandler) RemoveRule(c *gin.Context) {
+	const op = "handlers.teams.RemoveRule"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := h.bindRuleRequest(c, log)
+	if !ok {
+		return
+	}
+
+	rule := ruleToDomain(req)
+
+	err := h.teamService.RemoveRule(c.Request.Context(), req.TeamName, rule)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrTeamRuleInvalid) {
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleInvalid, ruleInvalidReason(err)),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrTeamRuleNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToTeamRuleResponse(rule))
+}
+
+func (h *TeamHandler) GetRules(c *gin.Context) {
+	const op = "handlers.teams.GetRules"
+	log := h.log.With(slog.String("op", op))
+
+	const teamNameParam = "team_name"
+
+	teamName := c.Query(teamNameParam)
+	if teamName == "" {
+		log.WarnContext(c.Request.Context(), teamNameParam+" is empty or not found in query params")
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), teamNameParam+" param decoded", slog.Any(teamNameParam, teamName))
+
+	rules, err := h.teamService.GetRules(c.Request.Context(), teamName)
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToGetTeamRulesResponse(teamName, rules))
+}
+
+func (h *TeamHandler) bindRuleRequest(c *gin.Context, log *slog.Logger) (*TeamRuleRequest, bool) {
+	return bindRequest[TeamRuleRequest](c, log)
+}
+
+func bindRequest[T any](c *gin.Context, log *slog.Logger) (*T, bool) {
+	var req T
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
+		return nil, false
+	}
+
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
+
+	if err := validate.Struct(req); err != nil {
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
+
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return nil, false
+	}
+
+	return &req, true
+}
+
+func ruleInvalidReason(err error) string {
+	if errors.Is(err, domain.ErrRuleKindUnknown) {
+		return domain.ErrRuleKindUnknown.Error()
+	}
+	if errors.Is(err, domain.ErrRuleMemberNotInTeam) {
+		return domain.ErrRuleMemberNotInTeam.Error()
+	}
+	if errors.Is(err, domain.ErrReviewRuleInvalid) {
+		return domain.ErrReviewRuleInvalid.Error()
+	}
+	if errors.Is(err, domain.ErrRequiredGroupInvalid) {
+		return domain.ErrRequiredGroupInvalid.Error()
+	}
+
+	return domain.ErrRuleInvalid.Error()
+}
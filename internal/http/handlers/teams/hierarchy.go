@@ -0,0 +1,77 @@
+package teams
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *TeamHandler) SetParent(c *gin.Context) {
+	const op = "handlers.teams.SetParent"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[SetParentRequest](c, log)
+	if !ok {
+		return
+	}
+
+	team, err := h.teamService.SetParent(c.Request.Context(), req.TeamName, req.ParentTeamName)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrTeamCycle) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamCycle, req.TeamName),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToSetParentResponse(team))
+}
+
+// GetTree returns the whole team hierarchy, or the subtree under the team_name query parameter.
+func (h *TeamHandler) GetTree(c *gin.Context) {
+	const op = "handlers.teams.GetTree"
+	log := h.log.With(slog.String("op", op))
+
+	const teamNameParam = "team_name"
+
+	var rootName *string
+	if teamName, ok := c.GetQuery(teamNameParam); ok {
+		if teamName == "" {
+			log.WarnContext(c.Request.Context(), teamNameParam+" is empty")
+			c.JSON(
+				http.StatusBadRequest,
+				handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam),
+			)
+			return
+		}
+		rootName = &teamName
+	}
+
+	tree, err := h.teamService.GetTree(c.Request.Context(), rootName)
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToTeamTreeResponse(tree))
+}
@@ -0,0 +1,203 @@
+package teams
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/domain/pullrequests/rules"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *TeamHandler) AddMember(c *gin.Context) {
+	const op = "handlers.teams.AddMember"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[AddMemberRequest](c, log)
+	if !ok {
+		return
+	}
+
+	member := memberToDomain(&req.MemberRequest)
+
+	team, err := h.teamService.AddMember(c.Request.Context(), req.TeamName, &member)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrTeamMemberAlreadyExists) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamMemberExists, req.UserID),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusCreated, domainToUpdateTeamResponse(team))
+}
+
+func (h *TeamHandler) RemoveMember(c *gin.Context) {
+	const op = "handlers.teams.RemoveMember"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[RemoveMemberRequest](c, log)
+	if !ok {
+		return
+	}
+
+	team, reassignments, err := h.teamService.RemoveMember(
+		c.Request.Context(),
+		req.TeamName,
+		req.UserID,
+		teamsDomain.OpenReviews(req.OpenReviews),
+	)
+	if err != nil {
+		respondMoveMemberError(c, err, req.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToMoveMemberResponse(team, reassignments))
+}
+
+func (h *TeamHandler) MoveMember(c *gin.Context) {
+	const op = "handlers.teams.MoveMember"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[MoveMemberRequest](c, log)
+	if !ok {
+		return
+	}
+
+	team, reassignments, err := h.teamService.MoveMember(
+		c.Request.Context(),
+		req.UserID,
+		req.TeamName,
+		req.ToTeamName,
+		teamsDomain.OpenReviews(req.OpenReviews),
+	)
+	if err != nil {
+		respondMoveMemberError(c, err, req.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToMoveMemberResponse(team, reassignments))
+}
+
+func respondMoveMemberError(c *gin.Context, err error, userID string) {
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) || errors.Is(err, service.ErrTeamMemberNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrTeamMemberAlreadyExists) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamMemberExists, userID),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrPullRequestNoCandidates) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodePullRequestNoCandidate),
+		)
+		return
+	}
+	var violationErr *rules.ViolationError
+	if errors.As(err, &violationErr) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleViolation, violationErr.Error()),
+		)
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+}
+
+func (h *TeamHandler) RenameTeam(c *gin.Context) {
+	const op = "handlers.teams.RenameTeam"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[RenameTeamRequest](c, log)
+	if !ok {
+		return
+	}
+
+	team, err := h.teamService.RenameTeam(c.Request.Context(), req.TeamName, req.NewTeamName)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrTeamAlreadyExists) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamExists, req.NewTeamName),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToUpdateTeamResponse(team))
+}
+
+func (h *TeamHandler) DeleteTeam(c *gin.Context) {
+	const op = "handlers.teams.DeleteTeam"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[DeleteTeamRequest](c, log)
+	if !ok {
+		return
+	}
+
+	err := h.teamService.DeleteTeam(c.Request.Context(), req.TeamName)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotEmpty) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamNotEmpty, req.TeamName),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrTeamHasSubteams) {
+		c.JSON(
+			http.StatusConflict,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamHasSubteams, req.TeamName),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteTeamResponse{TeamName: req.TeamName})
+}
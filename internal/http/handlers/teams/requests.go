@@ -28,3 +28,93 @@ func memberToDomain(member *MemberRequest) teamsDomain.Member {
 		IsActive: *member.IsActive,
 	}
 }
+
+type TeamRuleRequest struct {
+	TeamName    string `json:"team_name"     validate:"required"`
+	Kind        string `json:"kind"          validate:"required,oneof=CONFLICT_OF_INTEREST PAIRING"`
+	UserID      string `json:"user_id"       validate:"required"`
+	OtherUserID string `json:"other_user_id" validate:"required"`
+}
+
+func ruleToDomain(rule *TeamRuleRequest) *teamsDomain.Rule {
+	return &teamsDomain.Rule{
+		Kind:        teamsDomain.RuleKind(rule.Kind),
+		UserID:      rule.UserID,
+		OtherUserID: rule.OtherUserID,
+	}
+}
+
+type ReviewRuleRequest struct {
+	TeamName        string `json:"team_name"         validate:"required"`
+	MinLinesChanged int    `json:"min_lines_changed" validate:"min=0"`
+	Label           string `json:"label"             validate:"max=64"`
+	ReviewersCount  int    `json:"reviewers_count"   validate:"min=0"`
+	ExtraTeamName   string `json:"extra_team_name"`
+}
+
+func reviewRuleToDomain(rule *ReviewRuleRequest) *teamsDomain.ReviewRule {
+	return &teamsDomain.ReviewRule{
+		MinLinesChanged: rule.MinLinesChanged,
+		Label:           rule.Label,
+		ReviewersCount:  rule.ReviewersCount,
+		ExtraTeamName:   rule.ExtraTeamName,
+	}
+}
+
+// RequiredGroupRequest makes the team review every PR carrying one of Labels
+// or touching a path under one of PathPrefixes.
+type RequiredGroupRequest struct {
+	TeamName     string   `json:"team_name"     validate:"required"`
+	Labels       []string `json:"labels"        validate:"dive,required,max=64"`
+	PathPrefixes []string `json:"path_prefixes" validate:"dive,required,max=256"`
+}
+
+func requiredGroupToDomain(group *RequiredGroupRequest) *teamsDomain.RequiredGroup {
+	return &teamsDomain.RequiredGroup{
+		TeamName:     group.TeamName,
+		Labels:       group.Labels,
+		PathPrefixes: group.PathPrefixes,
+	}
+}
+
+type RemoveRequiredGroupRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+}
+
+type AddMemberRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+	MemberRequest
+}
+
+type RemoveMemberRequest struct {
+	TeamName    string `json:"team_name"    validate:"required"`
+	UserID      string `json:"user_id"      validate:"required"`
+	OpenReviews string `json:"open_reviews" validate:"required,oneof=KEEP REASSIGN"`
+}
+
+type MoveMemberRequest struct {
+	UserID      string `json:"user_id"      validate:"required"`
+	TeamName    string `json:"team_name"    validate:"required"`
+	ToTeamName  string `json:"to_team_name" validate:"required"`
+	OpenReviews string `json:"open_reviews" validate:"required,oneof=KEEP REASSIGN"`
+}
+
+type RenameTeamRequest struct {
+	TeamName    string `json:"team_name"     validate:"required"`
+	NewTeamName string `json:"new_team_name" validate:"required"`
+}
+
+type DeleteTeamRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+}
+
+type SyncTeamRequest struct {
+	TeamName string          `json:"team_name" validate:"required"`
+	Members  []MemberRequest `json:"members"   validate:"required,unique=UserID,dive"`
+}
+
+// SetParentRequest nests the team under ParentTeamName, a null parent makes it a top-level team.
+type SetParentRequest struct {
+	TeamName       string  `json:"team_name"        validate:"required"`
+	ParentTeamName *string `json:"parent_team_name" validate:"omitempty,min=1"`
+}
@@ -0,0 +1,82 @@
+package teams
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *TeamHandler) SetRequiredGroup(c *gin.Context) {
+	const op = "handlers.teams.SetRequiredGroup"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[RequiredGroupRequest](c, log)
+	if !ok {
+		return
+	}
+
+	group := requiredGroupToDomain(req)
+
+	err := h.teamService.SetRequiredGroup(c.Request.Context(), group)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrRequiredGroupInvalid) {
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleInvalid, ruleInvalidReason(err)),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToTeamRequiredGroupResponse(group))
+}
+
+func (h *TeamHandler) RemoveRequiredGroup(c *gin.Context) {
+	const op = "handlers.teams.RemoveRequiredGroup"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[RemoveRequiredGroupRequest](c, log)
+	if !ok {
+		return
+	}
+
+	err := h.teamService.RemoveRequiredGroup(c.Request.Context(), req.TeamName)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrRequiredGroupNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, RemoveRequiredGroupResponse{TeamName: req.TeamName})
+}
+
+func (h *TeamHandler) GetRequiredGroups(c *gin.Context) {
+	groups, err := h.teamService.GetRequiredGroups(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToGetRequiredGroupsResponse(groups))
+}
@@ -1,13 +1,25 @@
 package teams
 
-import teamsDomain "reviewer-assigner/internal/domain/teams"
+import (
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+)
 
 type AddTeamResponse struct {
 	TeamResponse `json:"team"`
 }
 
 type GetTeamResponse struct {
-	TeamResponse
+	TeamName string `json:"team_name"`
+	// ParentTeamName is left out for top-level teams.
+	ParentTeamName *string                 `json:"parent_team_name,omitempty"`
+	Members        []GetTeamMemberResponse `json:"members"`
+}
+
+// GetTeamMemberResponse marks the members whose primary team is the requested one.
+type GetTeamMemberResponse struct {
+	MemberResponse
+	IsPrimary bool `json:"is_primary"`
 }
 
 type TeamResponse struct {
@@ -28,23 +40,332 @@ func domainToAddTeamResponse(team *teamsDomain.Team) *AddTeamResponse {
 }
 
 func domainToGetTeamResponse(team *teamsDomain.Team) *GetTeamResponse {
-	return &GetTeamResponse{
-		*domainToTeamResponse(team),
+	members := make([]GetTeamMemberResponse, 0, len(team.Members))
+	for _, member := range team.Members {
+		members = append(members, GetTeamMemberResponse{
+			MemberResponse: MemberResponse{
+				ID:       member.ID,
+				Name:     member.Name,
+				IsActive: member.IsActive,
+			},
+			IsPrimary: member.IsPrimary,
+		})
+	}
+
+	response := &GetTeamResponse{
+		TeamName: team.Name,
+		Members:  members,
 	}
+	if team.ParentName != "" {
+		response.ParentTeamName = &team.ParentName
+	}
+
+	return response
 }
 
 func domainToTeamResponse(team *teamsDomain.Team) *TeamResponse {
-	members := make([]MemberResponse, 0, len(team.Members))
-	for _, member := range team.Members {
-		members = append(members, MemberResponse{
+	return &TeamResponse{
+		TeamName: team.Name,
+		Members:  domainToMembersResponse(team.Members),
+	}
+}
+
+func domainToMembersResponse(members []teamsDomain.Member) []MemberResponse {
+	membersResponse := make([]MemberResponse, 0, len(members))
+	for _, member := range members {
+		membersResponse = append(membersResponse, MemberResponse{
 			ID:       member.ID,
 			Name:     member.Name,
 			IsActive: member.IsActive,
 		})
 	}
 
-	return &TeamResponse{
-		TeamName: team.Name,
-		Members:  members,
+	return membersResponse
+}
+
+type UpdateTeamResponse struct {
+	TeamResponse `json:"team"`
+}
+
+// MoveMemberResponse holds the team left for removals and the team joined for moves.
+type MoveMemberResponse struct {
+	TeamResponse  `json:"team"`
+	Reassignments []ReassignmentResponse `json:"reassignments"`
+}
+
+type ReassignmentResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+type DeleteTeamResponse struct {
+	TeamName string `json:"team_name"`
+}
+
+func domainToUpdateTeamResponse(team *teamsDomain.Team) *UpdateTeamResponse {
+	return &UpdateTeamResponse{
+		*domainToTeamResponse(team),
+	}
+}
+
+func domainToMoveMemberResponse(
+	team *teamsDomain.Team,
+	reassignments []prsDomain.Reassignment,
+) *MoveMemberResponse {
+	return &MoveMemberResponse{
+		TeamResponse:  *domainToTeamResponse(team),
+		Reassignments: domainToReassignmentsResponse(reassignments),
+	}
+}
+
+func domainToReassignmentsResponse(reassignments []prsDomain.Reassignment) []ReassignmentResponse {
+	reassignmentsResponse := make([]ReassignmentResponse, 0, len(reassignments))
+	for _, r := range reassignments {
+		reassignmentsResponse = append(reassignmentsResponse, ReassignmentResponse{
+			PullRequestID: r.PullRequestID,
+			OldReviewerID: r.OldReviewerID,
+			NewReviewerID: r.NewReviewerID,
+		})
+	}
+
+	return reassignmentsResponse
+}
+
+type TeamRuleResponse struct {
+	RuleResponse `json:"rule"`
+}
+
+type GetTeamRulesResponse struct {
+	TeamName string         `json:"team_name"`
+	Rules    []RuleResponse `json:"rules"`
+}
+
+type RuleResponse struct {
+	Kind        string `json:"kind"`
+	UserID      string `json:"user_id"`
+	OtherUserID string `json:"other_user_id"`
+}
+
+func domainToTeamRuleResponse(rule *teamsDomain.Rule) *TeamRuleResponse {
+	return &TeamRuleResponse{
+		RuleResponse: domainToRuleResponse(rule),
+	}
+}
+
+func domainToGetTeamRulesResponse(teamName string, rules []teamsDomain.Rule) *GetTeamRulesResponse {
+	rulesResponse := make([]RuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		rulesResponse = append(rulesResponse, domainToRuleResponse(&rule))
+	}
+
+	return &GetTeamRulesResponse{
+		TeamName: teamName,
+		Rules:    rulesResponse,
+	}
+}
+
+func domainToRuleResponse(rule *teamsDomain.Rule) RuleResponse {
+	return RuleResponse{
+		Kind:        string(rule.Kind),
+		UserID:      rule.UserID,
+		OtherUserID: rule.OtherUserID,
+	}
+}
+
+type TeamReviewRuleResponse struct {
+	ReviewRuleResponse `json:"rule"`
+}
+
+type GetTeamReviewRulesResponse struct {
+	TeamName string               `json:"team_name"`
+	Rules    []ReviewRuleResponse `json:"rules"`
+}
+
+type ReviewRuleResponse struct {
+	MinLinesChanged int    `json:"min_lines_changed"`
+	Label           string `json:"label"`
+	ReviewersCount  int    `json:"reviewers_count"`
+	ExtraTeamName   string `json:"extra_team_name"`
+}
+
+func domainToTeamReviewRuleResponse(rule *teamsDomain.ReviewRule) *TeamReviewRuleResponse {
+	return &TeamReviewRuleResponse{
+		ReviewRuleResponse: domainToReviewRuleResponse(rule),
 	}
 }
+
+func domainToGetTeamReviewRulesResponse(
+	teamName string,
+	rules []teamsDomain.ReviewRule,
+) *GetTeamReviewRulesResponse {
+	rulesResponse := make([]ReviewRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		rulesResponse = append(rulesResponse, domainToReviewRuleResponse(&rule))
+	}
+
+	return &GetTeamReviewRulesResponse{
+		TeamName: teamName,
+		Rules:    rulesResponse,
+	}
+}
+
+func domainToReviewRuleResponse(rule *teamsDomain.ReviewRule) ReviewRuleResponse {
+	return ReviewRuleResponse{
+		MinLinesChanged: rule.MinLinesChanged,
+		Label:           rule.Label,
+		ReviewersCount:  rule.ReviewersCount,
+		ExtraTeamName:   rule.ExtraTeamName,
+	}
+}
+
+type TeamRequiredGroupResponse struct {
+	RequiredGroupResponse `json:"group"`
+}
+
+type RemoveRequiredGroupResponse struct {
+	TeamName string `json:"team_name"`
+}
+
+type GetRequiredGroupsResponse struct {
+	Groups []RequiredGroupResponse `json:"groups"`
+}
+
+type RequiredGroupResponse struct {
+	TeamName     string   `json:"team_name"`
+	Labels       []string `json:"labels"`
+	PathPrefixes []string `json:"path_prefixes"`
+}
+
+func domainToTeamRequiredGroupResponse(group *teamsDomain.RequiredGroup) *TeamRequiredGroupResponse {
+	return &TeamRequiredGroupResponse{
+		RequiredGroupResponse: domainToRequiredGroupResponse(group),
+	}
+}
+
+func domainToGetRequiredGroupsResponse(groups []teamsDomain.RequiredGroup) *GetRequiredGroupsResponse {
+	groupsResponse := make([]RequiredGroupResponse, 0, len(groups))
+	for _, group := range groups {
+		groupsResponse = append(groupsResponse, domainToRequiredGroupResponse(&group))
+	}
+
+	return &GetRequiredGroupsResponse{Groups: groupsResponse}
+}
+
+func domainToRequiredGroupResponse(group *teamsDomain.RequiredGroup) RequiredGroupResponse {
+	labels := group.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	pathPrefixes := group.PathPrefixes
+	if pathPrefixes == nil {
+		pathPrefixes = []string{}
+	}
+
+	return RequiredGroupResponse{
+		TeamName:     group.TeamName,
+		Labels:       labels,
+		PathPrefixes: pathPrefixes,
+	}
+}
+
+type SyncTeamResponse struct {
+	DryRun        bool                   `json:"dry_run"`
+	Diff          MembersDiffResponse    `json:"diff"`
+	Team          TeamResponse           `json:"team"`
+	Reassignments []ReassignmentResponse `json:"reassignments"`
+}
+
+type MembersDiffResponse struct {
+	Added           []MemberResponse         `json:"added"`
+	Removed         []MemberResponse         `json:"removed"`
+	Renamed         []RenamedMemberResponse  `json:"renamed"`
+	ActivityChanged []ActivityChangeResponse `json:"activity_changed"`
+}
+
+type RenamedMemberResponse struct {
+	ID      string `json:"user_id"`
+	OldName string `json:"old_username"`
+	NewName string `json:"new_username"`
+}
+
+type ActivityChangeResponse struct {
+	ID       string `json:"user_id"`
+	IsActive bool   `json:"is_active"`
+}
+
+// domainToSyncTeamResponse shows the team as it is after the sync, a dry run shows it as it would be.
+func domainToSyncTeamResponse(
+	team *teamsDomain.Team,
+	diff *teamsDomain.MembersDiff,
+	reassignments []prsDomain.Reassignment,
+	dryRun bool,
+) *SyncTeamResponse {
+	diffResponse := MembersDiffResponse{
+		Added:           domainToMembersResponse(diff.Added),
+		Removed:         domainToMembersResponse(diff.Removed),
+		Renamed:         make([]RenamedMemberResponse, 0, len(diff.Renamed)),
+		ActivityChanged: make([]ActivityChangeResponse, 0, len(diff.ActivityChanged)),
+	}
+	for _, change := range diff.Renamed {
+		diffResponse.Renamed = append(diffResponse.Renamed, RenamedMemberResponse{
+			ID:      change.After.ID,
+			OldName: change.Before.Name,
+			NewName: change.After.Name,
+		})
+	}
+	for _, change := range diff.ActivityChanged {
+		diffResponse.ActivityChanged = append(diffResponse.ActivityChanged, ActivityChangeResponse{
+			ID:       change.After.ID,
+			IsActive: change.After.IsActive,
+		})
+	}
+
+	return &SyncTeamResponse{
+		DryRun:        dryRun,
+		Diff:          diffResponse,
+		Team:          *domainToTeamResponse(team),
+		Reassignments: domainToReassignmentsResponse(reassignments),
+	}
+}
+
+type SetParentResponse struct {
+	TeamName       string  `json:"team_name"`
+	ParentTeamName *string `json:"parent_team_name"`
+}
+
+func domainToSetParentResponse(team *teamsDomain.Team) *SetParentResponse {
+	response := &SetParentResponse{TeamName: team.Name}
+	if team.ParentName != "" {
+		response.ParentTeamName = &team.ParentName
+	}
+
+	return response
+}
+
+type TeamTreeResponse struct {
+	Teams []TeamTreeNodeResponse `json:"teams"`
+}
+
+type TeamTreeNodeResponse struct {
+	TeamName     string                 `json:"team_name"`
+	MembersCount int                    `json:"members_count"`
+	Children     []TeamTreeNodeResponse `json:"children"`
+}
+
+func domainToTeamTreeResponse(tree []teamsDomain.TreeNode) *TeamTreeResponse {
+	return &TeamTreeResponse{Teams: domainToTeamTreeNodesResponse(tree)}
+}
+
+func domainToTeamTreeNodesResponse(nodes []teamsDomain.TreeNode) []TeamTreeNodeResponse {
+	response := make([]TeamTreeNodeResponse, 0, len(nodes))
+	for _, node := range nodes {
+		response = append(response, TeamTreeNodeResponse{
+			TeamName:     node.Name,
+			MembersCount: node.MembersCount,
+			Children:     domainToTeamTreeNodesResponse(node.Children),
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,106 @@
+package teams
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *TeamHandler) AddReviewRule(c *gin.Context) {
+	const op = "handlers.teams.AddReviewRule"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[ReviewRuleRequest](c, log)
+	if !ok {
+		return
+	}
+
+	rule := reviewRuleToDomain(req)
+
+	err := h.teamService.AddReviewRule(c.Request.Context(), req.TeamName, rule)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if errors.Is(err, service.ErrTeamRuleInvalid) {
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleInvalid, ruleInvalidReason(err)),
+		)
+		return
+	}
+	if errors.Is(err, service.ErrTeamRuleAlreadyExists) {
+		c.JSON(http.StatusConflict, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeTeamRuleExists))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusCreated, domainToTeamReviewRuleResponse(rule))
+}
+
+func (h *TeamHandler) RemoveReviewRule(c *gin.Context) {
+	const op = "handlers.teams.RemoveReviewRule"
+	log := h.log.With(slog.String("op", op))
+
+	req, ok := bindRequest[ReviewRuleRequest](c, log)
+	if !ok {
+		return
+	}
+
+	rule := reviewRuleToDomain(req)
+
+	err := h.teamService.RemoveReviewRule(c.Request.Context(), req.TeamName, rule)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTeamRuleNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToTeamReviewRuleResponse(rule))
+}
+
+func (h *TeamHandler) GetReviewRules(c *gin.Context) {
+	const op = "handlers.teams.GetReviewRules"
+	log := h.log.With(slog.String("op", op))
+
+	const teamNameParam = "team_name"
+
+	teamName := c.Query(teamNameParam)
+	if teamName == "" {
+		log.WarnContext(c.Request.Context(), teamNameParam+" is empty or not found in query params")
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	log.InfoContext(c.Request.Context(), teamNameParam+" param decoded", slog.Any(teamNameParam, teamName))
+
+	rules, err := h.teamService.GetReviewRules(c.Request.Context(), teamName)
+	if errors.Is(err, service.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToGetTeamReviewRulesResponse(teamName, rules))
+}
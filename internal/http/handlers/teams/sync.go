@@ -0,0 +1,57 @@
+package teams
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *TeamHandler) SyncTeam(c *gin.Context) {
+	const op = "handlers.teams.SyncTeam"
+	log := h.log.With(slog.String("op", op))
+
+	const dryRunParam = "dry_run"
+
+	dryRun := false
+	if value, ok := c.GetQuery(dryRunParam); ok {
+		var err error
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			log.WarnContext(c.Request.Context(), dryRunParam+" is not a boolean", logger.ErrAttr(err))
+			c.JSON(
+				http.StatusBadRequest,
+				handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam),
+			)
+			return
+		}
+	}
+
+	req, ok := bindRequest[SyncTeamRequest](c, log)
+	if !ok {
+		return
+	}
+
+	team, diff, reassignments, err := h.teamService.SyncTeam(
+		c.Request.Context(),
+		req.TeamName,
+		membersToDomain(req.Members),
+		dryRun,
+	)
+	var memberErr *service.MemberError
+	if errors.As(err, &memberErr) {
+		respondMoveMemberError(c, memberErr.Err, memberErr.UserID)
+		return
+	}
+	if err != nil {
+		respondMoveMemberError(c, err, "")
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToSyncTeamResponse(team, diff, reassignments, dryRun))
+}
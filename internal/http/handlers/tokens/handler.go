@@ -0,0 +1,120 @@
+package tokens
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"reviewer-assigner/internal/domain/access"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/service/tokens"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = validator.New()
+
+type TokenHandler struct {
+	tokenService *tokens.TokenService
+	log          *slog.Logger
+}
+
+func NewTokenHandler(log *slog.Logger, tokenService *tokens.TokenService) *TokenHandler {
+	return &TokenHandler{
+		log:          log,
+		tokenService: tokenService,
+	}
+}
+
+func (h *TokenHandler) Issue(c *gin.Context) {
+	const op = "handlers.tokens.Issue"
+	log := h.log.With(slog.String("op", op))
+
+	var req IssueTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
+		return
+	}
+
+	if err := validate.Struct(req); err != nil {
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
+
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return
+	}
+
+	scopes := make([]access.Scope, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, access.Scope(scope))
+	}
+
+	role := access.RoleMember
+	if req.Role != "" {
+		role = access.Role(req.Role)
+	}
+
+	token, plain, err := h.tokenService.Issue(c.Request.Context(), req.Actor, role, scopes)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTokenInvalid) {
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusCreated, domainToIssueTokenResponse(token, plain))
+}
+
+func (h *TokenHandler) Revoke(c *gin.Context) {
+	const op = "handlers.tokens.Revoke"
+	log := h.log.With(slog.String("op", op))
+
+	var req RevokeTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
+		return
+	}
+
+	if err := validate.Struct(req); err != nil {
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
+
+		c.JSON(
+			http.StatusUnprocessableEntity,
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
+		)
+		return
+	}
+
+	err := h.tokenService.Revoke(c.Request.Context(), req.TokenID)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
+	if errors.Is(err, service.ErrTokenNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, &RevokeTokenResponse{TokenID: req.TokenID})
+}
@@ -0,0 +1,12 @@
+package tokens
+
+type IssueTokenRequest struct {
+	Actor string `json:"actor"  validate:"required,max=64"`
+	// Role defaults to member.
+	Role   string   `json:"role"   validate:"omitempty,oneof=admin team_admin member"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,required"`
+}
+
+type RevokeTokenRequest struct {
+	TokenID string `json:"token_id" validate:"required"`
+}
@@ -0,0 +1,41 @@
+package tokens
+
+import (
+	tokensDomain "reviewer-assigner/internal/domain/tokens"
+	"time"
+)
+
+type IssueTokenResponse struct {
+	TokenResponse `json:"token"`
+}
+
+type TokenResponse struct {
+	TokenID   string    `json:"token_id"`
+	Token     string    `json:"token"`
+	Actor     string    `json:"actor"`
+	Role      string    `json:"role"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RevokeTokenResponse struct {
+	TokenID string `json:"token_id"`
+}
+
+func domainToIssueTokenResponse(token *tokensDomain.Token, plain string) *IssueTokenResponse {
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	return &IssueTokenResponse{
+		TokenResponse: TokenResponse{
+			TokenID:   token.ID,
+			Token:     plain,
+			Actor:     token.Actor,
+			Role:      string(token.Role),
+			Scopes:    scopes,
+			CreatedAt: token.CreatedAt,
+		},
+	}
+}
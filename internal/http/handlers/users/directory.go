@@ -0,0 +1,124 @@
+package users
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	usersDomain "reviewer-assigner/internal/domain/users"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	teamNameParam = "team_name"
+	isActiveParam = "is_active"
+	queryParam    = "query"
+
+	defaultLimit = 20
+)
+
+func (h *UserHandler) GetUser(c *gin.Context) {
+	const op = "handlers.users.GetUser"
+	log := h.log.With(slog.String("op", op))
+
+	const userIDParam = "user_id"
+
+	userID := c.Query(userIDParam)
+	if userID == "" {
+		log.WarnContext(c.Request.Context(), userIDParam+" is missing or empty")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	user, err := h.userService.GetUser(c.Request.Context(), userID)
+	if errors.Is(err, service.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, GetUserResponse{UserResponse: *domainToUserResponse(user)})
+}
+
+// ListUsers pages through the user directory filtered by team and activity.
+func (h *UserHandler) ListUsers(c *gin.Context) {
+	const op = "handlers.users.ListUsers"
+	log := h.log.With(slog.String("op", op))
+
+	filter, ok := parseFilter(c)
+	if !ok {
+		log.WarnContext(c.Request.Context(), "invalid filter")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	h.listUsers(c, log, filter)
+}
+
+// SearchUsers is ListUsers narrowed to the users whose name starts with the query param.
+func (h *UserHandler) SearchUsers(c *gin.Context) {
+	const op = "handlers.users.SearchUsers"
+	log := h.log.With(slog.String("op", op))
+
+	filter, ok := parseFilter(c)
+	if !ok {
+		log.WarnContext(c.Request.Context(), "invalid filter")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	filter.NamePrefix = c.Query(queryParam)
+	if filter.NamePrefix == "" {
+		log.WarnContext(c.Request.Context(), queryParam+" is missing or empty")
+
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
+		return
+	}
+
+	h.listUsers(c, log, filter)
+}
+
+func (h *UserHandler) listUsers(c *gin.Context, log *slog.Logger, filter *usersDomain.Filter) {
+	log.InfoContext(c.Request.Context(), "query param decoded", slog.Any("filter", filter))
+
+	users, total, err := h.userService.ListUsers(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
+		return
+	}
+
+	c.JSON(http.StatusOK, domainToListUsersResponse(filter, users, total))
+}
+
+// parseFilter reads the team, activity and pagination query params shared by listing and search.
+func parseFilter(c *gin.Context) (*usersDomain.Filter, bool) {
+	page, ok := handlers.ParsePage(c, defaultLimit)
+	if !ok {
+		return nil, false
+	}
+
+	filter := &usersDomain.Filter{
+		TeamName: c.Query(teamNameParam),
+		Limit:    page.Limit,
+		Offset:   page.Offset,
+	}
+
+	if raw, ok := c.GetQuery(isActiveParam); ok {
+		isActive, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, false
+		}
+		filter.IsActive = &isActive
+	}
+
+	return filter, true
+}
@@ -33,31 +33,35 @@ func (h *UserHandler) SetIsActive(c *gin.Context) {
 
 	var req SetIsActiveRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Warn("invalid json body", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "invalid json body", logger.ErrAttr(err))
 
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidJSON))
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidJSON))
 		return
 	}
 
-	log.Info("request decoded", slog.Any("request", req))
+	log.InfoContext(c.Request.Context(), "request decoded", slog.Any("request", req))
 
 	if err := validate.Struct(req); err != nil {
-		log.Warn("validation error", logger.ErrAttr(err))
+		log.WarnContext(c.Request.Context(), "validation error", logger.ErrAttr(err))
 
 		c.JSON(
 			http.StatusUnprocessableEntity,
-			handlers.NewErrorResponse(handlers.ErrCodeInvalidBody),
+			handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidBody),
 		)
 		return
 	}
 
 	user, err := h.userService.SetIsActive(c.Request.Context(), req.UserID, *req.IsActive)
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeForbidden))
+		return
+	}
 	if errors.Is(err, service.ErrUserNotFound) {
-		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(handlers.ErrCodeResourceNotFound))
+		c.JSON(http.StatusNotFound, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeResourceNotFound))
 		return
 	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(handlers.ErrCodeUnknown))
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
 		return
 	}
 
@@ -72,23 +76,23 @@ func (h *UserHandler) GetReview(c *gin.Context) {
 
 	userID, ok := c.GetQuery(userIDParam)
 	if !ok {
-		log.Warn(userIDParam + " not found in query params")
+		log.WarnContext(c.Request.Context(), userIDParam+" not found in query params")
 
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidQueryParam))
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
 		return
 	}
 	if userID == "" {
-		log.Warn(userIDParam + " is empty")
+		log.WarnContext(c.Request.Context(), userIDParam+" is empty")
 
-		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(handlers.ErrCodeInvalidQueryParam))
+		c.JSON(http.StatusBadRequest, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeInvalidQueryParam))
 		return
 	}
 
-	log.Info(userIDParam+" param decoded", slog.Any(userIDParam, userID))
+	log.InfoContext(c.Request.Context(), userIDParam+" param decoded", slog.Any(userIDParam, userID))
 
 	pullRequests, err := h.userService.GetReview(c.Request.Context(), userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(handlers.ErrCodeUnknown))
+		c.JSON(http.StatusInternalServerError, handlers.NewErrorResponse(c.Request.Context(), handlers.ErrCodeUnknown))
 		return
 	}
 
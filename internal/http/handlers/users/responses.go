@@ -9,11 +9,25 @@ type SetIsActiveResponse struct {
 	UserResponse `json:"user"`
 }
 
+// UserResponse names the primary team in team_name and every team of the user in team_names.
 type UserResponse struct {
-	UserID   string `json:"user_id"`
-	Name     string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	UserID      string   `json:"user_id"`
+	Name        string   `json:"username"`
+	TeamName    string   `json:"team_name"`
+	TeamNames   []string `json:"team_names"`
+	IsActive    bool     `json:"is_active"`
+	OpenReviews int      `json:"open_reviews"`
+}
+
+type GetUserResponse struct {
+	UserResponse `json:"user"`
+}
+
+type ListUsersResponse struct {
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+	Total  int            `json:"total"`
+	Users  []UserResponse `json:"users"`
 }
 
 type GetReviewResponse struct {
@@ -25,6 +39,7 @@ type PullRequestResponse struct {
 	ID       string `json:"pull_request_id"`
 	Name     string `json:"pull_request_name"`
 	AuthorID string `json:"author_id"`
+	TeamName string `json:"team_name"`
 	Status   string `json:"status"`
 }
 
@@ -41,6 +56,7 @@ func domainToGetReviewResponse(userID string, prs []prsDomain.PullRequestShort)
 			ID:       pr.ID,
 			Name:     pr.Name,
 			AuthorID: pr.AuthorID,
+			TeamName: pr.TeamName,
 			Status:   string(pr.Status),
 		})
 	}
@@ -53,9 +69,29 @@ func domainToGetReviewResponse(userID string, prs []prsDomain.PullRequestShort)
 
 func domainToUserResponse(u *usersDomain.User) *UserResponse {
 	return &UserResponse{
-		UserID:   u.ID,
-		Name:     u.Name,
-		TeamName: u.TeamName,
-		IsActive: u.IsActive,
+		UserID:      u.ID,
+		Name:        u.Name,
+		TeamName:    u.TeamName,
+		TeamNames:   u.TeamNames,
+		IsActive:    u.IsActive,
+		OpenReviews: u.OpenReviews,
+	}
+}
+
+func domainToListUsersResponse(
+	filter *usersDomain.Filter,
+	users []usersDomain.User,
+	total int,
+) *ListUsersResponse {
+	usersResponse := make([]UserResponse, 0, len(users))
+	for _, user := range users {
+		usersResponse = append(usersResponse, *domainToUserResponse(&user))
+	}
+
+	return &ListUsersResponse{
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+		Total:  total,
+		Users:  usersResponse,
 	}
 }
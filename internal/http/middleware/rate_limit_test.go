@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reviewer-assigner/internal/config"
+	"reviewer-assigner/internal/domain/access"
+	"reviewer-assigner/internal/http/handlers"
+	"reviewer-assigner/internal/ratelimit"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingLimiter struct{}
+
+func (failingLimiter) Take(context.Context, string, ratelimit.Rule) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("connection refused")
+}
+
+func newRateLimitRouter(limiter ratelimit.Limiter) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.RateLimit{
+		Default: config.RateLimitRule{RPS: 0},
+		Groups: map[string]config.RateLimitRule{
+			"pullRequest": {RPS: 0.5, Burst: 2},
+		},
+	}
+	l := NewRateLimiter(slog.New(slog.NewTextHandler(io.Discard, nil)), limiter, cfg)
+
+	r := gin.New()
+	// as NewRouter does without configured proxies
+	_ = r.SetTrustedProxies(nil)
+	r.Use(func(c *gin.Context) {
+		if actorID := c.GetHeader("X-Actor"); actorID != "" {
+			c.Request = c.Request.WithContext(access.WithActor(c.Request.Context(), &access.Actor{ID: actorID}))
+		}
+		c.Next()
+	})
+	r.POST("/pullRequest/create", l.Limit("pullRequest"), func(c *gin.Context) { c.Status(http.StatusCreated) })
+	r.GET("/stats", l.Limit("stats"), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	return r
+}
+
+func doRateLimited(r *gin.Engine, method, path, actorID string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if actorID != "" {
+		req.Header.Set("X-Actor", actorID)
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestRateLimiter_Limit(t *testing.T) {
+	r := newRateLimitRouter(ratelimit.NewMemoryLimiter())
+
+	for range 2 {
+		w := doRateLimited(r, http.MethodPost, "/pullRequest/create", "ci")
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	w := doRateLimited(r, http.MethodPost, "/pullRequest/create", "ci")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+
+	var body handlers.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, handlers.ErrCodeRateLimited, body.Error.Code)
+	assert.Equal(t, "rate limit exceeded, retry in 2 s", body.Error.Message)
+
+	// other actors and anonymous clients have their own buckets
+	w = doRateLimited(r, http.MethodPost, "/pullRequest/create", "alice")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	w = doRateLimited(r, http.MethodPost, "/pullRequest/create", "")
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	// groups with a zero rate are not limited
+	for range 10 {
+		w = doRateLimited(r, http.MethodGet, "/stats", "ci")
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestRateLimiter_Limit_ForwardedForIgnored(t *testing.T) {
+	r := newRateLimitRouter(ratelimit.NewMemoryLimiter())
+
+	// a new X-Forwarded-For on every request does not get the client a fresh bucket
+	for i := range 3 {
+		w := doRateLimited(r, http.MethodPost, "/pullRequest/create", "", "X-Forwarded-For", "10.0.0."+strconv.Itoa(i))
+		if i < 2 {
+			require.Equal(t, http.StatusCreated, w.Code)
+		} else {
+			require.Equal(t, http.StatusTooManyRequests, w.Code)
+		}
+	}
+}
+
+func TestRateLimiter_Limit_Disabled(t *testing.T) {
+	r := newRateLimitRouter(nil)
+
+	for range 10 {
+		w := doRateLimited(r, http.MethodPost, "/pullRequest/create", "ci")
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+}
+
+func TestRateLimiter_Limit_FailOpen(t *testing.T) {
+	r := newRateLimitRouter(failingLimiter{})
+
+	w := doRateLimited(r, http.MethodPost, "/pullRequest/create", "ci")
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
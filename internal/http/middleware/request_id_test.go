@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reviewer-assigner/internal/logger"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "provided", header: "req-42", expected: "req-42"},
+		{name: "missing", header: ""},
+		{name: "with_spaces", header: "req 42"},
+		{name: "too_long", header: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var fromContext string
+
+			r := gin.New()
+			r.Use(RequestID())
+			r.GET("/", func(c *gin.Context) {
+				fromContext = logger.RequestID(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.header != "" {
+				req.Header.Set(RequestIDHeader, tc.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			requestID := w.Header().Get(RequestIDHeader)
+			assert.Equal(t, requestID, fromContext)
+
+			if tc.expected != "" {
+				assert.Equal(t, tc.expected, requestID)
+				return
+			}
+
+			_, err := uuid.Parse(requestID)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestContextHandler_Handle(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(NewContextHandler(slog.NewTextHandler(&buf, nil))).With(slog.String("op", "test"))
+
+	log.InfoContext(context.Background(), "without span")
+	assert.NotContains(t, buf.String(), "trace_id")
+
+	provider := sdktrace.NewTracerProvider()
+	ctx, span := provider.Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	buf.Reset()
+	log.InfoContext(WithRequestID(ctx, "req-1"), "with span")
+
+	require.Contains(t, buf.String(), "op=test")
+	assert.Contains(t, buf.String(), "request_id=req-1")
+	assert.Contains(t, buf.String(), "trace_id="+span.SpanContext().TraceID().String())
+	assert.Contains(t, buf.String(), "span_id="+span.SpanContext().SpanID().String())
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "reviewer_assigner"
+
+// Metrics owns its registry, so several instances (e.g. test suites) never collide.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequestDuration *prometheus.HistogramVec
+
+	pullRequestsCreated prometheus.Counter
+	pullRequestsMerged  prometheus.Counter
+	reassignments       prometheus.Counter
+	noCandidates        prometheus.Counter
+	declines            *prometheus.CounterVec
+	assignments         *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of HTTP requests by route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+
+		pullRequestsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_created_total",
+			Help:      "Number of created pull requests.",
+		}),
+		pullRequestsMerged: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_merged_total",
+			Help:      "Number of merged pull requests.",
+		}),
+		reassignments: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reassignments_total",
+			Help:      "Number of reviewer reassignments.",
+		}),
+		noCandidates: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "no_candidate_failures_total",
+			Help:      "Number of reassignments failed because no active candidate was left.",
+		}),
+		declines: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "review_declines_total",
+			Help:      "Number of reviews declined by reviewers by reason.",
+		}, []string{"reason"}),
+		assignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "assignments_total",
+			Help:      "Number of reviewers assigned to created pull requests by strategy.",
+		}, []string{"strategy"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequestDuration,
+		m.pullRequestsCreated,
+		m.pullRequestsMerged,
+		m.reassignments,
+		m.noCandidates,
+		m.declines,
+		m.assignments,
+	)
+
+	return m
+}
+
+// Handler serves the registry in Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Metrics) PullRequestCreated() {
+	m.pullRequestsCreated.Inc()
+}
+
+func (m *Metrics) PullRequestMerged() {
+	m.pullRequestsMerged.Inc()
+}
+
+func (m *Metrics) ReviewerReassigned() {
+	m.reassignments.Inc()
+}
+
+func (m *Metrics) NoCandidate() {
+	m.noCandidates.Inc()
+}
+
+func (m *Metrics) ReviewDeclined(reason string) {
+	m.declines.WithLabelValues(reason).Inc()
+}
+
+func (m *Metrics) ReviewersAssigned(strategy string, count int) {
+	m.assignments.WithLabelValues(strategy).Add(float64(count))
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics_DomainCounters(t *testing.T) {
+	m := New()
+
+	m.PullRequestCreated()
+	m.PullRequestMerged()
+	m.ReviewerReassigned()
+	m.NoCandidate()
+	m.ReviewersAssigned("random", 2)
+	m.ReviewersAssigned("random", 1)
+
+	assert.InDelta(t, 1, testutil.ToFloat64(m.pullRequestsCreated), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(m.pullRequestsMerged), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(m.reassignments), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(m.noCandidates), 0)
+	assert.InDelta(t, 3, testutil.ToFloat64(m.assignments.WithLabelValues("random")), 0)
+}
+
+func TestMetrics_GinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	m := New()
+
+	r := gin.New()
+	r.Use(m.GinMiddleware())
+	r.GET("/team/get", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+	r.GET("/metrics", gin.WrapH(m.Handler()))
+
+	for _, path := range []string{"/team/get?team_name=a", "/team/get?team_name=b", "/unknown/42"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body,
+		`reviewer_assigner_http_request_duration_seconds_count{method="GET",route="/team/get",status="404"} 2`)
+	assert.Contains(t, body,
+		`reviewer_assigner_http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`)
+	assert.False(t, strings.Contains(body, "/unknown/42"))
+}
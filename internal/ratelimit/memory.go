@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SweepInterval is how often limiters drop the buckets that are full again.
+const SweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	rule      Rule
+}
+
+// MemoryLimiter keeps buckets in process, every replica limits clients on its own.
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (l *MemoryLimiter) Take(_ context.Context, key string, rule Rule) (Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rule.Burst), updatedAt: now, rule: rule}
+		l.buckets[key] = b
+	}
+
+	var res Result
+	b.tokens, res = take(refill(b.tokens, now.Sub(b.updatedAt), rule), rule)
+	b.updatedAt = now
+
+	return res, nil
+}
+
+// sweep drops buckets idle long enough to be full again, they are the same as missing ones.
+func (l *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < SweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if refill(b.tokens, now.Sub(b.updatedAt), b.rule) >= float64(b.rule.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter_Take(t *testing.T) {
+	l := NewMemoryLimiter()
+	now := time.Now()
+	l.now = func() time.Time { return now }
+
+	rule := Rule{Rate: 2, Burst: 3}
+
+	for range rule.Burst {
+		res, err := l.Take(context.Background(), "ci", rule)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+
+	res, err := l.Take(context.Background(), "ci", rule)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+
+	// other clients have their own buckets
+	res, err = l.Take(context.Background(), "other", rule)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	now = now.Add(250 * time.Millisecond)
+
+	res, err = l.Take(context.Background(), "ci", rule)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 250*time.Millisecond, res.RetryAfter)
+
+	now = now.Add(250 * time.Millisecond)
+
+	res, err = l.Take(context.Background(), "ci", rule)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+func TestMemoryLimiter_Sweep(t *testing.T) {
+	l := NewMemoryLimiter()
+	now := time.Now()
+	l.now = func() time.Time { return now }
+
+	_, err := l.Take(context.Background(), "slow", Rule{Rate: 0.001, Burst: 1})
+	require.NoError(t, err)
+	_, err = l.Take(context.Background(), "fast", Rule{Rate: 100, Burst: 1})
+	require.NoError(t, err)
+
+	now = now.Add(SweepInterval)
+
+	_, err = l.Take(context.Background(), "other", Rule{Rate: 100, Burst: 1})
+	require.NoError(t, err)
+
+	assert.Contains(t, l.buckets, "slow")
+	assert.NotContains(t, l.buckets, "fast")
+	assert.Contains(t, l.buckets, "other")
+}
@@ -0,0 +1,53 @@
+// Package ratelimit implements token bucket rate limiting keyed by client.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	BackendNone     = "none"
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+)
+
+var ErrUnknownBackend = errors.New("unknown rate limit backend")
+
+// Rule is a token bucket refilled at Rate tokens per second up to Burst tokens.
+type Rule struct {
+	Rate  float64
+	Burst int
+}
+
+// Result of taking a token, RetryAfter is set when the request is not allowed.
+type Result struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	// Take spends a token from the bucket of key, buckets start full.
+	Take(ctx context.Context, key string, rule Rule) (Result, error)
+}
+
+// refill returns the tokens in a bucket after elapsed time.
+func refill(tokens float64, elapsed time.Duration, rule Rule) float64 {
+	return math.Min(float64(rule.Burst), tokens+elapsed.Seconds()*rule.Rate)
+}
+
+// take spends a token from a refilled bucket and returns the tokens left.
+func take(tokens float64, rule Rule) (float64, Result) {
+	if tokens >= 1 {
+		return tokens - 1, Result{Allowed: true}
+	}
+
+	return tokens, Result{RetryAfter: rule.RetryAfter(tokens)}
+}
+
+// RetryAfter is the time a bucket with tokens left needs to refill one token.
+func (r Rule) RetryAfter(tokens float64) time.Duration {
+	return time.Duration((1 - tokens) / r.Rate * float64(time.Second))
+}
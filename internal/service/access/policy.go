@@ -0,0 +1,119 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reviewer-assigner/internal/domain"
+	accessDomain "reviewer-assigner/internal/domain/access"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	usersDomain "reviewer-assigner/internal/domain/users"
+	"reviewer-assigner/internal/service"
+)
+
+type UserRepository interface {
+	GetUserByID(ctx context.Context, userID string) (*usersDomain.User, error)
+}
+
+// Policy checks the actor from the context against domain access rules.
+// Requests without an actor are allowed, it happens only when authentication is disabled.
+type Policy struct {
+	userRepo UserRepository
+}
+
+func NewPolicy(userRepo UserRepository) *Policy {
+	return &Policy{userRepo: userRepo}
+}
+
+func (p *Policy) CanManageTeam(ctx context.Context, teamName string) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, actorTeams []string) error {
+		return actor.CanManageTeam(actorTeams, teamName)
+	})
+}
+
+func (p *Policy) CanManageUser(ctx context.Context, user *usersDomain.User) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, actorTeams []string) error {
+		return actor.CanManageUser(actorTeams, user.TeamName)
+	})
+}
+
+func (p *Policy) CanReassign(ctx context.Context, reviewer *usersDomain.User) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, actorTeams []string) error {
+		return actor.CanReassign(actorTeams, reviewer.ID, reviewer.TeamName)
+	})
+}
+
+func (p *Policy) CanSetReviewers(ctx context.Context, pullRequest *prsDomain.PullRequest) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, actorTeams []string) error {
+		return actor.CanSetReviewers(actorTeams, pullRequest.AuthorID, pullRequest.TeamName)
+	})
+}
+
+func (p *Policy) CanDecline(ctx context.Context, reviewer *usersDomain.User) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, _ []string) error {
+		return actor.CanDecline(reviewer.ID)
+	})
+}
+
+func (p *Policy) CanApprove(ctx context.Context, reviewer *usersDomain.User) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, _ []string) error {
+		return actor.CanApprove(reviewer.ID)
+	})
+}
+
+func (p *Policy) CanManageRequiredGroups(ctx context.Context) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, _ []string) error {
+		return actor.CanManageRequiredGroups()
+	})
+}
+
+func (p *Policy) CanImportPullRequests(ctx context.Context) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, _ []string) error {
+		return actor.CanImportPullRequests()
+	})
+}
+
+func (p *Policy) CanManageTokens(ctx context.Context) error {
+	return p.check(ctx, func(actor *accessDomain.Actor, _ []string) error {
+		return actor.CanManageTokens()
+	})
+}
+
+func (p *Policy) check(
+	ctx context.Context,
+	can func(actor *accessDomain.Actor, actorTeams []string) error,
+) error {
+	actor, ok := accessDomain.ActorFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	actorTeams, err := p.actorTeams(ctx, actor)
+	if err != nil {
+		return err
+	}
+
+	err = can(actor, actorTeams)
+	if errors.Is(err, domain.ErrAccessDenied) {
+		return service.ErrForbidden
+	}
+
+	return err
+}
+
+// actorTeams are looked up only for team admins, the other roles do not depend on them.
+func (p *Policy) actorTeams(ctx context.Context, actor *accessDomain.Actor) ([]string, error) {
+	if actor.Role != accessDomain.RoleTeamAdmin {
+		return nil, nil
+	}
+
+	user, err := p.userRepo.GetUserByID(ctx, actor.ID)
+	if errors.Is(err, service.ErrUserNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get actor: %w", err)
+	}
+
+	return user.TeamNames, nil
+}
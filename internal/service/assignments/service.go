@@ -0,0 +1,49 @@
+package assignments
+
+import (
+	"context"
+	"log/slog"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"time"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type TeamRepository interface {
+	GetTeamByName(ctx context.Context, teamName string) (*teamsDomain.Team, error)
+	GetInheritedRules(ctx context.Context, teamName string) ([]teamsDomain.Rule, error)
+}
+
+type PullRequestRepository interface {
+	GetCreatedByTeamSince(
+		ctx context.Context,
+		teamName string,
+		since time.Time,
+	) ([]prsDomain.PullRequest, error)
+}
+
+type AssignmentService struct {
+	teamRepo        TeamRepository
+	pullRequestRepo PullRequestRepository
+
+	txManager trm.Manager
+
+	log *slog.Logger
+}
+
+func NewAssignmentService(
+	log *slog.Logger,
+	teamRepo TeamRepository,
+	pullRequestRepo PullRequestRepository,
+	txManager trm.Manager,
+) *AssignmentService {
+	return &AssignmentService{
+		teamRepo:        teamRepo,
+		pullRequestRepo: pullRequestRepo,
+
+		txManager: txManager,
+
+		log: log,
+	}
+}
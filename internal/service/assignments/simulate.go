@@ -0,0 +1,112 @@
+package assignments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	assignmentsDomain "reviewer-assigner/internal/domain/assignments"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/pickers"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+	"time"
+)
+
+type SimulateParams struct {
+	TeamName       string
+	Strategy       pickers.Strategy
+	Days           int
+	ReviewersCount int
+	// Seed makes the random strategy reproducible, 0 picks a random seed.
+	Seed uint64
+}
+
+// Simulate replays pull requests of the last days against the strategy without writing anything.
+func (s *AssignmentService) Simulate(
+	ctx context.Context,
+	params *SimulateParams,
+) (simulation *assignmentsDomain.Simulation, err error) {
+	const op = "services.assignments.Simulate"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", params.TeamName),
+		slog.String("strategy", string(params.Strategy)),
+		slog.Int("days", params.Days),
+	)
+
+	picker, err := pickers.New(params.Strategy, pickers.NewSource(params.Seed))
+	if errors.Is(err, domain.ErrUnknownStrategy) {
+		log.WarnContext(ctx, "unknown strategy")
+
+		return nil, service.ErrAssignmentUnknownStrategy
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to build picker", logger.ErrAttr(err))
+
+		return nil, fmt.Errorf("failed to build picker: %w", err)
+	}
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		var team *teamsDomain.Team
+		team, err = s.teamRepo.GetTeamByName(ctx, params.TeamName)
+		if errors.Is(err, service.ErrTeamNotFound) {
+			log.WarnContext(ctx, "team not found")
+
+			return service.ErrTeamNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+
+		var teamRules []teamsDomain.Rule
+		teamRules, err = s.teamRepo.GetInheritedRules(ctx, params.TeamName)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team rules", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team rules: %w", err)
+		}
+
+		since := time.Now().UTC().AddDate(0, 0, -params.Days)
+
+		var history []prsDomain.PullRequest
+		history, err = s.pullRequestRepo.GetCreatedByTeamSince(ctx, params.TeamName, since)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get pull requests", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get pull requests: %w", err)
+		}
+
+		log.InfoContext(ctx, "got pull requests to replay", slog.Int("count", len(history)))
+
+		simulation, err = assignmentsDomain.Simulate(
+			team,
+			teamRules,
+			history,
+			picker,
+			params.ReviewersCount,
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to simulate", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to simulate: %w", err)
+		}
+
+		log.InfoContext(ctx, "simulated",
+			slog.Float64("simulated_gini", simulation.Simulated.Gini),
+			slog.Float64("actual_gini", simulation.Actual.Gini),
+		)
+
+		return nil
+	})
+
+	return simulation, err
+}
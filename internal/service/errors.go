@@ -5,6 +5,19 @@ import "errors"
 var (
 	ErrTeamAlreadyExists = errors.New("team already exists")
 	ErrTeamNotFound      = errors.New("team not found")
+	ErrTeamNotEmpty      = errors.New("team has members")
+	ErrTeamHasSubteams   = errors.New("team has subteams")
+	ErrTeamCycle         = errors.New("team cannot be nested under itself or its subteam")
+
+	ErrTeamMemberAlreadyExists = errors.New("user is already a member of a team")
+	ErrTeamMemberNotFound      = errors.New("user is not a member of the team")
+
+	ErrTeamRuleInvalid       = errors.New("invalid team rule")
+	ErrTeamRuleAlreadyExists = errors.New("team rule already exists")
+	ErrTeamRuleNotFound      = errors.New("team rule not found")
+
+	ErrRequiredGroupInvalid  = errors.New("invalid required group")
+	ErrRequiredGroupNotFound = errors.New("required group not found")
 
 	ErrUserNotFound = errors.New("user not found")
 
@@ -13,4 +26,33 @@ var (
 	ErrPullRequestAlreadyMerged = errors.New("pull request already merged")
 	ErrPullRequestNotAssigned   = errors.New("reviewer is not assigned to this PR")
 	ErrPullRequestNoCandidates  = errors.New("no active replacement candidate in team")
+	ErrPullRequestRuleViolation = errors.New("team rules violated")
+	ErrReviewerNotEligible      = errors.New("reviewer must be an active team member other than the author")
+	ErrReviewerInGroupSlot      = errors.New("reviewer fills a required group slot")
+	ErrPullRequestNotApproved   = errors.New("required reviewer groups have not approved")
+
+	ErrAssignmentUnknownStrategy = errors.New("unknown assignment strategy")
+
+	ErrTokenInvalid  = errors.New("invalid token")
+	ErrTokenNotFound = errors.New("token not found")
+	ErrTokenRevoked  = errors.New("token revoked")
+
+	ErrArchiveInvalid = errors.New("invalid archive")
+	ErrStateNotEmpty  = errors.New("database is not empty")
+
+	ErrForbidden = errors.New("forbidden")
 )
+
+// MemberError ties a member error to the user it was raised for, when a request touches many users.
+type MemberError struct {
+	UserID string
+	Err    error
+}
+
+func (e *MemberError) Error() string {
+	return e.UserID + ": " + e.Err.Error()
+}
+
+func (e *MemberError) Unwrap() error {
+	return e.Err
+}
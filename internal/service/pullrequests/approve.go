@@ -0,0 +1,103 @@
+package pullrequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	usersDomain "reviewer-assigner/internal/domain/users"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+	"time"
+)
+
+// Approve records the approval of an assigned reviewer, the PR can be merged
+// once the reviewers of all its required groups have approved.
+func (s *PullRequestService) Approve(
+	ctx context.Context, pullRequestID, reviewerID string,
+) (pullRequest *prsDomain.PullRequest, err error) {
+	const op = "services.pull_requests.Approve"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+		slog.String("reviewer_id", reviewerID),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		pullRequest, err = s.pullRequestRepo.GetByID(ctx, pullRequestID)
+		if errors.Is(err, service.ErrPullRequestNotFound) {
+			log.ErrorContext(ctx, "pull request not found")
+
+			return service.ErrPullRequestNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get pull request", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get pull request: %w", err)
+		}
+
+		var reviewer *usersDomain.User
+		reviewer, err = s.userRepo.GetUserByID(ctx, reviewerID)
+		if errors.Is(err, service.ErrUserNotFound) {
+			log.ErrorContext(ctx, "reviewer not found")
+
+			return service.ErrPullRequestNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get reviewer", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get reviewer: %w", err)
+		}
+
+		err = s.policy.CanApprove(ctx, reviewer)
+		if errors.Is(err, service.ErrForbidden) {
+			log.WarnContext(ctx, "actor may not approve for this reviewer")
+
+			return service.ErrForbidden
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to check access: %w", err)
+		}
+
+		err = pullRequest.Approve(reviewer.ID)
+		if errors.Is(err, domain.ErrPullRequestAlreadyMerged) {
+			log.InfoContext(ctx, "pull request is already merged")
+
+			return service.ErrPullRequestAlreadyMerged
+		}
+		if errors.Is(err, domain.ErrReviewerNotAssigned) {
+			log.ErrorContext(ctx, "reviewer is not assigned to this PR")
+
+			return service.ErrPullRequestNotAssigned
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to approve", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to approve: %w", err)
+		}
+
+		err = s.pullRequestRepo.Approve(ctx, pullRequest.ID, reviewer.ID, time.Now())
+		if err != nil {
+			log.ErrorContext(ctx, "failed to save approval", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to save approval: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "pull request approved", slog.Any("pending_groups", pullRequest.PendingGroups()))
+
+	return pullRequest, nil
+}
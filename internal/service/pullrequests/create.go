@@ -5,56 +5,117 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"reviewer-assigner/internal/domain"
 	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/rules"
 	teamsDomain "reviewer-assigner/internal/domain/teams"
 	usersDomain "reviewer-assigner/internal/domain/users"
 	"reviewer-assigner/internal/logger"
 	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
 	"time"
 )
 
+// Create assigns reviewers from teamName, an empty teamName stands for the author's primary team.
+// The review rules of the team and its parents decide from size how many reviewers the PR needs,
+// and each of their extra teams and each required group matching it fills a slot of its own on top.
+// requestedReviewers take their slots first and the picker fills the rest. Rules of the parent teams
+// apply as well, and slots the team cannot fill go to members of its nearest parent teams.
+// The team rules that kept members from being picked are returned along with the PR.
 func (s *PullRequestService) Create(
 	ctx context.Context,
-	prID, prName, authorID string,
-) (pullRequest *prsDomain.PullRequest, err error) {
+	prID, prName, authorID, teamName string,
+	size prsDomain.Size,
+	requestedReviewers []string,
+) (pullRequest *prsDomain.PullRequest, applied []rules.Violation, err error) {
 	const op = "services.pull_requests.Create"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
 	log := s.log.With(
 		slog.String("op", op),
 		slog.String("pull_request_id", prID),
 		slog.String("pull_request_name", prName),
 		slog.String("author_id", authorID),
+		slog.String("team_name", teamName),
+		slog.Int("lines_changed", size.LinesChanged),
+		slog.Any("labels", size.Labels),
+		slog.Any("paths", size.Paths),
+		slog.Any("requested_reviewers", requestedReviewers),
 	)
 
+	// requested reviewers are not reported as picked, duplicates among them take a single slot
+	var requested int
 	err = s.txManager.Do(ctx, func(ctx context.Context) error {
 		var author *usersDomain.User
 		author, err = s.userRepo.GetUserByID(ctx, authorID)
 		if errors.Is(err, service.ErrUserNotFound) {
-			log.Error("author not found")
+			log.ErrorContext(ctx, "author not found")
 
 			return service.ErrUserNotFound
 		}
 		if err != nil {
-			log.Error("failed to get author", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to get author", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to get author: %w", err)
 		}
 
-		log.Info("got author", slog.Any("author", author))
+		log.InfoContext(ctx, "got author", slog.Any("author", author))
+
+		if teamName == "" {
+			teamName = author.TeamName
+		}
+		if !author.InTeam(teamName) {
+			log.WarnContext(ctx, "author is not a member of the team")
+
+			return service.ErrTeamMemberNotFound
+		}
 
 		var team *teamsDomain.Team
-		team, err = s.teamRepo.GetTeamByName(ctx, author.TeamName)
+		team, err = s.teamRepo.GetTeamByName(ctx, teamName)
 		if errors.Is(err, service.ErrTeamNotFound) {
-			log.Error("team not found")
+			log.ErrorContext(ctx, "team not found")
 
 			return service.ErrTeamNotFound
 		}
 		if err != nil {
-			log.Error("failed to find team")
+			log.ErrorContext(ctx, "failed to find team")
 
 			return fmt.Errorf("failed to get team: %w", err)
 		}
 
-		log.Info("got team", slog.Any("team", team))
+		log.InfoContext(ctx, "got team", slog.Any("team", team))
+
+		var teamRules []teamsDomain.Rule
+		teamRules, err = s.teamRepo.GetInheritedRules(ctx, team.Name)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team rules", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team rules: %w", err)
+		}
+
+		var reviewRules []teamsDomain.ReviewRule
+		reviewRules, err = s.teamRepo.GetInheritedReviewRules(ctx, team.Name)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get review rules", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get review rules: %w", err)
+		}
+
+		var requiredGroups []teamsDomain.RequiredGroup
+		requiredGroups, err = s.teamRepo.GetRequiredGroups(ctx)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get required groups", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get required groups: %w", err)
+		}
+
+		plan := prsDomain.PlanReviewers(size, reviewRules, requiredGroups)
+
+		log.InfoContext(ctx, "review planned",
+			slog.Int("reviewers_count", plan.ReviewersCount),
+			slog.Any("group_names", plan.GroupNames),
+		)
 
 		now := time.Now()
 		pullRequest = &prsDomain.PullRequest{
@@ -62,37 +123,155 @@ func (s *PullRequestService) Create(
 				ID:       prID,
 				Name:     prName,
 				AuthorID: author.ID,
+				TeamName: team.Name,
 				Status:   prsDomain.StatusOpen,
 			},
 			CreatedAt: &now,
 		}
 
-		const countReviewers = 2
-		err = pullRequest.AssignReviewers(team.Members, s.reviewerPicker, countReviewers)
+		err = s.requestReviewers(ctx, log, pullRequest, requestedReviewers, team.Members)
+		if err != nil {
+			return err
+		}
+		requested = len(pullRequest.AssignedReviewers)
+
+		picker := rules.NewPicker(s.reviewerPicker, teamRules, author.ID)
+
+		err = pullRequest.AssignReviewers(team.Members, picker, plan.ReviewersCount)
 		if err != nil {
-			log.Error("failed to assign reviewers", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to assign reviewers", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to assign reviewers: %w", err)
 		}
 
-		log.Info("got reviewers", slog.Any("reviewers", pullRequest.AssignedReviewers))
+		for _, skipped := range picker.Skipped() {
+			log.InfoContext(ctx, "team rule applied", slog.String("rule", skipped.String()))
+		}
+
+		if len(pullRequest.AssignedReviewers) < plan.ReviewersCount {
+			err = s.assignFallbackReviewers(ctx, log, pullRequest, picker, plan.ReviewersCount)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.assignGroupReviewers(ctx, log, pullRequest, picker, plan.GroupNames)
+		if err != nil {
+			return err
+		}
+
+		log.InfoContext(ctx, "got reviewers", slog.Any("reviewers", pullRequest.AssignedReviewers))
 
 		_, err = s.pullRequestRepo.Create(ctx, pullRequest)
 		if errors.Is(err, service.ErrPullRequestAlreadyExists) {
-			log.Error("pull request already exists")
+			log.ErrorContext(ctx, "pull request already exists")
 
 			return service.ErrPullRequestAlreadyExists
 		}
 		if err != nil {
-			log.Error("failed to create pull request", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to create pull request", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to create pull request: %w", err)
 		}
 
-		log.Info("pull request created", slog.Any("pull_request", pullRequest))
+		log.InfoContext(ctx, "pull request created", slog.Any("pull_request", pullRequest))
+
+		applied = picker.Applied()
 
 		return nil
 	})
+	if errors.Is(err, service.ErrPullRequestNoCandidates) {
+		s.metrics.NoCandidate()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.metrics.PullRequestCreated()
+	s.metrics.ReviewersAssigned(
+		string(s.strategy),
+		len(pullRequest.AssignedReviewers)-requested,
+	)
+
+	return pullRequest, applied, nil
+}
+
+// assignFallbackReviewers fills the free slots from the parent teams, nearest first.
+func (s *PullRequestService) assignFallbackReviewers(
+	ctx context.Context,
+	log *slog.Logger,
+	pullRequest *prsDomain.PullRequest,
+	picker *rules.Picker,
+	countReviewers int,
+) error {
+	ancestors, err := s.teamRepo.GetAncestors(ctx, pullRequest.TeamName)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get parent teams", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to get parent teams: %w", err)
+	}
+
+	for _, ancestor := range ancestors {
+		if len(pullRequest.AssignedReviewers) >= countReviewers {
+			break
+		}
+
+		assigned := len(pullRequest.AssignedReviewers)
+		if err = pullRequest.AssignReviewers(ancestor.Members, picker, countReviewers); err != nil {
+			log.ErrorContext(ctx, "failed to assign fallback reviewers", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to assign fallback reviewers: %w", err)
+		}
+
+		for _, skipped := range picker.Skipped() {
+			log.InfoContext(ctx, "team rule applied", slog.String("rule", skipped.String()))
+		}
+
+		log.InfoContext(ctx, "fallback reviewers assigned",
+			slog.String("fallback_team_name", ancestor.Name),
+			slog.Int("count", len(pullRequest.AssignedReviewers)-assigned),
+		)
+	}
+
+	return nil
+}
+
+// assignGroupReviewers fills the slot of each extra team and required group. The slot is tracked,
+// so a reassignment or a decline hands it over within the group, and the PR cannot be created
+// while a group has nobody to review it.
+func (s *PullRequestService) assignGroupReviewers(
+	ctx context.Context,
+	log *slog.Logger,
+	pullRequest *prsDomain.PullRequest,
+	picker *rules.Picker,
+	groupNames []string,
+) error {
+	for _, groupName := range groupNames {
+		group, err := s.teamRepo.GetTeamByName(ctx, groupName)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get group", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get group: %w", err)
+		}
+
+		err = pullRequest.AssignGroupReviewer(group.Name, group.Members, picker)
+		if errors.Is(err, domain.ErrNotEnoughMembers) {
+			log.WarnContext(ctx, "no candidate in group", slog.String("group_name", groupName))
+
+			return service.ErrPullRequestNoCandidates
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to assign group reviewer", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to assign group reviewer: %w", err)
+		}
+
+		for _, skipped := range picker.Skipped() {
+			log.InfoContext(ctx, "team rule applied", slog.String("rule", skipped.String()))
+		}
+
+		log.InfoContext(ctx, "group reviewer assigned", slog.String("group_name", groupName))
+	}
 
-	return pullRequest, err
+	return nil
 }
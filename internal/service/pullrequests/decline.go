@@ -0,0 +1,140 @@
+package pullrequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/rules"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	usersDomain "reviewer-assigner/internal/domain/users"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+	"slices"
+)
+
+// Decline takes the reviewer off the PR on their own request and reassigns the review.
+// replacedBy is empty when no candidate is left, the PR then keeps fewer reviewers,
+// unless the reviewer fills a required group slot.
+func (s *PullRequestService) Decline(
+	ctx context.Context, decline *prsDomain.Decline,
+) (pullRequest *prsDomain.PullRequest, replacedBy string, err error) {
+	const op = "services.pull_requests.Decline"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("pull_request_id", decline.PullRequestID),
+		slog.String("reviewer_id", decline.ReviewerID),
+		slog.String("reason", string(decline.Reason)),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		pullRequest, err = s.pullRequestRepo.GetByID(ctx, decline.PullRequestID)
+		if errors.Is(err, service.ErrPullRequestNotFound) {
+			log.ErrorContext(ctx, "pull request not found")
+
+			return service.ErrPullRequestNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get pull request", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get pull request: %w", err)
+		}
+
+		log.InfoContext(ctx, "got pull request", slog.Any("pull_request", pullRequest))
+
+		if pullRequest.Status == prsDomain.StatusMerged {
+			log.InfoContext(ctx, "pull request is already merged")
+
+			return service.ErrPullRequestAlreadyMerged
+		}
+
+		var reviewer *usersDomain.User
+		reviewer, err = s.userRepo.GetUserByID(ctx, decline.ReviewerID)
+		if errors.Is(err, service.ErrUserNotFound) {
+			log.ErrorContext(ctx, "reviewer not found")
+
+			return service.ErrPullRequestNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get reviewer", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get reviewer: %w", err)
+		}
+
+		err = s.policy.CanDecline(ctx, reviewer)
+		if errors.Is(err, service.ErrForbidden) {
+			log.WarnContext(ctx, "actor may not decline for this reviewer")
+
+			return service.ErrForbidden
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to check access: %w", err)
+		}
+
+		if !slices.Contains(pullRequest.AssignedReviewers, reviewer.ID) {
+			log.ErrorContext(ctx, "reviewer is not assigned to this PR")
+
+			return service.ErrPullRequestNotAssigned
+		}
+
+		var members []teamsDomain.Member
+		var reassigner *rules.Reassigner
+		members, reassigner, err = s.replacementPool(ctx, log, pullRequest, reviewer)
+		if err != nil {
+			return err
+		}
+
+		replacedBy, err = pullRequest.Decline(&reviewer.Member, members, reassigner)
+		if errors.Is(err, domain.ErrNotEnoughMembers) {
+			log.ErrorContext(ctx, "no candidate left for the required group slot")
+
+			return service.ErrPullRequestNoCandidates
+		}
+		if errors.Is(err, domain.ErrRuleViolation) {
+			log.WarnContext(ctx, "team rules violated", logger.ErrAttr(err))
+
+			return fmt.Errorf("%w: %w", service.ErrPullRequestRuleViolation, err)
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to decline", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to decline: %w", err)
+		}
+
+		err = s.pullRequestRepo.CreateDecline(ctx, decline)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to record decline", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to record decline: %w", err)
+		}
+
+		err = s.pullRequestRepo.UpdateReviewers(ctx, pullRequest)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to update reviewers", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to update reviewers: %w", err)
+		}
+
+		return nil
+	})
+	if errors.Is(err, service.ErrPullRequestNoCandidates) {
+		s.metrics.NoCandidate()
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.metrics.ReviewDeclined(string(decline.Reason))
+
+	log.InfoContext(ctx, "review declined", slog.String("replaced_by", replacedBy))
+
+	return pullRequest, replacedBy, nil
+}
@@ -0,0 +1,178 @@
+package pullrequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+// Import loads PRs from history with their original reviewers, statuses and times.
+// Records failing validation or referring to unknown users, teams or stored PRs are reported
+// by line, the others are imported together.
+func (s *PullRequestService) Import(
+	ctx context.Context,
+	records []prsDomain.ImportRecord,
+) (result *prsDomain.ImportResult, err error) {
+	const op = "services.pull_requests.Import"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("records", len(records)),
+	)
+
+	err = s.policy.CanImportPullRequests(ctx)
+	if errors.Is(err, service.ErrForbidden) {
+		log.WarnContext(ctx, "actor may not import pull requests")
+
+		return nil, service.ErrForbidden
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+		return nil, fmt.Errorf("failed to check access: %w", err)
+	}
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		result = &prsDomain.ImportResult{}
+
+		var refs *importRefs
+		refs, err = s.getImportRefs(ctx, records)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to check import references", logger.ErrAttr(err))
+
+			return err
+		}
+
+		pullRequests := make([]prsDomain.PullRequest, 0, len(records))
+		for _, record := range records {
+			var reason string
+			err = record.PullRequest.ValidateImported()
+			if errors.Is(err, domain.ErrImportRecordInvalid) {
+				reason = err.Error()
+			} else if err != nil {
+				return fmt.Errorf("failed to validate record: %w", err)
+			} else {
+				reason = refs.check(&record.PullRequest)
+			}
+			if reason != "" {
+				result.Errors = append(result.Errors, prsDomain.ImportError{
+					Line:          record.Line,
+					PullRequestID: record.PullRequest.ID,
+					Reason:        reason,
+				})
+				continue
+			}
+
+			refs.pullRequests[record.PullRequest.ID] = true
+			pullRequests = append(pullRequests, record.PullRequest)
+		}
+
+		if len(pullRequests) == 0 {
+			return nil
+		}
+
+		err = s.pullRequestRepo.Import(ctx, pullRequests)
+		if errors.Is(err, service.ErrPullRequestAlreadyExists) {
+			log.WarnContext(ctx, "pull request stored during import")
+
+			return service.ErrPullRequestAlreadyExists
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to import pull requests", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to import pull requests: %w", err)
+		}
+
+		result.Imported = len(pullRequests)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "pull requests imported",
+		slog.Int("imported", result.Imported),
+		slog.Int("failed", len(result.Errors)),
+	)
+
+	return result, nil
+}
+
+// importRefs are the users, teams and PRs the imported records refer to that are already stored,
+// pullRequests also gets the PRs accepted from earlier lines.
+type importRefs struct {
+	users        map[string]bool
+	teams        map[string]bool
+	pullRequests map[string]bool
+}
+
+func (s *PullRequestService) getImportRefs(ctx context.Context, records []prsDomain.ImportRecord) (*importRefs, error) {
+	var userIDs, teamNames, pullRequestIDs []string
+	for _, record := range records {
+		userIDs = append(userIDs, record.PullRequest.AuthorID)
+		userIDs = append(userIDs, record.PullRequest.AssignedReviewers...)
+		if record.PullRequest.TeamName != "" {
+			teamNames = append(teamNames, record.PullRequest.TeamName)
+		}
+		pullRequestIDs = append(pullRequestIDs, record.PullRequest.ID)
+	}
+
+	users, err := s.userRepo.GetExistingUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	teams, err := s.teamRepo.GetExistingTeamNames(ctx, teamNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+
+	pullRequests, err := s.pullRequestRepo.GetExistingIDs(ctx, pullRequestIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull requests: %w", err)
+	}
+
+	return &importRefs{
+		users:        toSet(users),
+		teams:        toSet(teams),
+		pullRequests: toSet(pullRequests),
+	}, nil
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+
+	return set
+}
+
+// check returns why the PR cannot be imported, empty when it can.
+func (r *importRefs) check(pullRequest *prsDomain.PullRequest) string {
+	if r.pullRequests[pullRequest.ID] {
+		return fmt.Sprintf("PR %s already exists", pullRequest.ID)
+	}
+	if !r.users[pullRequest.AuthorID] {
+		return fmt.Sprintf("unknown author %s", pullRequest.AuthorID)
+	}
+	for _, reviewerID := range pullRequest.AssignedReviewers {
+		if !r.users[reviewerID] {
+			return fmt.Sprintf("unknown reviewer %s", reviewerID)
+		}
+	}
+	if pullRequest.TeamName != "" && !r.teams[pullRequest.TeamName] {
+		return fmt.Sprintf("unknown team %s", pullRequest.TeamName)
+	}
+
+	return ""
+}
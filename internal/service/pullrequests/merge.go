@@ -9,6 +9,7 @@ import (
 	prsDomain "reviewer-assigner/internal/domain/pullrequests"
 	"reviewer-assigner/internal/logger"
 	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
 )
 
 func (s *PullRequestService) Merge(
@@ -16,20 +17,26 @@ func (s *PullRequestService) Merge(
 	pullRequestID string,
 ) (pullRequest *prsDomain.PullRequest, err error) {
 	const op = "services.pull_requests.Merge"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
 	log := s.log.With(
 		slog.String("op", op),
 		slog.String("pull_request_id", pullRequestID),
 	)
 
+	merged := false
 	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		merged = false
+
 		pullRequest, err = s.pullRequestRepo.GetByID(ctx, pullRequestID)
 		if errors.Is(err, service.ErrPullRequestNotFound) {
-			log.Error("pull request not found")
+			log.ErrorContext(ctx, "pull request not found")
 
 			return service.ErrPullRequestNotFound
 		}
 		if err != nil {
-			log.Error("failed to merge pull request", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to merge pull request", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to merge pull request: %w", err)
 		}
@@ -37,25 +44,40 @@ func (s *PullRequestService) Merge(
 		err = pullRequest.Merge()
 		if errors.Is(err, domain.ErrPullRequestAlreadyMerged) {
 			// It's ok
-			log.Info("pull request is already merged")
+			log.InfoContext(ctx, "pull request is already merged")
 
 			return nil
 		}
+		if errors.Is(err, domain.ErrApprovalRequired) {
+			log.WarnContext(ctx, "required groups have not approved", slog.Any("groups", pullRequest.PendingGroups()))
+
+			return service.ErrPullRequestNotApproved
+		}
 		if err != nil {
-			log.Error("failed to merge pull request", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to merge pull request", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to merge pull request: %w", err)
 		}
 
 		err = s.pullRequestRepo.SetStatusMerged(ctx, pullRequestID, *pullRequest.MergedAt)
 		if err != nil {
-			log.Error("failed to set status merged", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to set status merged", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to set status merged: %w", err)
 		}
 
+		merged = true
+
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// merging twice is fine, but counts once
+	if merged {
+		s.metrics.PullRequestMerged()
+	}
 
-	return pullRequest, err
+	return pullRequest, nil
 }
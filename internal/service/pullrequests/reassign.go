@@ -7,10 +7,12 @@ import (
 	"log/slog"
 	"reviewer-assigner/internal/domain"
 	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/rules"
 	teamsDomain "reviewer-assigner/internal/domain/teams"
 	usersDomain "reviewer-assigner/internal/domain/users"
 	"reviewer-assigner/internal/logger"
 	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
 	"slices"
 )
 
@@ -18,6 +20,9 @@ func (s *PullRequestService) Reassign(
 	ctx context.Context, pullRequestID, oldReviewerID string,
 ) (pullRequest *prsDomain.PullRequest, replacedBy string, err error) {
 	const op = "services.pull_requests.Reassign"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
 	log := s.log.With(
 		slog.String("op", op),
 		slog.String("pull_request_id", pullRequestID),
@@ -27,20 +32,20 @@ func (s *PullRequestService) Reassign(
 	err = s.txManager.Do(ctx, func(ctx context.Context) error {
 		pullRequest, err = s.pullRequestRepo.GetByID(ctx, pullRequestID)
 		if errors.Is(err, service.ErrPullRequestNotFound) {
-			log.Error("pull request not found")
+			log.ErrorContext(ctx, "pull request not found")
 
 			return service.ErrPullRequestNotFound
 		}
 		if err != nil {
-			log.Error("failed to get pull request", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to get pull request", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to get repo: %w", err)
 		}
 
-		log.Info("got pull request", slog.Any("pull_request", pullRequest))
+		log.InfoContext(ctx, "got pull request", slog.Any("pull_request", pullRequest))
 
 		if pullRequest.Status == prsDomain.StatusMerged {
-			log.Info("pull request is already merged")
+			log.InfoContext(ctx, "pull request is already merged")
 
 			return service.ErrPullRequestAlreadyMerged
 		}
@@ -48,64 +53,115 @@ func (s *PullRequestService) Reassign(
 		var oldReviewer *usersDomain.User
 		oldReviewer, err = s.userRepo.GetUserByID(ctx, oldReviewerID)
 		if errors.Is(err, service.ErrUserNotFound) {
-			log.Error("old reviewer not found")
+			log.ErrorContext(ctx, "old reviewer not found")
 
 			return service.ErrPullRequestNotFound
 		}
 		if err != nil {
-			log.Error("failed to get old reviewer", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to get old reviewer", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to get old reviewer: %w", err)
 		}
 
-		log.Info("got old reviewer", slog.Any("old_reviewer", oldReviewer))
+		log.InfoContext(ctx, "got old reviewer", slog.Any("old_reviewer", oldReviewer))
 
-		if idx := slices.Index(pullRequest.AssignedReviewers, oldReviewer.ID); idx == -1 {
-			log.Error("old reviewer is not assigned to this PR")
+		err = s.policy.CanReassign(ctx, oldReviewer)
+		if errors.Is(err, service.ErrForbidden) {
+			log.WarnContext(ctx, "actor may not reassign this reviewer")
 
-			return service.ErrPullRequestNotAssigned
+			return service.ErrForbidden
 		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
 
-		var team *teamsDomain.Team
-		team, err = s.teamRepo.GetTeamByName(ctx, oldReviewer.TeamName)
-		if errors.Is(err, service.ErrTeamNotFound) {
-			log.Error("team not found")
-
-			return service.ErrTeamNotFound
+			return fmt.Errorf("failed to check access: %w", err)
 		}
-		if err != nil {
-			log.Error("failed to get team", logger.ErrAttr(err))
 
-			return fmt.Errorf("failed to get members: %w", err)
+		if idx := slices.Index(pullRequest.AssignedReviewers, oldReviewer.ID); idx == -1 {
+			log.ErrorContext(ctx, "old reviewer is not assigned to this PR")
+
+			return service.ErrPullRequestNotAssigned
 		}
 
-		log.Info("got team", slog.Any("team", team))
+		var members []teamsDomain.Member
+		var reassigner *rules.Reassigner
+		members, reassigner, err = s.replacementPool(ctx, log, pullRequest, oldReviewer)
+		if err != nil {
+			return err
+		}
 
-		replacedBy, err = pullRequest.Reassign(
-			&oldReviewer.Member,
-			team.Members,
-			s.reviewerReassigner,
-		)
+		replacedBy, err = pullRequest.Reassign(&oldReviewer.Member, members, reassigner)
 		if errors.Is(err, domain.ErrNotEnoughMembers) {
-			log.Error("not enough active members")
+			log.ErrorContext(ctx, "not enough active members")
 
 			return service.ErrPullRequestNoCandidates
 		}
+		if errors.Is(err, domain.ErrRuleViolation) {
+			log.WarnContext(ctx, "team rules violated", logger.ErrAttr(err))
+
+			return fmt.Errorf("%w: %w", service.ErrPullRequestRuleViolation, err)
+		}
 		if err != nil {
-			log.Error("failed to reassign", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to reassign", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to reassign: %w", err)
 		}
 
-		err = s.pullRequestRepo.UpdateReviewers(ctx, pullRequestID, pullRequest.AssignedReviewers)
+		err = s.pullRequestRepo.UpdateReviewers(ctx, pullRequest)
 		if err != nil {
-			log.Error("failed to update reviewers", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to update reviewers", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to update reviewers: %w", err)
 		}
 
 		return nil
 	})
+	if errors.Is(err, service.ErrPullRequestNoCandidates) {
+		s.metrics.NoCandidate()
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.metrics.ReviewerReassigned()
+
+	return pullRequest, replacedBy, nil
+}
 
-	return pullRequest, replacedBy, err
+// replacementPool returns the members that may take over the slot of reviewer along with
+// a reassigner enforcing the team rules. A group slot is refilled from its group,
+// pull requests left without a team fall back to the reviewer's primary team.
+func (s *PullRequestService) replacementPool(
+	ctx context.Context,
+	log *slog.Logger,
+	pullRequest *prsDomain.PullRequest,
+	reviewer *usersDomain.User,
+) ([]teamsDomain.Member, *rules.Reassigner, error) {
+	teamName := pullRequest.SlotTeamName(reviewer.ID)
+	if teamName == "" {
+		teamName = reviewer.TeamName
+	}
+
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if errors.Is(err, service.ErrTeamNotFound) {
+		log.ErrorContext(ctx, "team not found", slog.String("team_name", teamName))
+
+		return nil, nil, service.ErrTeamNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+		return nil, nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	log.InfoContext(ctx, "got team", slog.Any("team", team))
+
+	teamRules, err := s.teamRepo.GetInheritedRules(ctx, team.Name)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get team rules", logger.ErrAttr(err))
+
+		return nil, nil, fmt.Errorf("failed to get team rules: %w", err)
+	}
+
+	return team.Members, rules.NewReassigner(s.reviewerReassigner, teamRules, pullRequest), nil
 }
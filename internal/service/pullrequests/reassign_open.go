@@ -0,0 +1,79 @@
+package pullrequests
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/tracing"
+)
+
+// ReassignOpenReviews hands the reviewer's open reviews filling a slot of the team over to other members
+// of that team. Those are the team slots of pull requests targeting the team and the group slots of the team
+// on pull requests of any team.
+// It fails as a whole when any review has no candidate, so it is meant to run inside the caller's transaction.
+func (s *PullRequestService) ReassignOpenReviews(
+	ctx context.Context,
+	reviewerID string,
+	teamName string,
+) (reassignments []prsDomain.Reassignment, err error) {
+	const op = "services.pull_requests.ReassignOpenReviews"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("reviewer_id", reviewerID),
+		slog.String("team_name", teamName),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		var pullRequests []prsDomain.PullRequestShort
+		pullRequests, err = s.pullRequestRepo.GetPullRequestsForReview(ctx, reviewerID)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get pull requests for review", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get pull requests for review: %w", err)
+		}
+
+		for _, short := range pullRequests {
+			if short.Status != prsDomain.StatusOpen {
+				continue
+			}
+
+			var pullRequest *prsDomain.PullRequest
+			pullRequest, err = s.pullRequestRepo.GetByID(ctx, short.ID)
+			if err != nil {
+				log.ErrorContext(ctx, "failed to get pull request", logger.ErrAttr(err))
+
+				return fmt.Errorf("failed to get pull request %s: %w", short.ID, err)
+			}
+
+			if pullRequest.SlotTeamName(reviewerID) != teamName {
+				continue
+			}
+
+			var replacedBy string
+			_, replacedBy, err = s.Reassign(ctx, pullRequest.ID, reviewerID)
+			if err != nil {
+				return fmt.Errorf("failed to reassign %s: %w", pullRequest.ID, err)
+			}
+
+			reassignments = append(reassignments, prsDomain.Reassignment{
+				PullRequestID: pullRequest.ID,
+				OldReviewerID: reviewerID,
+				NewReviewerID: replacedBy,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "open reviews reassigned", slog.Int("count", len(reassignments)))
+
+	return reassignments, nil
+}
@@ -0,0 +1,158 @@
+package pullrequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	usersDomain "reviewer-assigner/internal/domain/users"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+// SetReviewers removes and then adds reviewers chosen by hand, freed slots are not refilled.
+// Added reviewers must be active members of the PR team, a PR left without a team
+// takes them from the author's primary team.
+func (s *PullRequestService) SetReviewers(
+	ctx context.Context,
+	pullRequestID string,
+	add, remove []string,
+) (pullRequest *prsDomain.PullRequest, err error) {
+	const op = "services.pull_requests.SetReviewers"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("pull_request_id", pullRequestID),
+		slog.Any("add", add),
+		slog.Any("remove", remove),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		pullRequest, err = s.pullRequestRepo.GetByID(ctx, pullRequestID)
+		if errors.Is(err, service.ErrPullRequestNotFound) {
+			log.ErrorContext(ctx, "pull request not found")
+
+			return service.ErrPullRequestNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get pull request", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get pull request: %w", err)
+		}
+
+		log.InfoContext(ctx, "got pull request", slog.Any("pull_request", pullRequest))
+
+		if pullRequest.Status == prsDomain.StatusMerged {
+			log.InfoContext(ctx, "pull request is already merged")
+
+			return service.ErrPullRequestAlreadyMerged
+		}
+
+		err = s.policy.CanSetReviewers(ctx, pullRequest)
+		if errors.Is(err, service.ErrForbidden) {
+			log.WarnContext(ctx, "actor may not set reviewers of this PR")
+
+			return service.ErrForbidden
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to check access: %w", err)
+		}
+
+		teamName := pullRequest.TeamName
+		if teamName == "" {
+			var author *usersDomain.User
+			author, err = s.userRepo.GetUserByID(ctx, pullRequest.AuthorID)
+			if err != nil {
+				log.ErrorContext(ctx, "failed to get author", logger.ErrAttr(err))
+
+				return fmt.Errorf("failed to get author: %w", err)
+			}
+			teamName = author.TeamName
+		}
+
+		var team *teamsDomain.Team
+		team, err = s.teamRepo.GetTeamByName(ctx, teamName)
+		if errors.Is(err, service.ErrTeamNotFound) {
+			log.ErrorContext(ctx, "team not found")
+
+			return service.ErrTeamNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+
+		for _, reviewerID := range remove {
+			err = pullRequest.RemoveReviewer(reviewerID)
+			if errors.Is(err, domain.ErrReviewerNotAssigned) {
+				log.WarnContext(ctx, "reviewer is not assigned", slog.String("reviewer_id", reviewerID))
+
+				return &service.MemberError{UserID: reviewerID, Err: service.ErrPullRequestNotAssigned}
+			}
+			if errors.Is(err, domain.ErrReviewerInGroupSlot) {
+				log.WarnContext(ctx, "reviewer fills a required group slot", slog.String("reviewer_id", reviewerID))
+
+				return &service.MemberError{UserID: reviewerID, Err: service.ErrReviewerInGroupSlot}
+			}
+			if err != nil {
+				log.ErrorContext(ctx, "failed to remove reviewer", logger.ErrAttr(err))
+
+				return fmt.Errorf("failed to remove reviewer: %w", err)
+			}
+		}
+
+		err = s.requestReviewers(ctx, log, pullRequest, add, team.Members)
+		if err != nil {
+			return err
+		}
+
+		err = s.pullRequestRepo.UpdateReviewers(ctx, pullRequest)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to update reviewers", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to update reviewers: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "reviewers set", slog.Any("reviewers", pullRequest.AssignedReviewers))
+
+	return pullRequest, nil
+}
+
+func (s *PullRequestService) requestReviewers(
+	ctx context.Context,
+	log *slog.Logger,
+	pullRequest *prsDomain.PullRequest,
+	reviewerIDs []string,
+	members []teamsDomain.Member,
+) error {
+	for _, reviewerID := range reviewerIDs {
+		err := pullRequest.RequestReviewer(reviewerID, members)
+		if errors.Is(err, domain.ErrReviewerNotEligible) {
+			log.WarnContext(ctx, "requested reviewer is not eligible", slog.String("reviewer_id", reviewerID))
+
+			return &service.MemberError{UserID: reviewerID, Err: service.ErrReviewerNotEligible}
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to request reviewer", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to request reviewer: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/pickers"
 	teamsDomain "reviewer-assigner/internal/domain/teams"
 	usersDomain "reviewer-assigner/internal/domain/users"
 	"time"
@@ -13,17 +14,28 @@ import (
 
 type UserRepository interface {
 	GetUserByID(ctx context.Context, userID string) (*usersDomain.User, error)
+	GetExistingUserIDs(ctx context.Context, userIDs []string) ([]string, error)
 }
 
 type TeamRepository interface {
 	GetTeamByName(ctx context.Context, teamName string) (*teamsDomain.Team, error)
+	GetInheritedRules(ctx context.Context, teamName string) ([]teamsDomain.Rule, error)
+	GetAncestors(ctx context.Context, teamName string) ([]teamsDomain.Team, error)
+	GetInheritedReviewRules(ctx context.Context, teamName string) ([]teamsDomain.ReviewRule, error)
+	GetRequiredGroups(ctx context.Context) ([]teamsDomain.RequiredGroup, error)
+	GetExistingTeamNames(ctx context.Context, teamNames []string) ([]string, error)
 }
 
 type PullRequestRepository interface {
 	GetByID(ctx context.Context, pullRequestID string) (*prsDomain.PullRequest, error)
+	GetPullRequestsForReview(ctx context.Context, userID string) ([]prsDomain.PullRequestShort, error)
 	Create(ctx context.Context, pullRequest *prsDomain.PullRequest) (string, error)
 	SetStatusMerged(ctx context.Context, pullRequestID string, mergedAt time.Time) error
-	UpdateReviewers(ctx context.Context, pullRequestID string, newReviewerIDs []string) error
+	UpdateReviewers(ctx context.Context, pullRequest *prsDomain.PullRequest) error
+	Approve(ctx context.Context, pullRequestID, reviewerID string, approvedAt time.Time) error
+	CreateDecline(ctx context.Context, decline *prsDomain.Decline) error
+	GetExistingIDs(ctx context.Context, pullRequestIDs []string) ([]string, error)
+	Import(ctx context.Context, pullRequests []prsDomain.PullRequest) error
 }
 
 type ReviewerPicker interface {
@@ -37,6 +49,23 @@ type ReviewerReassigner interface {
 	) (newReviewer *teamsDomain.Member, err error)
 }
 
+type Metrics interface {
+	PullRequestCreated()
+	PullRequestMerged()
+	ReviewersAssigned(strategy string, count int)
+	ReviewerReassigned()
+	NoCandidate()
+	ReviewDeclined(reason string)
+}
+
+type Policy interface {
+	CanReassign(ctx context.Context, reviewer *usersDomain.User) error
+	CanDecline(ctx context.Context, reviewer *usersDomain.User) error
+	CanSetReviewers(ctx context.Context, pullRequest *prsDomain.PullRequest) error
+	CanApprove(ctx context.Context, reviewer *usersDomain.User) error
+	CanImportPullRequests(ctx context.Context) error
+}
+
 type PullRequestService struct {
 	userRepo        UserRepository
 	teamRepo        TeamRepository
@@ -44,6 +73,11 @@ type PullRequestService struct {
 
 	reviewerPicker     ReviewerPicker
 	reviewerReassigner ReviewerReassigner
+	// strategy labels the reviewers picked by reviewerPicker in metrics
+	strategy pickers.Strategy
+
+	metrics Metrics
+	policy  Policy
 
 	txManager trm.Manager
 
@@ -57,6 +91,9 @@ func NewPullRequestService(
 	pullRequestRepo PullRequestRepository,
 	reviewerPicker ReviewerPicker,
 	reviewerReassigner ReviewerReassigner,
+	strategy pickers.Strategy,
+	metrics Metrics,
+	policy Policy,
 	txManager trm.Manager,
 ) *PullRequestService {
 	return &PullRequestService{
@@ -66,6 +103,10 @@ func NewPullRequestService(
 
 		reviewerPicker:     reviewerPicker,
 		reviewerReassigner: reviewerReassigner,
+		strategy:           strategy,
+
+		metrics: metrics,
+		policy:  policy,
 
 		txManager: txManager,
 
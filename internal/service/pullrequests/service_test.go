@@ -0,0 +1,702 @@
+package pullrequests
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"maps"
+	"reviewer-assigner/internal/domain"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/domain/pullrequests/pickers"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	usersDomain "reviewer-assigner/internal/domain/users"
+	"reviewer-assigner/internal/service"
+	accessService "reviewer-assigner/internal/service/access"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTxManager struct{}
+
+func (m *fakeTxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (m *fakeTxManager) DoWithSettings(
+	ctx context.Context,
+	_ trm.Settings,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+type fakeMetrics struct {
+	created     int
+	merged      int
+	reassigned  int
+	noCandidate int
+	declined    map[string]int
+	assigned    map[string]int
+}
+
+func (m *fakeMetrics) PullRequestCreated() { m.created++ }
+func (m *fakeMetrics) PullRequestMerged()  { m.merged++ }
+func (m *fakeMetrics) ReviewerReassigned() { m.reassigned++ }
+func (m *fakeMetrics) NoCandidate()        { m.noCandidate++ }
+
+func (m *fakeMetrics) ReviewDeclined(reason string) {
+	if m.declined == nil {
+		m.declined = make(map[string]int)
+	}
+	m.declined[reason]++
+}
+
+func (m *fakeMetrics) ReviewersAssigned(strategy string, count int) {
+	if m.assigned == nil {
+		m.assigned = make(map[string]int)
+	}
+	m.assigned[strategy] += count
+}
+
+// fakeReassigner takes the replacement from a round robin picker, so reassignments are predictable.
+type fakeReassigner struct {
+	picker *pickers.RoundRobinReviewerPicker
+}
+
+func (r *fakeReassigner) Reassign(
+	_ *teamsDomain.Member,
+	members []teamsDomain.Member,
+) (*teamsDomain.Member, error) {
+	reviewers := r.picker.Pick(members, 1)
+	if len(reviewers) == 0 {
+		return nil, domain.ErrNotEnoughMembers
+	}
+
+	return &reviewers[0], nil
+}
+
+// fakeStorage keeps a single team, its parent teams, teams its review rules and required groups
+// refer to and its pull requests in memory.
+type fakeStorage struct {
+	team           teamsDomain.Team
+	ancestors      []teamsDomain.Team
+	extraTeams     []teamsDomain.Team
+	reviewRules    []teamsDomain.ReviewRule
+	requiredGroups []teamsDomain.RequiredGroup
+	pullRequests   map[string]*prsDomain.PullRequest
+}
+
+func (f *fakeStorage) GetUserByID(_ context.Context, userID string) (*usersDomain.User, error) {
+	for _, team := range append([]teamsDomain.Team{f.team}, f.extraTeams...) {
+		for _, member := range team.Members {
+			if member.ID == userID {
+				return &usersDomain.User{
+					Member:    member,
+					TeamName:  team.Name,
+					TeamNames: []string{team.Name},
+				}, nil
+			}
+		}
+	}
+
+	return nil, service.ErrUserNotFound
+}
+
+func (f *fakeStorage) GetExistingUserIDs(ctx context.Context, userIDs []string) ([]string, error) {
+	var existing []string
+	for _, userID := range userIDs {
+		if _, err := f.GetUserByID(ctx, userID); err == nil {
+			existing = append(existing, userID)
+		}
+	}
+
+	return existing, nil
+}
+
+func (f *fakeStorage) GetExistingTeamNames(ctx context.Context, teamNames []string) ([]string, error) {
+	var existing []string
+	for _, teamName := range teamNames {
+		if _, err := f.GetTeamByName(ctx, teamName); err == nil {
+			existing = append(existing, teamName)
+		}
+	}
+
+	return existing, nil
+}
+
+func (f *fakeStorage) GetTeamByName(_ context.Context, teamName string) (*teamsDomain.Team, error) {
+	teams := append([]teamsDomain.Team{f.team}, f.extraTeams...)
+	idx := slices.IndexFunc(teams, func(team teamsDomain.Team) bool {
+		return team.Name == teamName
+	})
+	if idx == -1 {
+		return nil, service.ErrTeamNotFound
+	}
+
+	team := teams[idx]
+	team.Members = slices.Clone(teams[idx].Members)
+
+	return &team, nil
+}
+
+func (f *fakeStorage) GetInheritedRules(_ context.Context, _ string) ([]teamsDomain.Rule, error) {
+	return nil, nil
+}
+
+func (f *fakeStorage) GetInheritedReviewRules(_ context.Context, _ string) ([]teamsDomain.ReviewRule, error) {
+	return f.reviewRules, nil
+}
+
+func (f *fakeStorage) GetRequiredGroups(_ context.Context) ([]teamsDomain.RequiredGroup, error) {
+	return f.requiredGroups, nil
+}
+
+func (f *fakeStorage) GetAncestors(_ context.Context, _ string) ([]teamsDomain.Team, error) {
+	return f.ancestors, nil
+}
+
+func (f *fakeStorage) GetByID(_ context.Context, pullRequestID string) (*prsDomain.PullRequest, error) {
+	pullRequest, ok := f.pullRequests[pullRequestID]
+	if !ok {
+		return nil, service.ErrPullRequestNotFound
+	}
+
+	clone := *pullRequest
+	clone.AssignedReviewers = slices.Clone(pullRequest.AssignedReviewers)
+	clone.DeclinedReviewers = slices.Clone(pullRequest.DeclinedReviewers)
+	clone.GroupReviewers = maps.Clone(pullRequest.GroupReviewers)
+	clone.ApprovedReviewers = slices.Clone(pullRequest.ApprovedReviewers)
+
+	return &clone, nil
+}
+
+func (f *fakeStorage) GetPullRequestsForReview(
+	_ context.Context,
+	userID string,
+) ([]prsDomain.PullRequestShort, error) {
+	var pullRequests []prsDomain.PullRequestShort
+	for _, pullRequest := range f.pullRequests {
+		if slices.Contains(pullRequest.AssignedReviewers, userID) {
+			pullRequests = append(pullRequests, pullRequest.PullRequestShort)
+		}
+	}
+
+	slices.SortFunc(pullRequests, func(a, b prsDomain.PullRequestShort) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return pullRequests, nil
+}
+
+func (f *fakeStorage) Create(_ context.Context, pullRequest *prsDomain.PullRequest) (string, error) {
+	if _, ok := f.pullRequests[pullRequest.ID]; ok {
+		return "", service.ErrPullRequestAlreadyExists
+	}
+
+	clone := *pullRequest
+	f.pullRequests[pullRequest.ID] = &clone
+
+	return pullRequest.ID, nil
+}
+
+func (f *fakeStorage) SetStatusMerged(_ context.Context, pullRequestID string, mergedAt time.Time) error {
+	f.pullRequests[pullRequestID].Status = prsDomain.StatusMerged
+	f.pullRequests[pullRequestID].MergedAt = &mergedAt
+
+	return nil
+}
+
+func (f *fakeStorage) UpdateReviewers(_ context.Context, pullRequest *prsDomain.PullRequest) error {
+	stored := f.pullRequests[pullRequest.ID]
+	stored.AssignedReviewers = slices.Clone(pullRequest.AssignedReviewers)
+	stored.GroupReviewers = maps.Clone(pullRequest.GroupReviewers)
+	stored.ApprovedReviewers = slices.Clone(pullRequest.ApprovedReviewers)
+
+	return nil
+}
+
+func (f *fakeStorage) Approve(_ context.Context, pullRequestID, reviewerID string, _ time.Time) error {
+	pullRequest := f.pullRequests[pullRequestID]
+	if !slices.Contains(pullRequest.ApprovedReviewers, reviewerID) {
+		pullRequest.ApprovedReviewers = append(pullRequest.ApprovedReviewers, reviewerID)
+	}
+
+	return nil
+}
+
+func (f *fakeStorage) GetExistingIDs(_ context.Context, pullRequestIDs []string) ([]string, error) {
+	var existing []string
+	for _, pullRequestID := range pullRequestIDs {
+		if _, ok := f.pullRequests[pullRequestID]; ok {
+			existing = append(existing, pullRequestID)
+		}
+	}
+
+	return existing, nil
+}
+
+func (f *fakeStorage) Import(_ context.Context, pullRequests []prsDomain.PullRequest) error {
+	for _, pullRequest := range pullRequests {
+		clone := pullRequest
+		f.pullRequests[pullRequest.ID] = &clone
+	}
+
+	return nil
+}
+
+func (f *fakeStorage) CreateDecline(_ context.Context, decline *prsDomain.Decline) error {
+	pullRequest := f.pullRequests[decline.PullRequestID]
+	pullRequest.DeclinedReviewers = append(pullRequest.DeclinedReviewers, decline.ReviewerID)
+
+	return nil
+}
+
+func newTestService(members []teamsDomain.Member, ancestors ...teamsDomain.Team) (*PullRequestService, *fakeMetrics) {
+	storage := &fakeStorage{
+		team:         teamsDomain.Team{Name: "backend", Members: members},
+		ancestors:    ancestors,
+		pullRequests: make(map[string]*prsDomain.PullRequest),
+	}
+	picker := pickers.NewRoundRobinReviewerPicker()
+	metrics := &fakeMetrics{}
+
+	return NewPullRequestService(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		storage,
+		storage,
+		storage,
+		picker,
+		&fakeReassigner{picker: picker},
+		picker.Strategy(),
+		metrics,
+		accessService.NewPolicy(storage),
+		&fakeTxManager{},
+	), metrics
+}
+
+func TestPullRequestService_Metrics(t *testing.T) {
+	ctx := context.Background()
+	s, metrics := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+	})
+
+	_, _, err := s.Create(ctx, "pr-1", "Add search", "u1", "", prsDomain.Size{}, nil)
+	require.NoError(t, err)
+
+	_, _, err = s.Create(ctx, "pr-1", "Add search", "u1", "", prsDomain.Size{}, nil)
+	require.ErrorIs(t, err, service.ErrPullRequestAlreadyExists)
+
+	assert.Equal(t, 1, metrics.created)
+	assert.Equal(t, map[string]int{string(pickers.StrategyRoundRobin): 2}, metrics.assigned)
+
+	// both candidates already review the PR
+	_, _, err = s.Reassign(ctx, "pr-1", "u2")
+	require.ErrorIs(t, err, service.ErrPullRequestNoCandidates)
+
+	assert.Equal(t, 1, metrics.noCandidate)
+	assert.Equal(t, 0, metrics.reassigned)
+
+	_, err = s.Merge(ctx, "pr-1")
+	require.NoError(t, err)
+	_, err = s.Merge(ctx, "pr-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, metrics.merged)
+}
+
+func TestPullRequestService_Metrics_Reassigned(t *testing.T) {
+	ctx := context.Background()
+	s, metrics := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+		{ID: "u4", IsActive: true},
+	})
+
+	pullRequest, _, err := s.Create(ctx, "pr-1", "Add search", "u1", "", prsDomain.Size{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2", "u3"}, pullRequest.AssignedReviewers)
+
+	_, replacedBy, err := s.Reassign(ctx, "pr-1", "u2")
+	require.NoError(t, err)
+
+	assert.Equal(t, "u4", replacedBy)
+	assert.Equal(t, 1, metrics.reassigned)
+	assert.Equal(t, 0, metrics.noCandidate)
+}
+
+func TestPullRequestService_ReassignOpenReviews(t *testing.T) {
+	ctx := context.Background()
+	s, metrics := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+		{ID: "u4", IsActive: true},
+	})
+
+	for _, id := range []string{"pr-1", "pr-2", "pr-3"} {
+		_, _, err := s.Create(ctx, id, "Add search", "u1", "", prsDomain.Size{}, nil)
+		require.NoError(t, err)
+		pullRequest := &prsDomain.PullRequest{
+			PullRequestShort:  prsDomain.PullRequestShort{ID: id},
+			AssignedReviewers: []string{"u2", "u3"},
+		}
+		require.NoError(t, s.pullRequestRepo.UpdateReviewers(ctx, pullRequest))
+	}
+
+	_, err := s.Merge(ctx, "pr-3")
+	require.NoError(t, err)
+
+	reassignments, err := s.ReassignOpenReviews(ctx, "u2", "backend")
+	require.NoError(t, err)
+
+	assert.Equal(t, []prsDomain.Reassignment{
+		{PullRequestID: "pr-1", OldReviewerID: "u2", NewReviewerID: "u4"},
+		{PullRequestID: "pr-2", OldReviewerID: "u2", NewReviewerID: "u4"},
+	}, reassignments)
+	assert.Equal(t, 2, metrics.reassigned)
+
+	// merged pull requests keep their reviewers
+	pullRequest, err := s.pullRequestRepo.GetByID(ctx, "pr-3")
+	require.NoError(t, err)
+	assert.Contains(t, pullRequest.AssignedReviewers, "u2")
+}
+
+func TestPullRequestService_ReassignOpenReviews_GroupSlots(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+		{ID: "u4", IsActive: true},
+	})
+	storage := s.teamRepo.(*fakeStorage)
+	storage.extraTeams = []teamsDomain.Team{{
+		Name:    "compliance",
+		Members: []teamsDomain.Member{{ID: "c1", IsActive: true}, {ID: "c2", IsActive: true}},
+	}}
+	storage.requiredGroups = []teamsDomain.RequiredGroup{
+		{TeamName: "compliance", PathPrefixes: []string{"billing/"}},
+	}
+
+	pullRequest, _, err := s.Create(ctx, "pr-1", "Add invoices", "u1", "", prsDomain.Size{
+		Paths: []string{"billing/invoice.go"},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"c1": "compliance"}, pullRequest.GroupReviewers)
+
+	// the compliance slot is not a backend one, so leaving backend keeps it
+	reassignments, err := s.ReassignOpenReviews(ctx, "c1", "backend")
+	require.NoError(t, err)
+	assert.Empty(t, reassignments)
+
+	reassignments, err = s.ReassignOpenReviews(ctx, "c1", "compliance")
+	require.NoError(t, err)
+	assert.Equal(t, []prsDomain.Reassignment{
+		{PullRequestID: "pr-1", OldReviewerID: "c1", NewReviewerID: "c2"},
+	}, reassignments)
+
+	pullRequest, err = s.pullRequestRepo.GetByID(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"c2": "compliance"}, pullRequest.GroupReviewers)
+
+	// the team slots of backend reviewers stay with backend
+	reassignments, err = s.ReassignOpenReviews(ctx, "u2", "compliance")
+	require.NoError(t, err)
+	assert.Empty(t, reassignments)
+}
+
+func TestPullRequestService_Create_TeamName(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+	})
+
+	pullRequest, _, err := s.Create(ctx, "pr-1", "Add search", "u1", "backend", prsDomain.Size{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "backend", pullRequest.TeamName)
+
+	// the author may target only their own teams
+	_, _, err = s.Create(ctx, "pr-2", "Add search", "u1", "frontend", prsDomain.Size{}, nil)
+	require.ErrorIs(t, err, service.ErrTeamMemberNotFound)
+}
+
+func TestPullRequestService_Create_FallbackReviewers(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(
+		[]teamsDomain.Member{
+			{ID: "u1", IsActive: true},
+			{ID: "u2", IsActive: true},
+		},
+		teamsDomain.Team{Name: "platform", Members: []teamsDomain.Member{{ID: "u2", IsActive: true}}},
+		teamsDomain.Team{Name: "engineering", Members: []teamsDomain.Member{{ID: "u3", IsActive: true}}},
+	)
+
+	// u2 is already taken, so the free slot goes to the next parent team
+	pullRequest, _, err := s.Create(ctx, "pr-1", "Add search", "u1", "", prsDomain.Size{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "u3"}, pullRequest.AssignedReviewers)
+}
+
+func TestPullRequestService_Decline(t *testing.T) {
+	ctx := context.Background()
+	s, metrics := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+		{ID: "u4", IsActive: true},
+	})
+
+	_, _, err := s.Create(ctx, "pr-1", "Add search", "u1", "", prsDomain.Size{}, nil)
+	require.NoError(t, err)
+
+	pullRequest, replacedBy, err := s.Decline(ctx, &prsDomain.Decline{
+		PullRequestID: "pr-1",
+		ReviewerID:    "u2",
+		Reason:        prsDomain.DeclineReasonNoContext,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "u4", replacedBy)
+	assert.Equal(t, []string{"u4", "u3"}, pullRequest.AssignedReviewers)
+
+	// u2 declined and u4, u3 already review, so nobody replaces u3
+	pullRequest, replacedBy, err = s.Decline(ctx, &prsDomain.Decline{
+		PullRequestID: "pr-1",
+		ReviewerID:    "u3",
+		Reason:        prsDomain.DeclineReasonUnavailable,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, replacedBy)
+	assert.Equal(t, []string{"u4"}, pullRequest.AssignedReviewers)
+
+	// nor is u2 brought back by a regular reassignment
+	_, _, err = s.Reassign(ctx, "pr-1", "u4")
+	require.ErrorIs(t, err, service.ErrPullRequestNoCandidates)
+
+	_, _, err = s.Decline(ctx, &prsDomain.Decline{
+		PullRequestID: "pr-1",
+		ReviewerID:    "u2",
+		Reason:        prsDomain.DeclineReasonOther,
+	})
+	require.ErrorIs(t, err, service.ErrPullRequestNotAssigned)
+
+	assert.Equal(t, map[string]int{
+		string(prsDomain.DeclineReasonNoContext):   1,
+		string(prsDomain.DeclineReasonUnavailable): 1,
+	}, metrics.declined)
+}
+
+func TestPullRequestService_RequestedReviewers(t *testing.T) {
+	ctx := context.Background()
+	s, metrics := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+		{ID: "u4", IsActive: true},
+		{ID: "u5", IsActive: false},
+	})
+
+	pullRequest, _, err := s.Create(ctx, "pr-1", "Add search", "u1", "", prsDomain.Size{}, []string{"u4"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u4", "u2"}, pullRequest.AssignedReviewers)
+	assert.Equal(t, map[string]int{string(pickers.StrategyRoundRobin): 1}, metrics.assigned)
+
+	_, _, err = s.Create(ctx, "pr-2", "Add search", "u1", "", prsDomain.Size{}, []string{"u5"})
+	var memberErr *service.MemberError
+	require.ErrorAs(t, err, &memberErr)
+	assert.Equal(t, "u5", memberErr.UserID)
+	require.ErrorIs(t, err, service.ErrReviewerNotEligible)
+
+	pullRequest, err = s.SetReviewers(ctx, "pr-1", []string{"u3"}, []string{"u2", "u4"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u3"}, pullRequest.AssignedReviewers)
+
+	_, err = s.SetReviewers(ctx, "pr-1", nil, []string{"u2"})
+	require.ErrorIs(t, err, service.ErrPullRequestNotAssigned)
+
+	_, err = s.SetReviewers(ctx, "pr-1", []string{"u1"}, nil)
+	require.ErrorIs(t, err, service.ErrReviewerNotEligible)
+}
+
+func TestPullRequestService_Create_ReviewRules(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+		{ID: "u4", IsActive: true},
+	})
+	storage := s.teamRepo.(*fakeStorage)
+	storage.extraTeams = []teamsDomain.Team{
+		{Name: "security", Members: []teamsDomain.Member{{ID: "u2", IsActive: true}, {ID: "s1", IsActive: true}}},
+		{Name: "dba", Members: []teamsDomain.Member{{ID: "d1", IsActive: false}}},
+	}
+	storage.reviewRules = []teamsDomain.ReviewRule{
+		{ReviewersCount: 1},
+		{MinLinesChanged: 500, ReviewersCount: 3},
+		{Label: "security", ExtraTeamName: "security"},
+		{Label: "migration", ExtraTeamName: "dba"},
+	}
+
+	pullRequest, _, err := s.Create(ctx, "pr-1", "Fix typo", "u1", "", prsDomain.Size{LinesChanged: 5}, nil)
+	require.NoError(t, err)
+	assert.Len(t, pullRequest.AssignedReviewers, 1)
+
+	pullRequest, _, err = s.Create(ctx, "pr-2", "Add search", "u1", "", prsDomain.Size{LinesChanged: 800}, nil)
+	require.NoError(t, err)
+	assert.Len(t, pullRequest.AssignedReviewers, 3)
+
+	// a member of the security team fills its slot on top
+	pullRequest, _, err = s.Create(ctx, "pr-3", "Rotate keys", "u1", "", prsDomain.Size{
+		LinesChanged: 20,
+		Labels:       []string{"security"},
+	}, []string{"u2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "s1"}, pullRequest.AssignedReviewers)
+	assert.Equal(t, map[string]string{"s1": "security"}, pullRequest.GroupReviewers)
+
+	// u2 already reviews, so nobody in the security team can take over the slot of s1
+	_, _, err = s.Reassign(ctx, "pr-3", "s1")
+	require.ErrorIs(t, err, service.ErrPullRequestNoCandidates)
+
+	// an extra team without a candidate fails the PR
+	_, _, err = s.Create(ctx, "pr-4", "Add index", "u1", "", prsDomain.Size{
+		LinesChanged: 20,
+		Labels:       []string{"migration"},
+	}, nil)
+	require.ErrorIs(t, err, service.ErrPullRequestNoCandidates)
+}
+
+func TestPullRequestService_RequiredGroups(t *testing.T) {
+	ctx := context.Background()
+	s, metrics := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: true},
+	})
+	storage := s.teamRepo.(*fakeStorage)
+	storage.extraTeams = []teamsDomain.Team{{
+		Name:    "compliance",
+		Members: []teamsDomain.Member{{ID: "c1", IsActive: true}},
+	}}
+	storage.requiredGroups = []teamsDomain.RequiredGroup{
+		{TeamName: "compliance", PathPrefixes: []string{"billing/"}},
+	}
+
+	pullRequest, _, err := s.Create(ctx, "pr-1", "Fix typo", "u1", "", prsDomain.Size{
+		Paths: []string{"docs/README.md"},
+	}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, pullRequest.GroupReviewers)
+
+	pullRequest, _, err = s.Create(ctx, "pr-2", "Add invoices", "u1", "", prsDomain.Size{
+		Paths: []string{"billing/invoice.go"},
+	}, nil)
+	require.NoError(t, err)
+	assert.Len(t, pullRequest.AssignedReviewers, 3)
+	assert.Equal(t, map[string]string{"c1": "compliance"}, pullRequest.GroupReviewers)
+
+	// the slot of the only compliance member cannot be left empty
+	_, _, err = s.Decline(ctx, &prsDomain.Decline{
+		PullRequestID: "pr-2",
+		ReviewerID:    "c1",
+		Reason:        prsDomain.DeclineReasonUnavailable,
+	})
+	require.ErrorIs(t, err, service.ErrPullRequestNoCandidates)
+	assert.Equal(t, 1, metrics.noCandidate)
+
+	_, err = s.Approve(ctx, "pr-2", "u2")
+	require.NoError(t, err)
+
+	_, err = s.Merge(ctx, "pr-2")
+	require.ErrorIs(t, err, service.ErrPullRequestNotApproved)
+
+	_, err = s.Approve(ctx, "pr-2", "u1")
+	require.ErrorIs(t, err, service.ErrPullRequestNotAssigned)
+
+	_, err = s.Approve(ctx, "pr-2", "c1")
+	require.NoError(t, err)
+
+	pullRequest, err = s.Merge(ctx, "pr-2")
+	require.NoError(t, err)
+	assert.Equal(t, prsDomain.StatusMerged, pullRequest.Status)
+}
+
+func TestPullRequestService_Import(t *testing.T) {
+	ctx := context.Background()
+	s, metrics := newTestService([]teamsDomain.Member{
+		{ID: "u1", IsActive: true},
+		{ID: "u2", IsActive: true},
+		{ID: "u3", IsActive: false},
+	})
+
+	_, _, err := s.Create(ctx, "pr-1", "Add search", "u1", "", prsDomain.Size{}, nil)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(time.Hour)
+	record := func(line int, id, authorID, teamName string, reviewerIDs ...string) prsDomain.ImportRecord {
+		return prsDomain.ImportRecord{
+			Line: line,
+			PullRequest: prsDomain.PullRequest{
+				PullRequestShort: prsDomain.PullRequestShort{
+					ID:       id,
+					Name:     "Imported",
+					AuthorID: authorID,
+					TeamName: teamName,
+					Status:   prsDomain.StatusMerged,
+				},
+				AssignedReviewers: reviewerIDs,
+				CreatedAt:         &createdAt,
+				MergedAt:          &mergedAt,
+			},
+		}
+	}
+	openRecord := record(2, "pr-old-2", "u2", "", "u1")
+	openRecord.PullRequest.Status = prsDomain.StatusOpen
+	openRecord.PullRequest.MergedAt = nil
+
+	result, err := s.Import(ctx, []prsDomain.ImportRecord{
+		// inactive users keep their reviews from history
+		record(1, "pr-old-1", "u1", "backend", "u2", "u3"),
+		openRecord,
+		record(3, "pr-1", "u1", ""),
+		record(4, "pr-old-1", "u1", ""),
+		record(5, "pr-old-5", "u9", ""),
+		record(6, "pr-old-6", "u1", "", "u9"),
+		record(7, "pr-old-7", "u1", "frontend"),
+		record(8, "pr-old-8", "u1", "", "u1"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.Imported)
+	reasons := make(map[int]string, len(result.Errors))
+	for _, importErr := range result.Errors {
+		reasons[importErr.Line] = importErr.Reason
+	}
+	assert.Equal(t, map[int]string{
+		3: "PR pr-1 already exists",
+		4: "PR pr-old-1 already exists",
+		5: "unknown author u9",
+		6: "unknown reviewer u9",
+		7: "unknown team frontend",
+		8: "invalid pull request record: author cannot review their own PR",
+	}, reasons)
+
+	pullRequest, err := s.pullRequestRepo.GetByID(ctx, "pr-old-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "u3"}, pullRequest.AssignedReviewers)
+	assert.Equal(t, prsDomain.StatusMerged, pullRequest.Status)
+
+	// the picker is skipped, so nothing is counted as assigned
+	assert.Equal(t, 1, metrics.created)
+}
@@ -0,0 +1,67 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	stateDomain "reviewer-assigner/internal/domain/state"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/tracing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/settings"
+	"github.com/jackc/pgx/v5"
+)
+
+// Export reads the state in one snapshot, so the archive holds together while the service keeps running.
+func (s *StateService) Export(ctx context.Context) (_ *stateDomain.Archive, err error) {
+	const op = "services.state.Export"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(slog.String("op", op))
+
+	archive := &stateDomain.Archive{
+		Version:    stateDomain.Version,
+		ExportedAt: time.Now().UTC(),
+	}
+
+	snapshot := trmpgx.MustSettings(
+		settings.Must(),
+		trmpgx.WithTxOptions(pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}),
+	)
+
+	err = s.txManager.DoWithSettings(ctx, snapshot, func(ctx context.Context) error {
+		archive.Users, err = s.stateRepo.GetUsers(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get users: %w", err)
+		}
+
+		archive.Teams, err = s.stateRepo.GetTeams(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get teams: %w", err)
+		}
+
+		archive.PullRequests, err = s.stateRepo.GetPullRequests(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get pull requests: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "failed to export state", logger.ErrAttr(err))
+
+		return nil, err
+	}
+
+	log.InfoContext(ctx,
+		"state exported",
+		slog.Int("users", len(archive.Users)),
+		slog.Int("teams", len(archive.Teams)),
+		slog.Int("pull_requests", len(archive.PullRequests)),
+	)
+
+	return archive, nil
+}
@@ -0,0 +1,81 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	stateDomain "reviewer-assigner/internal/domain/state"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+// Restore loads the archive into an empty database in one transaction,
+// nothing is stored unless the whole archive is.
+func (s *StateService) Restore(ctx context.Context, archive *stateDomain.Archive) (err error) {
+	const op = "services.state.Restore"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("version", archive.Version),
+		slog.Time("exported_at", archive.ExportedAt),
+	)
+
+	err = archive.Validate()
+	if errors.Is(err, domain.ErrArchiveVersion) || errors.Is(err, domain.ErrArchiveInvalid) {
+		log.WarnContext(ctx, "invalid archive", logger.ErrAttr(err))
+
+		return fmt.Errorf("%w: %w", service.ErrArchiveInvalid, err)
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to validate archive", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to validate archive: %w", err)
+	}
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		var empty bool
+		empty, err = s.stateRepo.IsEmpty(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check database is empty: %w", err)
+		}
+		if !empty {
+			return service.ErrStateNotEmpty
+		}
+
+		if err = s.stateRepo.CreateUsers(ctx, archive.Users); err != nil {
+			return fmt.Errorf("failed to create users: %w", err)
+		}
+		if err = s.stateRepo.CreateTeams(ctx, archive.Teams); err != nil {
+			return fmt.Errorf("failed to create teams: %w", err)
+		}
+		if err = s.stateRepo.CreatePullRequests(ctx, archive.PullRequests); err != nil {
+			return fmt.Errorf("failed to create pull requests: %w", err)
+		}
+
+		return nil
+	})
+	if errors.Is(err, service.ErrStateNotEmpty) {
+		log.WarnContext(ctx, "database is not empty")
+
+		return service.ErrStateNotEmpty
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to restore state", logger.ErrAttr(err))
+
+		return err
+	}
+
+	log.InfoContext(ctx,
+		"state restored",
+		slog.Int("users", len(archive.Users)),
+		slog.Int("teams", len(archive.Teams)),
+		slog.Int("pull_requests", len(archive.PullRequests)),
+	)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package state
+
+import (
+	"context"
+	"log/slog"
+	stateDomain "reviewer-assigner/internal/domain/state"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type StateRepository interface {
+	IsEmpty(ctx context.Context) (bool, error)
+	GetUsers(ctx context.Context) ([]stateDomain.User, error)
+	GetTeams(ctx context.Context) ([]stateDomain.Team, error)
+	GetPullRequests(ctx context.Context) ([]stateDomain.PullRequest, error)
+	CreateUsers(ctx context.Context, users []stateDomain.User) error
+	CreateTeams(ctx context.Context, teams []stateDomain.Team) error
+	CreatePullRequests(ctx context.Context, pullRequests []stateDomain.PullRequest) error
+}
+
+// StateService backs up and restores the whole state, it is run from the CLI only.
+type StateService struct {
+	stateRepo StateRepository
+
+	txManager trm.Manager
+
+	log *slog.Logger
+}
+
+func NewStateService(log *slog.Logger, stateRepo StateRepository, txManager trm.Manager) *StateService {
+	return &StateService{
+		stateRepo: stateRepo,
+		txManager: txManager,
+		log:       log,
+	}
+}
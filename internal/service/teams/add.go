@@ -9,6 +9,7 @@ import (
 	teamsDomain "reviewer-assigner/internal/domain/teams"
 	"reviewer-assigner/internal/logger"
 	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
 )
 
 func (s *TeamService) AddTeam(
@@ -17,15 +18,22 @@ func (s *TeamService) AddTeam(
 	members []teamsDomain.Member,
 ) (team *teamsDomain.Team, err error) {
 	const op = "services.teams.AddTeam"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
 	log := s.log.With(
 		slog.String("op", op),
 		slog.String("team_name", name),
 	)
 
 	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err = s.checkCanManageTeam(ctx, log, name); err != nil {
+			return err
+		}
+
 		team, err = s.teamRepo.GetTeamByName(ctx, name)
 		if err != nil {
-			log.Warn("team not found")
+			log.WarnContext(ctx, "team not found")
 
 			team, err = s.createTeam(ctx, name, members)
 			return err
@@ -52,12 +60,12 @@ func (s *TeamService) createTeam(
 
 	_, err := s.teamRepo.SaveTeam(ctx, teamName, members)
 	if err != nil {
-		log.Error("failed to save team", logger.ErrAttr(err))
+		log.ErrorContext(ctx, "failed to save team", logger.ErrAttr(err))
 
 		return nil, fmt.Errorf("failed to save team: %w", err)
 	}
 
-	log.Info("new team saved")
+	log.InfoContext(ctx, "new team saved")
 
 	return &teamsDomain.Team{
 		Name:    teamName,
@@ -79,7 +87,7 @@ func (s *TeamService) updateExistingTeam(
 
 	err := team.UpdateMembers(newMembers)
 	if errors.Is(err, domain.ErrTeamMembersMismatch) {
-		log.Warn("members mismatch",
+		log.WarnContext(ctx, "members mismatch",
 			slog.Any("oldMembers", team.Members),
 			slog.Any("newMembers", newMembers),
 		)
@@ -87,21 +95,21 @@ func (s *TeamService) updateExistingTeam(
 		return nil, service.ErrTeamAlreadyExists
 	}
 	if err != nil {
-		log.Error("failed to update members", logger.ErrAttr(err))
+		log.ErrorContext(ctx, "failed to update members", logger.ErrAttr(err))
 
 		return nil, fmt.Errorf("failed to update members: %w", err)
 	}
 
-	log.Info("team members updated", slog.Any("team", team))
+	log.InfoContext(ctx, "team members updated", slog.Any("team", team))
 
 	err = s.teamRepo.UpdateMembers(ctx, team.Name, team.Members)
 	if err != nil {
-		log.Error("failed to update existing team", logger.ErrAttr(err))
+		log.ErrorContext(ctx, "failed to update existing team", logger.ErrAttr(err))
 
 		return nil, fmt.Errorf("failed to update existing team: %w", err)
 	}
 
-	log.Info("updated team members saved")
+	log.InfoContext(ctx, "updated team members saved")
 
 	return team, nil
 }
@@ -8,31 +8,35 @@ import (
 	teamsDomain "reviewer-assigner/internal/domain/teams"
 	"reviewer-assigner/internal/logger"
 	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
 )
 
 func (s *TeamService) GetTeam(
 	ctx context.Context,
 	name string,
-) (*teamsDomain.Team, error) {
+) (team *teamsDomain.Team, err error) {
 	const op = "services.teams.GetTeam"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
 	log := s.log.With(
 		slog.String("op", op),
 		slog.String("team_name", name),
 	)
 
-	team, err := s.teamRepo.GetTeamByName(ctx, name)
+	team, err = s.teamRepo.GetTeamByName(ctx, name)
 	if errors.Is(err, service.ErrTeamNotFound) {
-		log.Warn("team not found")
+		log.WarnContext(ctx, "team not found")
 
 		return nil, service.ErrTeamNotFound
 	}
 	if err != nil {
-		log.Error("failed to get team", logger.ErrAttr(err))
+		log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
 
 		return nil, fmt.Errorf("failed to get team: %w", err)
 	}
 
-	log.Info("got team", slog.Any("team", team))
+	log.InfoContext(ctx, "got team", slog.Any("team", team))
 
 	return team, nil
 }
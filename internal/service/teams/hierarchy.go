@@ -0,0 +1,102 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+// SetParent nests the team under parentName, a nil parentName makes it a top-level team.
+// The caller has to manage both the team and its new parent.
+func (s *TeamService) SetParent(
+	ctx context.Context,
+	teamName string,
+	parentName *string,
+) (team *teamsDomain.Team, err error) {
+	const op = "services.teams.SetParent"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+	if parentName != nil {
+		log = log.With(slog.String("parent_team_name", *parentName))
+	}
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err = s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+		if parentName != nil {
+			if err = s.checkCanManageTeam(ctx, log, *parentName); err != nil {
+				return err
+			}
+		}
+
+		err = s.teamRepo.SetParent(ctx, teamName, parentName)
+		if errors.Is(err, service.ErrTeamNotFound) {
+			log.WarnContext(ctx, "team not found")
+
+			return service.ErrTeamNotFound
+		}
+		if errors.Is(err, service.ErrTeamCycle) {
+			log.WarnContext(ctx, "parent team is the team itself or its subteam")
+
+			return service.ErrTeamCycle
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to set parent team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to set parent team: %w", err)
+		}
+
+		log.InfoContext(ctx, "parent team set")
+
+		team, err = s.getTeam(ctx, log, teamName)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// GetTree returns the teams nested under rootName, or the whole hierarchy when rootName is nil.
+func (s *TeamService) GetTree(
+	ctx context.Context,
+	rootName *string,
+) (tree []teamsDomain.TreeNode, err error) {
+	const op = "services.teams.GetTree"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(slog.String("op", op))
+	if rootName != nil {
+		log = log.With(slog.String("team_name", *rootName))
+	}
+
+	refs, err := s.teamRepo.GetTree(ctx, rootName)
+	if errors.Is(err, service.ErrTeamNotFound) {
+		log.WarnContext(ctx, "team not found")
+
+		return nil, service.ErrTeamNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get team tree", logger.ErrAttr(err))
+
+		return nil, fmt.Errorf("failed to get team tree: %w", err)
+	}
+
+	log.InfoContext(ctx, "got team tree", slog.Int("teams", len(refs)))
+
+	return teamsDomain.BuildTree(refs), nil
+}
@@ -0,0 +1,128 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+func (s *TeamService) RenameTeam(
+	ctx context.Context,
+	teamName string,
+	newTeamName string,
+) (team *teamsDomain.Team, err error) {
+	const op = "services.teams.RenameTeam"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.String("new_team_name", newTeamName),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err = s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+
+		team, err = s.getTeam(ctx, log, teamName)
+		if err != nil {
+			return err
+		}
+
+		if teamName == newTeamName {
+			return nil
+		}
+
+		err = s.teamRepo.RenameTeam(ctx, teamName, newTeamName)
+		if errors.Is(err, service.ErrTeamNotFound) {
+			log.WarnContext(ctx, "team not found")
+
+			return service.ErrTeamNotFound
+		}
+		if errors.Is(err, service.ErrTeamAlreadyExists) {
+			log.WarnContext(ctx, "team with the new name already exists")
+
+			return service.ErrTeamAlreadyExists
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to rename team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to rename team: %w", err)
+		}
+
+		log.InfoContext(ctx, "team renamed")
+
+		team.Name = newTeamName
+
+		return nil
+	})
+
+	return team, err
+}
+
+// DeleteTeam deletes a team without members and subteams together with its rules.
+func (s *TeamService) DeleteTeam(
+	ctx context.Context,
+	teamName string,
+) (err error) {
+	const op = "services.teams.DeleteTeam"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	return s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+
+		team, err := s.getTeam(ctx, log, teamName)
+		if err != nil {
+			return err
+		}
+
+		if !team.IsEmpty() {
+			log.WarnContext(ctx, "team has members", slog.Int("members", len(team.Members)))
+
+			return service.ErrTeamNotEmpty
+		}
+
+		subtree, err := s.teamRepo.GetTree(ctx, &teamName)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get subteams", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get subteams: %w", err)
+		}
+		if len(subtree) > 1 {
+			log.WarnContext(ctx, "team has subteams", slog.Int("subteams", len(subtree)-1))
+
+			return service.ErrTeamHasSubteams
+		}
+
+		err = s.teamRepo.DeleteTeam(ctx, teamName)
+		if errors.Is(err, service.ErrTeamNotEmpty) {
+			log.WarnContext(ctx, "team got members or subteams")
+
+			return service.ErrTeamNotEmpty
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to delete team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to delete team: %w", err)
+		}
+
+		log.InfoContext(ctx, "team deleted")
+
+		return nil
+	})
+}
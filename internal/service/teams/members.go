@@ -0,0 +1,216 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+func (s *TeamService) AddMember(
+	ctx context.Context,
+	teamName string,
+	member *teamsDomain.Member,
+) (team *teamsDomain.Team, err error) {
+	const op = "services.teams.AddMember"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.String("user_id", member.ID),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err = s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+
+		team, err = s.getTeam(ctx, log, teamName)
+		if err != nil {
+			return err
+		}
+
+		if team.HasMember(member.ID) {
+			log.WarnContext(ctx, "user is already a member of the team")
+
+			return service.ErrTeamMemberAlreadyExists
+		}
+
+		err = s.teamRepo.AddMember(ctx, teamName, member)
+		if errors.Is(err, service.ErrTeamMemberAlreadyExists) {
+			log.WarnContext(ctx, "user is already a member of the team")
+
+			return service.ErrTeamMemberAlreadyExists
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to add member", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to add member: %w", err)
+		}
+
+		log.InfoContext(ctx, "member added")
+
+		team.Members = append(team.Members, *member)
+
+		return nil
+	})
+
+	return team, err
+}
+
+// RemoveMember takes the user out of the team, the user stays known as an author and a reviewer.
+func (s *TeamService) RemoveMember(
+	ctx context.Context,
+	teamName string,
+	userID string,
+	openReviews teamsDomain.OpenReviews,
+) (team *teamsDomain.Team, reassignments []prsDomain.Reassignment, err error) {
+	const op = "services.teams.RemoveMember"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.String("user_id", userID),
+		slog.String("open_reviews", string(openReviews)),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err = s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+
+		team, reassignments, err = s.moveMember(ctx, log, userID, teamName, nil, openReviews)
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return team, reassignments, nil
+}
+
+// MoveMember moves the user to another team and returns the team the user joined.
+func (s *TeamService) MoveMember(
+	ctx context.Context,
+	userID string,
+	fromTeamName string,
+	toTeamName string,
+	openReviews teamsDomain.OpenReviews,
+) (team *teamsDomain.Team, reassignments []prsDomain.Reassignment, err error) {
+	const op = "services.teams.MoveMember"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.String("from_team_name", fromTeamName),
+		slog.String("to_team_name", toTeamName),
+		slog.String("open_reviews", string(openReviews)),
+	)
+
+	if fromTeamName == toTeamName {
+		log.WarnContext(ctx, "user is already a member of the team")
+
+		return nil, nil, service.ErrTeamMemberAlreadyExists
+	}
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		for _, teamName := range []string{fromTeamName, toTeamName} {
+			if err = s.checkCanManageTeam(ctx, log, teamName); err != nil {
+				return err
+			}
+		}
+
+		var toTeam *teamsDomain.Team
+		toTeam, err = s.getTeam(ctx, log, toTeamName)
+		if err != nil {
+			return err
+		}
+
+		if toTeam.HasMember(userID) {
+			log.WarnContext(ctx, "user is already a member of the target team")
+
+			return service.ErrTeamMemberAlreadyExists
+		}
+
+		team, reassignments, err = s.moveMember(ctx, log, userID, fromTeamName, &toTeamName, openReviews)
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return team, reassignments, nil
+}
+
+// moveMember handles the open reviews while the user is still in the old team, so they go to its members.
+// A nil toTeamName only takes the user out of the old team, then the old team is returned.
+func (s *TeamService) moveMember(
+	ctx context.Context,
+	log *slog.Logger,
+	userID string,
+	fromTeamName string,
+	toTeamName *string,
+	openReviews teamsDomain.OpenReviews,
+) (*teamsDomain.Team, []prsDomain.Reassignment, error) {
+	fromTeam, err := s.getTeam(ctx, log, fromTeamName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !fromTeam.HasMember(userID) {
+		log.WarnContext(ctx, "user is not a member of the team")
+
+		return nil, nil, service.ErrTeamMemberNotFound
+	}
+
+	var reassignments []prsDomain.Reassignment
+	if openReviews == teamsDomain.OpenReviewsReassign {
+		reassignments, err = s.reviewReassigner.ReassignOpenReviews(ctx, userID, fromTeamName)
+		if err != nil {
+			log.WarnContext(ctx, "failed to reassign open reviews", logger.ErrAttr(err))
+
+			return nil, nil, err
+		}
+
+		log.InfoContext(ctx, "open reviews reassigned", slog.Int("count", len(reassignments)))
+	}
+
+	err = s.teamRepo.MoveMember(ctx, userID, fromTeamName, toTeamName)
+	if errors.Is(err, service.ErrTeamMemberNotFound) {
+		log.WarnContext(ctx, "user is not a member of the team")
+
+		return nil, nil, service.ErrTeamMemberNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to move member", logger.ErrAttr(err))
+
+		return nil, nil, fmt.Errorf("failed to move member: %w", err)
+	}
+
+	log.InfoContext(ctx, "member moved")
+
+	teamName := fromTeamName
+	if toTeamName != nil {
+		teamName = *toTeamName
+	}
+
+	team, err := s.getTeam(ctx, log, teamName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return team, reassignments, nil
+}
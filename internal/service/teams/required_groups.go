@@ -0,0 +1,108 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+func (s *TeamService) GetRequiredGroups(ctx context.Context) (groups []teamsDomain.RequiredGroup, err error) {
+	const op = "services.teams.GetRequiredGroups"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(slog.String("op", op))
+
+	groups, err = s.teamRepo.GetRequiredGroups(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get required groups", logger.ErrAttr(err))
+
+		return nil, fmt.Errorf("failed to get required groups: %w", err)
+	}
+
+	log.InfoContext(ctx, "got required groups", slog.Any("groups", groups))
+
+	return groups, nil
+}
+
+// SetRequiredGroup makes the team review every PR the group matches, setting it again replaces
+// the labels and path prefixes. PRs created before keep their reviewers.
+func (s *TeamService) SetRequiredGroup(ctx context.Context, group *teamsDomain.RequiredGroup) (err error) {
+	const op = "services.teams.SetRequiredGroup"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Any("group", group),
+	)
+
+	if err = s.checkCanManageRequiredGroups(ctx, log); err != nil {
+		return err
+	}
+
+	err = group.Validate()
+	if errors.Is(err, domain.ErrRequiredGroupInvalid) {
+		log.WarnContext(ctx, "invalid required group", logger.ErrAttr(err))
+
+		return fmt.Errorf("%w: %w", service.ErrRequiredGroupInvalid, err)
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to validate required group", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to validate required group: %w", err)
+	}
+
+	err = s.teamRepo.SetRequiredGroup(ctx, group)
+	if errors.Is(err, service.ErrTeamNotFound) {
+		log.WarnContext(ctx, "team not found")
+
+		return service.ErrTeamNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to set required group", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to set required group: %w", err)
+	}
+
+	log.InfoContext(ctx, "required group set")
+
+	return nil
+}
+
+func (s *TeamService) RemoveRequiredGroup(ctx context.Context, teamName string) (err error) {
+	const op = "services.teams.RemoveRequiredGroup"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	if err = s.checkCanManageRequiredGroups(ctx, log); err != nil {
+		return err
+	}
+
+	err = s.teamRepo.DeleteRequiredGroup(ctx, teamName)
+	if errors.Is(err, service.ErrRequiredGroupNotFound) {
+		log.WarnContext(ctx, "required group not found")
+
+		return service.ErrRequiredGroupNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to remove required group", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to remove required group: %w", err)
+	}
+
+	log.InfoContext(ctx, "required group removed")
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+func (s *TeamService) GetReviewRules(
+	ctx context.Context,
+	teamName string,
+) (rules []teamsDomain.ReviewRule, err error) {
+	const op = "services.teams.GetReviewRules"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		_, err = s.teamRepo.GetTeamByName(ctx, teamName)
+		if errors.Is(err, service.ErrTeamNotFound) {
+			log.WarnContext(ctx, "team not found")
+
+			return service.ErrTeamNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+
+		rules, err = s.teamRepo.GetReviewRules(ctx, teamName)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get review rules", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get review rules: %w", err)
+		}
+
+		log.InfoContext(ctx, "got review rules", slog.Any("rules", rules))
+
+		return nil
+	})
+
+	return rules, err
+}
+
+// AddReviewRule checks that both the team and the extra team of the rule exist.
+func (s *TeamService) AddReviewRule(
+	ctx context.Context,
+	teamName string,
+	rule *teamsDomain.ReviewRule,
+) (err error) {
+	const op = "services.teams.AddReviewRule"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.Any("rule", rule),
+	)
+
+	return s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+
+		err := rule.Validate()
+		if errors.Is(err, domain.ErrReviewRuleInvalid) {
+			log.WarnContext(ctx, "invalid review rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("%w: %w", service.ErrTeamRuleInvalid, err)
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to validate review rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to validate review rule: %w", err)
+		}
+
+		for _, name := range []string{teamName, rule.ExtraTeamName} {
+			if name == "" {
+				continue
+			}
+
+			_, err = s.teamRepo.GetTeamByName(ctx, name)
+			if errors.Is(err, service.ErrTeamNotFound) {
+				log.WarnContext(ctx, "team not found", slog.String("not_found_team_name", name))
+
+				return service.ErrTeamNotFound
+			}
+			if err != nil {
+				log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+				return fmt.Errorf("failed to get team: %w", err)
+			}
+		}
+
+		err = s.teamRepo.AddReviewRule(ctx, teamName, rule)
+		if errors.Is(err, service.ErrTeamRuleAlreadyExists) {
+			log.WarnContext(ctx, "review rule already exists")
+
+			return service.ErrTeamRuleAlreadyExists
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to add review rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to add review rule: %w", err)
+		}
+
+		log.InfoContext(ctx, "review rule added")
+
+		return nil
+	})
+}
+
+func (s *TeamService) RemoveReviewRule(
+	ctx context.Context,
+	teamName string,
+	rule *teamsDomain.ReviewRule,
+) (err error) {
+	const op = "services.teams.RemoveReviewRule"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.Any("rule", rule),
+	)
+
+	if err = s.checkCanManageTeam(ctx, log, teamName); err != nil {
+		return err
+	}
+
+	err = s.teamRepo.DeleteReviewRule(ctx, teamName, rule)
+	if errors.Is(err, service.ErrTeamRuleNotFound) {
+		log.WarnContext(ctx, "review rule not found")
+
+		return service.ErrTeamRuleNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to remove review rule", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to remove review rule: %w", err)
+	}
+
+	log.InfoContext(ctx, "review rule removed")
+
+	return nil
+}
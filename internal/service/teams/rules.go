@@ -0,0 +1,181 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+func (s *TeamService) GetRules(
+	ctx context.Context,
+	teamName string,
+) (rules []teamsDomain.Rule, err error) {
+	const op = "services.teams.GetRules"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		_, err = s.teamRepo.GetTeamByName(ctx, teamName)
+		if errors.Is(err, service.ErrTeamNotFound) {
+			log.WarnContext(ctx, "team not found")
+
+			return service.ErrTeamNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+
+		rules, err = s.teamRepo.GetRules(ctx, teamName)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team rules", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team rules: %w", err)
+		}
+
+		log.InfoContext(ctx, "got team rules", slog.Any("rules", rules))
+
+		return nil
+	})
+
+	return rules, err
+}
+
+func (s *TeamService) AddRule(
+	ctx context.Context,
+	teamName string,
+	rule *teamsDomain.Rule,
+) (err error) {
+	const op = "services.teams.AddRule"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.Any("rule", rule),
+	)
+
+	return s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if errors.Is(err, service.ErrTeamNotFound) {
+			log.WarnContext(ctx, "team not found")
+
+			return service.ErrTeamNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+
+		err = team.ValidateRule(rule)
+		if errors.Is(err, domain.ErrRuleKindUnknown) ||
+			errors.Is(err, domain.ErrRuleInvalid) ||
+			errors.Is(err, domain.ErrRuleMemberNotInTeam) {
+			log.WarnContext(ctx, "invalid rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("%w: %w", service.ErrTeamRuleInvalid, err)
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to validate rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to validate rule: %w", err)
+		}
+
+		err = s.teamRepo.AddRule(ctx, teamName, rule)
+		if errors.Is(err, service.ErrTeamRuleAlreadyExists) {
+			log.WarnContext(ctx, "rule already exists")
+
+			return service.ErrTeamRuleAlreadyExists
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to add rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to add rule: %w", err)
+		}
+
+		log.InfoContext(ctx, "rule added")
+
+		return nil
+	})
+}
+
+func (s *TeamService) RemoveRule(
+	ctx context.Context,
+	teamName string,
+	rule *teamsDomain.Rule,
+) (err error) {
+	const op = "services.teams.RemoveRule"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.Any("rule", rule),
+	)
+
+	return s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+
+		_, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if errors.Is(err, service.ErrTeamNotFound) {
+			log.WarnContext(ctx, "team not found")
+
+			return service.ErrTeamNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+
+		// members may have left the team since the rule was added, so only the rule itself is validated
+		err = rule.Validate()
+		if errors.Is(err, domain.ErrRuleKindUnknown) || errors.Is(err, domain.ErrRuleInvalid) {
+			log.WarnContext(ctx, "invalid rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("%w: %w", service.ErrTeamRuleInvalid, err)
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to validate rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to validate rule: %w", err)
+		}
+
+		err = s.teamRepo.DeleteRule(ctx, teamName, rule)
+		if errors.Is(err, service.ErrTeamRuleNotFound) {
+			log.WarnContext(ctx, "rule not found")
+
+			return service.ErrTeamRuleNotFound
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to remove rule", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to remove rule: %w", err)
+		}
+
+		log.InfoContext(ctx, "rule removed")
+
+		return nil
+	})
+}
@@ -2,8 +2,13 @@ package teams
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
 	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2"
 )
@@ -12,20 +17,102 @@ type TeamRepository interface {
 	GetTeamByName(ctx context.Context, name string) (*teamsDomain.Team, error)
 	SaveTeam(ctx context.Context, name string, members []teamsDomain.Member) (int64, error)
 	UpdateMembers(ctx context.Context, name string, newMembers []teamsDomain.Member) error
+	AddMember(ctx context.Context, name string, member *teamsDomain.Member) error
+	MoveMember(ctx context.Context, userID, fromName string, toName *string) error
+	RenameTeam(ctx context.Context, name, newName string) error
+	DeleteTeam(ctx context.Context, name string) error
+	SetParent(ctx context.Context, name string, parentName *string) error
+	GetTree(ctx context.Context, rootName *string) ([]teamsDomain.TeamRef, error)
+	GetRules(ctx context.Context, name string) ([]teamsDomain.Rule, error)
+	AddRule(ctx context.Context, name string, rule *teamsDomain.Rule) error
+	DeleteRule(ctx context.Context, name string, rule *teamsDomain.Rule) error
+	GetReviewRules(ctx context.Context, name string) ([]teamsDomain.ReviewRule, error)
+	AddReviewRule(ctx context.Context, name string, rule *teamsDomain.ReviewRule) error
+	DeleteReviewRule(ctx context.Context, name string, rule *teamsDomain.ReviewRule) error
+	GetRequiredGroups(ctx context.Context) ([]teamsDomain.RequiredGroup, error)
+	SetRequiredGroup(ctx context.Context, group *teamsDomain.RequiredGroup) error
+	DeleteRequiredGroup(ctx context.Context, name string) error
+}
+
+type ReviewReassigner interface {
+	ReassignOpenReviews(ctx context.Context, reviewerID, teamName string) ([]prsDomain.Reassignment, error)
+}
+
+type Policy interface {
+	CanManageTeam(ctx context.Context, teamName string) error
+	CanManageRequiredGroups(ctx context.Context) error
 }
 
 type TeamService struct {
-	teamRepo TeamRepository
+	teamRepo         TeamRepository
+	reviewReassigner ReviewReassigner
+	policy           Policy
 
 	txManager trm.Manager
 
 	log *slog.Logger
 }
 
-func NewTeamService(log *slog.Logger, teamRepo TeamRepository, txManager trm.Manager) *TeamService {
+func NewTeamService(
+	log *slog.Logger,
+	teamRepo TeamRepository,
+	reviewReassigner ReviewReassigner,
+	policy Policy,
+	txManager trm.Manager,
+) *TeamService {
 	return &TeamService{
-		teamRepo:  teamRepo,
-		txManager: txManager,
-		log:       log,
+		teamRepo:         teamRepo,
+		reviewReassigner: reviewReassigner,
+		policy:           policy,
+		txManager:        txManager,
+		log:              log,
 	}
 }
+
+func (s *TeamService) checkCanManageTeam(ctx context.Context, log *slog.Logger, teamName string) error {
+	err := s.policy.CanManageTeam(ctx, teamName)
+	if errors.Is(err, service.ErrForbidden) {
+		log.WarnContext(ctx, "actor may not manage team")
+
+		return service.ErrForbidden
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to check access: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TeamService) checkCanManageRequiredGroups(ctx context.Context, log *slog.Logger) error {
+	err := s.policy.CanManageRequiredGroups(ctx)
+	if errors.Is(err, service.ErrForbidden) {
+		log.WarnContext(ctx, "actor may not manage required groups")
+
+		return service.ErrForbidden
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to check access: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TeamService) getTeam(ctx context.Context, log *slog.Logger, teamName string) (*teamsDomain.Team, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if errors.Is(err, service.ErrTeamNotFound) {
+		log.WarnContext(ctx, "team not found", slog.String("team_name", teamName))
+
+		return nil, service.ErrTeamNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get team", logger.ErrAttr(err))
+
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	return team, nil
+}
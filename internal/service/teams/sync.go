@@ -0,0 +1,136 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+// errDryRun rolls back the transaction of a dry run after the diff is applied.
+var errDryRun = errors.New("dry run")
+
+// SyncTeam brings the team to the full roster and returns the diff against the members before the sync.
+// The diff is applied in one transaction: new members join first,
+// so they can take over the open reviews of the removed ones.
+// A dry run applies the diff the same way and rolls it back, so it reports the reassignments
+// and fails when a sync would.
+func (s *TeamService) SyncTeam(
+	ctx context.Context,
+	teamName string,
+	roster []teamsDomain.Member,
+	dryRun bool,
+) (team *teamsDomain.Team, diff *teamsDomain.MembersDiff, reassignments []prsDomain.Reassignment, err error) {
+	const op = "services.teams.SyncTeam"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+		slog.Bool("dry_run", dryRun),
+	)
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err = s.checkCanManageTeam(ctx, log, teamName); err != nil {
+			return err
+		}
+
+		team, err = s.getTeam(ctx, log, teamName)
+		if err != nil {
+			return err
+		}
+
+		membersDiff := team.Diff(roster)
+		diff = &membersDiff
+
+		log.InfoContext(ctx, "team diff computed",
+			slog.Int("added", len(diff.Added)),
+			slog.Int("removed", len(diff.Removed)),
+			slog.Int("renamed", len(diff.Renamed)),
+			slog.Int("activity_changed", len(diff.ActivityChanged)),
+		)
+
+		if diff.IsEmpty() {
+			return nil
+		}
+
+		team, reassignments, err = s.applyDiff(ctx, log, teamName, diff)
+		if err == nil && dryRun {
+			return errDryRun
+		}
+
+		return err
+	})
+	if errors.Is(err, errDryRun) {
+		log.InfoContext(ctx, "dry run rolled back")
+
+		err = nil
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return team, diff, reassignments, nil
+}
+
+func (s *TeamService) applyDiff(
+	ctx context.Context,
+	log *slog.Logger,
+	teamName string,
+	diff *teamsDomain.MembersDiff,
+) (*teamsDomain.Team, []prsDomain.Reassignment, error) {
+	for _, member := range diff.Added {
+		err := s.teamRepo.AddMember(ctx, teamName, &member)
+		if errors.Is(err, service.ErrTeamMemberAlreadyExists) {
+			log.WarnContext(ctx, "user is already a member of the team", slog.String("user_id", member.ID))
+
+			return nil, nil, &service.MemberError{UserID: member.ID, Err: service.ErrTeamMemberAlreadyExists}
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to add member", logger.ErrAttr(err))
+
+			return nil, nil, fmt.Errorf("failed to add member: %w", err)
+		}
+	}
+
+	if updated := diff.Updated(); len(updated) > 0 {
+		if err := s.teamRepo.UpdateMembers(ctx, teamName, updated); err != nil {
+			log.ErrorContext(ctx, "failed to update members", logger.ErrAttr(err))
+
+			return nil, nil, fmt.Errorf("failed to update members: %w", err)
+		}
+	}
+
+	// members leave one by one, so reviews of a removed member never land on another removed one
+	var reassignments []prsDomain.Reassignment
+	for _, member := range diff.Removed {
+		_, memberReassignments, err := s.moveMember(
+			ctx,
+			log.With(slog.String("user_id", member.ID)),
+			member.ID,
+			teamName,
+			nil,
+			teamsDomain.OpenReviewsReassign,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reassignments = append(reassignments, memberReassignments...)
+	}
+
+	log.InfoContext(ctx, "team diff applied", slog.Int("reassignments", len(reassignments)))
+
+	team, err := s.getTeam(ctx, log, teamName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return team, reassignments, nil
+}
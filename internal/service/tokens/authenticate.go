@@ -0,0 +1,44 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	tokensDomain "reviewer-assigner/internal/domain/tokens"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+// Authenticate resolves a plain bearer token into a live token.
+func (s *TokenService) Authenticate(
+	ctx context.Context,
+	plain string,
+) (token *tokensDomain.Token, err error) {
+	const op = "services.tokens.Authenticate"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(slog.String("op", op))
+
+	token, err = s.tokenRepo.GetTokenByHash(ctx, tokensDomain.Hash(plain))
+	if errors.Is(err, service.ErrTokenNotFound) {
+		log.WarnContext(ctx, "unknown token")
+
+		return nil, service.ErrTokenNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get token", logger.ErrAttr(err))
+
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	if token.IsRevoked() {
+		log.WarnContext(ctx, "revoked token", slog.String("token_id", token.ID))
+
+		return nil, service.ErrTokenRevoked
+	}
+
+	return token, nil
+}
@@ -0,0 +1,65 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/domain"
+	"reviewer-assigner/internal/domain/access"
+	tokensDomain "reviewer-assigner/internal/domain/tokens"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+// Issue creates a token and returns its plain value, which is never stored.
+func (s *TokenService) Issue(
+	ctx context.Context,
+	actor string,
+	role access.Role,
+	scopes []access.Scope,
+) (token *tokensDomain.Token, plain string, err error) {
+	const op = "services.tokens.Issue"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("actor", actor),
+		slog.String("role", string(role)),
+	)
+
+	err = s.policy.CanManageTokens(ctx)
+	if errors.Is(err, service.ErrForbidden) {
+		log.WarnContext(ctx, "actor may not issue tokens")
+
+		return nil, "", service.ErrForbidden
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+		return nil, "", fmt.Errorf("failed to check access: %w", err)
+	}
+
+	token, plain, err = tokensDomain.New(actor, role, scopes)
+	if errors.Is(err, domain.ErrTokenInvalid) {
+		log.WarnContext(ctx, "invalid token", logger.ErrAttr(err))
+
+		return nil, "", service.ErrTokenInvalid
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create token: %w", err)
+	}
+
+	err = s.tokenRepo.SaveToken(ctx, token)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to save token", logger.ErrAttr(err))
+
+		return nil, "", fmt.Errorf("failed to save token: %w", err)
+	}
+
+	log.InfoContext(ctx, "token issued", slog.String("token_id", token.ID), slog.Any("scopes", token.Scopes))
+
+	return token, plain, nil
+}
@@ -0,0 +1,50 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+func (s *TokenService) Revoke(ctx context.Context, tokenID string) (err error) {
+	const op = "services.tokens.Revoke"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("token_id", tokenID),
+	)
+
+	err = s.policy.CanManageTokens(ctx)
+	if errors.Is(err, service.ErrForbidden) {
+		log.WarnContext(ctx, "actor may not revoke tokens")
+
+		return service.ErrForbidden
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to check access: %w", err)
+	}
+
+	err = s.tokenRepo.RevokeToken(ctx, tokenID)
+	if errors.Is(err, service.ErrTokenNotFound) {
+		log.WarnContext(ctx, "token not found or already revoked")
+
+		return service.ErrTokenNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to revoke token", logger.ErrAttr(err))
+
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	log.InfoContext(ctx, "token revoked")
+
+	return nil
+}
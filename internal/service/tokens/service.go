@@ -0,0 +1,32 @@
+package tokens
+
+import (
+	"context"
+	"log/slog"
+	tokensDomain "reviewer-assigner/internal/domain/tokens"
+)
+
+type TokenRepository interface {
+	SaveToken(ctx context.Context, token *tokensDomain.Token) error
+	GetTokenByHash(ctx context.Context, hash string) (*tokensDomain.Token, error)
+	RevokeToken(ctx context.Context, tokenID string) error
+}
+
+type Policy interface {
+	CanManageTokens(ctx context.Context) error
+}
+
+type TokenService struct {
+	tokenRepo TokenRepository
+	policy    Policy
+
+	log *slog.Logger
+}
+
+func NewTokenService(log *slog.Logger, tokenRepo TokenRepository, policy Policy) *TokenService {
+	return &TokenService{
+		tokenRepo: tokenRepo,
+		policy:    policy,
+		log:       log,
+	}
+}
@@ -0,0 +1,68 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	usersDomain "reviewer-assigner/internal/domain/users"
+	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
+)
+
+func (s *UserService) GetUser(
+	ctx context.Context,
+	userID string,
+) (user *usersDomain.User, err error) {
+	const op = "services.users.GetUser"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	user, err = s.userRepo.GetUserByID(ctx, userID)
+	if errors.Is(err, service.ErrUserNotFound) {
+		log.WarnContext(ctx, "user not found")
+
+		return nil, service.ErrUserNotFound
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get user", logger.ErrAttr(err))
+
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	log.InfoContext(ctx, "got user", slog.Any("user", user))
+
+	return user, nil
+}
+
+// ListUsers returns a page of the user directory and the total number of users matching the filter.
+func (s *UserService) ListUsers(
+	ctx context.Context,
+	filter *usersDomain.Filter,
+) (users []usersDomain.User, total int, err error) {
+	const op = "services.users.ListUsers"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Any("filter", filter),
+	)
+
+	users, total, err = s.userRepo.ListUsers(ctx, filter)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to list users", logger.ErrAttr(err))
+
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	log.InfoContext(ctx, "got users", slog.Int("users", len(users)), slog.Int("total", total))
+
+	return users, total, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reviewer-assigner/internal/logger"
+	"reviewer-assigner/internal/tracing"
 
 	prDomain "reviewer-assigner/internal/domain/pullrequests"
 )
@@ -12,21 +13,24 @@ import (
 func (s *UserService) GetReview(
 	ctx context.Context,
 	userID string,
-) ([]prDomain.PullRequestShort, error) {
+) (prsForReview []prDomain.PullRequestShort, err error) {
 	const op = "services.users.GetReview"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
 	log := s.log.With(
 		slog.String("op", op),
 		slog.String("user_id", userID),
 	)
 
-	prsForReview, err := s.prRepo.GetPullRequestsForReview(ctx, userID)
+	prsForReview, err = s.prRepo.GetPullRequestsForReview(ctx, userID)
 	if err != nil {
-		log.Error("failed to get pull requests for review", logger.ErrAttr(err))
+		log.ErrorContext(ctx, "failed to get pull requests for review", logger.ErrAttr(err))
 
 		return nil, fmt.Errorf("failed to get pull requests for review: %w", err)
 	}
 
-	log.Info("got pull requests for review", slog.Any("pull_requests", prsForReview))
+	log.InfoContext(ctx, "got pull requests for review", slog.Any("pull_requests", prsForReview))
 
 	return prsForReview, nil
 }
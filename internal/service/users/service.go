@@ -12,6 +12,7 @@ import (
 type UserRepository interface {
 	GetUserByID(ctx context.Context, userID string) (*usersDomain.User, error)
 	UpdateIsActive(ctx context.Context, user *usersDomain.User) error
+	ListUsers(ctx context.Context, filter *usersDomain.Filter) ([]usersDomain.User, int, error)
 }
 
 type PullRequestRepository interface {
@@ -21,9 +22,14 @@ type PullRequestRepository interface {
 	) ([]prsDomain.PullRequestShort, error)
 }
 
+type Policy interface {
+	CanManageUser(ctx context.Context, user *usersDomain.User) error
+}
+
 type UserService struct {
 	userRepo UserRepository
 	prRepo   PullRequestRepository
+	policy   Policy
 
 	txManager trm.Manager
 
@@ -34,11 +40,13 @@ func NewUserService(
 	log *slog.Logger,
 	userRepo UserRepository,
 	prRepo PullRequestRepository,
+	policy Policy,
 	txManager trm.Manager,
 ) *UserService {
 	return &UserService{
 		userRepo:  userRepo,
 		prRepo:    prRepo,
+		policy:    policy,
 		log:       log,
 		txManager: txManager,
 	}
@@ -8,6 +8,7 @@ import (
 	usersDomain "reviewer-assigner/internal/domain/users"
 	"reviewer-assigner/internal/logger"
 	"reviewer-assigner/internal/service"
+	"reviewer-assigner/internal/tracing"
 )
 
 func (s *UserService) SetIsActive(
@@ -16,6 +17,9 @@ func (s *UserService) SetIsActive(
 	isActive bool,
 ) (user *usersDomain.User, err error) {
 	const op = "services.users.SetIsActive"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, err) }()
+
 	log := s.log.With(
 		slog.String("op", op),
 		slog.String("user_id", userID),
@@ -24,35 +28,47 @@ func (s *UserService) SetIsActive(
 	err = s.txManager.Do(ctx, func(ctx context.Context) error {
 		user, err = s.userRepo.GetUserByID(ctx, userID)
 		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+			log.WarnContext(ctx, "user not found")
 
 			return service.ErrUserNotFound
 		}
 		if err != nil {
-			log.Error("failed to get user", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to get user", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to get user: %w", err)
 		}
 
-		log.Info("got user", slog.Any("user", user))
+		log.InfoContext(ctx, "got user", slog.Any("user", user))
+
+		err = s.policy.CanManageUser(ctx, user)
+		if errors.Is(err, service.ErrForbidden) {
+			log.WarnContext(ctx, "actor may not manage user")
+
+			return service.ErrForbidden
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "failed to check access", logger.ErrAttr(err))
+
+			return fmt.Errorf("failed to check access: %w", err)
+		}
 
 		err = user.SetIsActive(isActive)
 		if err != nil {
-			log.Error("failed to set is active", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to set is active", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to set is active: %w", err)
 		}
 
-		log.Info("update is active", slog.Bool("is_active", user.IsActive))
+		log.InfoContext(ctx, "update is active", slog.Bool("is_active", user.IsActive))
 
 		err = s.userRepo.UpdateIsActive(ctx, user)
 		if err != nil {
-			log.Error("failed to update is active", logger.ErrAttr(err))
+			log.ErrorContext(ctx, "failed to update is active", logger.ErrAttr(err))
 
 			return fmt.Errorf("failed to update is active: %w", service.ErrUserNotFound)
 		}
 
-		log.Info("user saved")
+		log.InfoContext(ctx, "user saved")
 
 		return nil
 	})
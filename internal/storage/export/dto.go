@@ -0,0 +1,66 @@
+package export
+
+import (
+	"reviewer-assigner/internal/http/handlers/export"
+	"time"
+)
+
+type TeamRowDB struct {
+	TeamName       string   `db:"team_name"`
+	ParentTeamName string   `db:"parent_team_name"`
+	MemberIDs      []string `db:"member_ids"`
+}
+
+func DBToTeamRow(t *TeamRowDB) export.TeamRow {
+	return export.TeamRow{
+		TeamName:       t.TeamName,
+		ParentTeamName: t.ParentTeamName,
+		MemberIDs:      t.MemberIDs,
+	}
+}
+
+type PullRequestRowDB struct {
+	ID          string     `db:"pull_request_id"`
+	Name        string     `db:"pull_request_name"`
+	AuthorID    string     `db:"author_id"`
+	TeamName    string     `db:"team_name"`
+	Status      string     `db:"status"`
+	ReviewerIDs []string   `db:"reviewer_ids"`
+	CreatedAt   time.Time  `db:"created_at"`
+	MergedAt    *time.Time `db:"merged_at"`
+}
+
+func DBToPullRequestRow(p *PullRequestRowDB) export.PullRequestRow {
+	return export.PullRequestRow{
+		ID:          p.ID,
+		Name:        p.Name,
+		AuthorID:    p.AuthorID,
+		TeamName:    p.TeamName,
+		Status:      p.Status,
+		ReviewerIDs: p.ReviewerIDs,
+		CreatedAt:   p.CreatedAt,
+		MergedAt:    p.MergedAt,
+	}
+}
+
+type AssignmentRowDB struct {
+	PullRequestID string     `db:"pull_request_id"`
+	TeamName      string     `db:"team_name"`
+	ReviewerID    string     `db:"reviewer_id"`
+	GroupTeamName string     `db:"group_team_name"`
+	ApprovedAt    *time.Time `db:"approved_at"`
+	DeclinedAt    *time.Time `db:"declined_at"`
+	DeclineReason string     `db:"decline_reason"`
+}
+
+func DBToAssignmentRow(a *AssignmentRowDB) export.AssignmentRow {
+	return export.AssignmentRow{
+		PullRequestID: a.PullRequestID,
+		TeamName:      a.TeamName,
+		ReviewerID:    a.ReviewerID,
+		GroupTeamName: a.GroupTeamName,
+		ApprovedAt:    a.ApprovedAt,
+		DeclinedAt:    a.DeclinedAt,
+		DeclineReason: a.DeclineReason,
+	}
+}
@@ -0,0 +1,215 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"reviewer-assigner/internal/http/handlers/export"
+
+	"github.com/jackc/pgx/v5"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// fetchSize rows are held in memory at a time, whatever the size of the export.
+const fetchSize = 1000
+
+type PostgresExportRepository struct {
+	pool   *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewPostgresExportRepository(
+	pool *pgxpool.Pool,
+	getter *trmpgx.CtxGetter,
+) *PostgresExportRepository {
+	return &PostgresExportRepository{
+		pool:   pool,
+		getter: getter,
+	}
+}
+
+func (r *PostgresExportRepository) ExportTeams(ctx context.Context, yield func(export.TeamRow) error) error {
+	const query = `
+	SELECT
+		t.name AS team_name,
+		COALESCE(p.name, '') AS parent_team_name,
+		ARRAY(
+			SELECT u.user_id FROM team_members tm
+			JOIN users u ON u.id = tm.user_id
+			WHERE tm.team_id = t.id
+			ORDER BY u.user_id
+		)::text[] AS member_ids
+	FROM teams t
+	LEFT JOIN teams p ON p.id = t.parent_id
+	ORDER BY t.id
+	`
+
+	return r.stream(ctx, func(tx pgx.Tx) error {
+		return streamCursor(ctx, tx, query, nil, DBToTeamRow, yield)
+	})
+}
+
+// ExportPullRequests returns PRs created within the filter period in the order they were stored.
+func (r *PostgresExportRepository) ExportPullRequests(
+	ctx context.Context,
+	filter *export.Filter,
+	yield func(export.PullRequestRow) error,
+) error {
+	const query = `
+	WITH RECURSIVE subtree AS (
+		SELECT t.id FROM teams t WHERE t.name = $3::text
+		UNION ALL
+		SELECT c.id FROM subtree s
+		JOIN teams c ON c.parent_id = s.id
+	)
+	SELECT
+		pr.pull_request_id,
+		pr.name AS pull_request_name,
+		pr.author_id,
+		COALESCE(t.name, '') AS team_name,
+		pr.status::text AS status,
+		ARRAY(
+			SELECT u.user_id FROM pull_request_reviewers prr
+			JOIN users u ON u.id = prr.reviewer_id
+			WHERE prr.pull_request_id = pr.id
+			ORDER BY u.user_id
+		)::text[] AS reviewer_ids,
+		pr.created_at,
+		pr.merged_at
+	FROM pull_requests pr
+	LEFT JOIN teams t ON t.id = pr.team_id
+	WHERE ($1::timestamp IS NULL OR pr.created_at >= $1::timestamp)
+		AND ($2::timestamp IS NULL OR pr.created_at < $2::timestamp)
+		AND ($3::text IS NULL OR pr.team_id IN (SELECT id FROM subtree))
+	ORDER BY pr.id
+	`
+
+	return r.stream(ctx, func(tx pgx.Tx) error {
+		return streamCursor(ctx, tx, query, filterArgs(filter), DBToPullRequestRow, yield)
+	})
+}
+
+// ExportAssignments returns every reviewer offered a PR created within the filter period,
+// declined ones included: a reviewer leaves pull_request_reviewers on decline.
+func (r *PostgresExportRepository) ExportAssignments(
+	ctx context.Context,
+	filter *export.Filter,
+	yield func(export.AssignmentRow) error,
+) error {
+	const query = `
+	WITH RECURSIVE subtree AS (
+		SELECT t.id FROM teams t WHERE t.name = $3::text
+		UNION ALL
+		SELECT c.id FROM subtree s
+		JOIN teams c ON c.parent_id = s.id
+	),
+	offers AS (
+		SELECT
+			prr.pull_request_id,
+			prr.reviewer_id,
+			prr.group_team_id,
+			prr.approved_at,
+			NULL::timestamp AS declined_at,
+			'' AS decline_reason
+		FROM pull_request_reviewers prr
+		UNION ALL
+		SELECT
+			rd.pull_request_id,
+			rd.reviewer_id,
+			NULL::bigint AS group_team_id,
+			NULL::timestamp AS approved_at,
+			rd.declined_at,
+			rd.reason::text AS decline_reason
+		FROM review_declines rd
+	)
+	SELECT
+		pr.pull_request_id,
+		COALESCE(t.name, '') AS team_name,
+		u.user_id AS reviewer_id,
+		COALESCE(g.name, '') AS group_team_name,
+		o.approved_at,
+		o.declined_at,
+		o.decline_reason
+	FROM pull_requests pr
+	JOIN offers o ON o.pull_request_id = pr.id
+	JOIN users u ON u.id = o.reviewer_id
+	LEFT JOIN teams t ON t.id = pr.team_id
+	LEFT JOIN teams g ON g.id = o.group_team_id
+	WHERE ($1::timestamp IS NULL OR pr.created_at >= $1::timestamp)
+		AND ($2::timestamp IS NULL OR pr.created_at < $2::timestamp)
+		AND ($3::text IS NULL OR pr.team_id IN (SELECT id FROM subtree))
+	ORDER BY pr.id, u.user_id
+	`
+
+	return r.stream(ctx, func(tx pgx.Tx) error {
+		return streamCursor(ctx, tx, query, filterArgs(filter), DBToAssignmentRow, yield)
+	})
+}
+
+// stream runs fn in a read-only transaction, a cursor lives only within one
+// and the snapshot keeps the rows consistent between fetches.
+func (r *PostgresExportRepository) stream(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := r.getter.DefaultTrOrDB(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err = tx.Exec(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY"); err != nil {
+		return fmt.Errorf("failed to set transaction mode: %w", err)
+	}
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// streamCursor declares a cursor for query and fetches it by fetchSize rows,
+// so the export never holds the whole result either here or in the driver.
+func streamCursor[DB any, Row any](
+	ctx context.Context,
+	tx pgx.Tx,
+	query string,
+	args []any,
+	toRow func(*DB) Row,
+	yield func(Row) error,
+) error {
+	if _, err := tx.Exec(ctx, "DECLARE export_cursor NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return fmt.Errorf("failed to declare cursor: %w", err)
+	}
+
+	fetchQuery := fmt.Sprintf("FETCH FORWARD %d FROM export_cursor", fetchSize)
+	for {
+		rows, _ := tx.Query(ctx, fetchQuery)
+		batch, err := pgx.CollectRows(rows, pgx.RowToStructByName[DB])
+		if err != nil {
+			return fmt.Errorf("failed to fetch rows: %w", err)
+		}
+
+		for i := range batch {
+			if err = yield(toRow(&batch[i])); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < fetchSize {
+			return nil
+		}
+	}
+}
+
+func filterArgs(filter *export.Filter) []any {
+	var teamName *string
+	if filter.TeamName != "" {
+		teamName = &filter.TeamName
+	}
+
+	return []any{filter.From, filter.To, teamName}
+}
@@ -10,6 +10,7 @@ type PullRequestShortDB struct {
 	PullRequestID string             `db:"pull_request_id"`
 	Name          string             `db:"name"`
 	AuthorID      string             `db:"author_id"`
+	TeamName      string             `db:"team_name"`
 	Status        prsDomain.StatusPR `db:"status"`
 }
 
@@ -20,11 +21,24 @@ type PullRequestDB struct {
 	MergedAt  *time.Time `db:"merged_at"`
 }
 
+type PullRequestWithReviewersDB struct {
+	PullRequestDB
+
+	Reviewers []string `db:"reviewers"`
+}
+
+type ReviewerDB struct {
+	UserID    string `db:"user_id"`
+	GroupName string `db:"group_name"`
+	Approved  bool   `db:"approved"`
+}
+
 func DBShortToDomainPullRequestShort(d *PullRequestShortDB) *prsDomain.PullRequestShort {
 	return &prsDomain.PullRequestShort{
 		ID:       d.PullRequestID,
 		Name:     d.Name,
 		AuthorID: d.AuthorID,
+		TeamName: d.TeamName,
 		Status:   d.Status,
 	}
 }
@@ -35,9 +49,33 @@ func DBToDomainPullRequest(d *PullRequestDB) *prsDomain.PullRequest {
 			ID:       d.PullRequestID,
 			Name:     d.Name,
 			AuthorID: d.AuthorID,
+			TeamName: d.TeamName,
 			Status:   d.Status,
 		},
 		CreatedAt: d.CreatedAt,
 		MergedAt:  d.MergedAt,
 	}
 }
+
+func DBWithReviewersToDomainPullRequest(d *PullRequestWithReviewersDB) *prsDomain.PullRequest {
+	pullRequest := DBToDomainPullRequest(&d.PullRequestDB)
+	pullRequest.AssignedReviewers = d.Reviewers
+
+	return pullRequest
+}
+
+func setDBReviewers(pullRequest *prsDomain.PullRequest, reviewers []ReviewerDB) {
+	pullRequest.AssignedReviewers = make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		pullRequest.AssignedReviewers = append(pullRequest.AssignedReviewers, reviewer.UserID)
+		if reviewer.GroupName != "" {
+			if pullRequest.GroupReviewers == nil {
+				pullRequest.GroupReviewers = make(map[string]string)
+			}
+			pullRequest.GroupReviewers[reviewer.UserID] = reviewer.GroupName
+		}
+		if reviewer.Approved {
+			pullRequest.ApprovedReviewers = append(pullRequest.ApprovedReviewers, reviewer.UserID)
+		}
+	}
+}
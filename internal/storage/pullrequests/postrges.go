@@ -33,9 +33,12 @@ func (r *PostgresPullRequestRepository) GetPullRequestsForReview(
 	userID string,
 ) ([]prsDomain.PullRequestShort, error) {
 	const query = `
-	SELECT prs.id, prs.pull_request_id, prs.name, prs.author_id, prs.status FROM pull_requests prs
+	SELECT
+	    prs.id, prs.pull_request_id, prs.name, prs.author_id, COALESCE(t.name, '') team_name, prs.status
+	FROM pull_requests prs
 	JOIN pull_request_reviewers prr on prs.id = prr.pull_request_id
 	JOIN users u on u.id = prr.reviewer_id
+	LEFT JOIN teams t ON t.id = prs.team_id
 	WHERE u.user_id = $1
 	`
 
@@ -64,9 +67,11 @@ func (r *PostgresPullRequestRepository) GetByID(
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	const queryGetPullRequest = `
-	SELECT 
-	    prs.id, prs.pull_request_id, prs.name, prs.author_id, prs.status, prs.created_at, prs.merged_at 
+	SELECT
+	    prs.id, prs.pull_request_id, prs.name, prs.author_id, COALESCE(t.name, '') team_name,
+	    prs.status, prs.created_at, prs.merged_at
 	FROM pull_requests prs
+	LEFT JOIN teams t ON t.id = prs.team_id
 	WHERE prs.pull_request_id = $1
 	`
 
@@ -80,23 +85,79 @@ func (r *PostgresPullRequestRepository) GetByID(
 	}
 
 	const queryGetReviewers = `
-	SELECT u.user_id FROM users u
+	SELECT u.user_id, COALESCE(gt.name, '') AS group_name, prr.approved_at IS NOT NULL AS approved
+	FROM users u
 	JOIN pull_request_reviewers prr ON u.id = prr.reviewer_id
+	LEFT JOIN teams gt ON gt.id = prr.group_team_id
 	WHERE prr.pull_request_id = $1
 	`
 
 	rows, _ = tx.Query(ctx, queryGetReviewers, pullRequestDB.ID)
-	reviewers, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	reviewers, err := pgx.CollectRows(rows, pgx.RowToStructByName[ReviewerDB])
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pull request reviewers: %w", err)
 	}
 
+	const queryGetDeclined = `
+	SELECT u.user_id FROM users u
+	JOIN review_declines rd ON u.id = rd.reviewer_id
+	WHERE rd.pull_request_id = $1
+	ORDER BY rd.declined_at, u.user_id
+	`
+
+	rows, _ = tx.Query(ctx, queryGetDeclined, pullRequestDB.ID)
+	declined, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request declines: %w", err)
+	}
+
 	pullRequest := DBToDomainPullRequest(pullRequestDB)
-	pullRequest.AssignedReviewers = reviewers
+	setDBReviewers(pullRequest, reviewers)
+	pullRequest.DeclinedReviewers = declined
 
 	return pullRequest, nil
 }
 
+// GetCreatedByTeamSince returns pull requests targeting the team, oldest first.
+func (r *PostgresPullRequestRepository) GetCreatedByTeamSince(
+	ctx context.Context,
+	teamName string,
+	since time.Time,
+) ([]prsDomain.PullRequest, error) {
+	const query = `
+	SELECT
+	    prs.id, prs.pull_request_id, prs.name, prs.author_id, t.name team_name,
+	    prs.status, prs.created_at, prs.merged_at,
+	    COALESCE(
+	        array_agg(r.user_id ORDER BY r.user_id) FILTER (WHERE r.user_id IS NOT NULL),
+	        '{}'
+	    ) AS reviewers
+	FROM pull_requests prs
+	JOIN teams t ON t.id = prs.team_id
+	LEFT JOIN pull_request_reviewers prr ON prr.pull_request_id = prs.id
+	LEFT JOIN users r ON r.id = prr.reviewer_id
+	WHERE t.name = $1 AND prs.created_at >= $2
+	GROUP BY prs.id, t.name
+	ORDER BY prs.created_at, prs.id
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamName, since)
+	pullRequestsDB, err := pgx.CollectRows(
+		rows,
+		pgx.RowToStructByName[PullRequestWithReviewersDB],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull requests: %w", err)
+	}
+
+	pullRequests := make([]prsDomain.PullRequest, 0, len(pullRequestsDB))
+	for _, pr := range pullRequestsDB {
+		pullRequests = append(pullRequests, *DBWithReviewersToDomainPullRequest(&pr))
+	}
+
+	return pullRequests, nil
+}
+
 func (r *PostgresPullRequestRepository) Create(
 	ctx context.Context,
 	pullRequest *prsDomain.PullRequest,
@@ -108,8 +169,8 @@ func (r *PostgresPullRequestRepository) Create(
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	const queryInsertPR = `
-	INSERT INTO pull_requests (pull_request_id, name, author_id, status) 
-	VALUES ($1, $2, $3, $4)
+	INSERT INTO pull_requests (pull_request_id, name, author_id, status, team_id)
+	VALUES ($1, $2, $3, $4, (SELECT id FROM teams WHERE name = $5))
 	ON CONFLICT DO NOTHING
 	RETURNING id, pull_request_id
 	`
@@ -124,6 +185,7 @@ func (r *PostgresPullRequestRepository) Create(
 			pullRequest.Name,
 			pullRequest.AuthorID,
 			pullRequest.Status,
+			pullRequest.TeamName,
 		).
 		Scan(&pullRequestSurrogateID, &pullRequestID)
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -133,7 +195,7 @@ func (r *PostgresPullRequestRepository) Create(
 		return "", fmt.Errorf("failed to insert pull request: %w", err)
 	}
 
-	err = r.insertReviewers(ctx, tx, pullRequest.AssignedReviewers, pullRequestSurrogateID)
+	err = r.insertReviewers(ctx, tx, pullRequest, pullRequestSurrogateID)
 	if err != nil {
 		return "", fmt.Errorf("failed to insert reviewers: %w", err)
 	}
@@ -171,10 +233,11 @@ func (r *PostgresPullRequestRepository) SetStatusMerged(
 	return nil
 }
 
+// UpdateReviewers replaces the reviewers of the PR with the assigned ones and their slots,
+// reviewers staying on the PR keep their approvals.
 func (r *PostgresPullRequestRepository) UpdateReviewers(
 	ctx context.Context,
-	pullRequestID string,
-	newReviewerIDs []string,
+	pullRequest *prsDomain.PullRequest,
 ) error {
 	tx, err := r.getter.DefaultTrOrDB(ctx, r.pool).Begin(ctx)
 	if err != nil {
@@ -182,23 +245,33 @@ func (r *PostgresPullRequestRepository) UpdateReviewers(
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	const queryDeleteOldReviewers = `
-	DELETE FROM pull_request_reviewers
-	WHERE pull_request_id = (
-		SELECT id FROM pull_requests pr WHERE pr.pull_request_id = $1)
-	RETURNING pull_request_id
+	const queryGetSurrogateID = `
+	SELECT id FROM pull_requests WHERE pull_request_id = $1
 	`
 
 	var pullRequestSurrogateID int64
-	err = tx.QueryRow(ctx, queryDeleteOldReviewers, pullRequestID).Scan(&pullRequestSurrogateID)
+	err = tx.QueryRow(ctx, queryGetSurrogateID, pullRequest.ID).Scan(&pullRequestSurrogateID)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return service.ErrPullRequestNotFound
 	}
 	if err != nil {
+		return fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	const queryDeleteOldReviewers = `
+	DELETE FROM pull_request_reviewers prr
+	USING users u
+	WHERE u.id = prr.reviewer_id
+	  AND prr.pull_request_id = $1 AND u.user_id <> ALL($2)
+	`
+
+	// a nil slice is sent as NULL, which would keep every reviewer
+	reviewerIDs := append([]string{}, pullRequest.AssignedReviewers...)
+	if _, err = tx.Exec(ctx, queryDeleteOldReviewers, pullRequestSurrogateID, reviewerIDs); err != nil {
 		return fmt.Errorf("failed remove old reviewers: %w", err)
 	}
 
-	err = r.insertReviewers(ctx, tx, newReviewerIDs, pullRequestSurrogateID)
+	err = r.insertReviewers(ctx, tx, pullRequest, pullRequestSurrogateID)
 	if err != nil {
 		return fmt.Errorf("failed to insert new reviewers: %w", err)
 	}
@@ -210,46 +283,136 @@ func (r *PostgresPullRequestRepository) UpdateReviewers(
 	return nil
 }
 
-func (r *PostgresPullRequestRepository) insertReviewers(
+// Approve keeps the time of the first approval when the reviewer approves again.
+func (r *PostgresPullRequestRepository) Approve(
 	ctx context.Context,
-	txBase pgx.Tx,
-	reviewerIDs []string,
-	pullRequestSurrogateID int64,
+	pullRequestID, reviewerID string,
+	approvedAt time.Time,
 ) error {
-	tx, err := txBase.Begin(ctx)
+	const query = `
+	UPDATE pull_request_reviewers prr
+	SET approved_at = COALESCE(prr.approved_at, $3)
+	FROM pull_requests prs, users u
+	WHERE prs.id = prr.pull_request_id AND u.id = prr.reviewer_id
+	  AND prs.pull_request_id = $1 AND u.user_id = $2
+	`
+
+	tag, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(ctx, query, pullRequestID, reviewerID, approvedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update approval: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrPullRequestNotAssigned
+	}
+
+	return nil
+}
+
+// GetExistingIDs returns the ones of pullRequestIDs that are stored.
+func (r *PostgresPullRequestRepository) GetExistingIDs(ctx context.Context, pullRequestIDs []string) ([]string, error) {
+	const query = `
+	SELECT pull_request_id FROM pull_requests
+	WHERE pull_request_id = ANY($1)
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, pullRequestIDs)
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect pull request IDs: %w", err)
+	}
+
+	return existing, nil
+}
+
+// Import loads PRs from history as they are: the records are copied into a temporary table,
+// then moved into pull_requests and pull_request_reviewers in two statements.
+// PRs without a team are credited to the primary team of the author.
+func (r *PostgresPullRequestRepository) Import(ctx context.Context, pullRequests []prsDomain.PullRequest) error {
+	tx, err := r.getter.DefaultTrOrDB(ctx, r.pool).Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	const queryGetSurrogateIDs = `
-	SELECT u.id FROM users u
-	WHERE u.user_id = ANY($1)
+	const queryCreateImportTable = `
+	CREATE TEMPORARY TABLE import_pull_requests (
+	    pull_request_id TEXT NOT NULL,
+	    name TEXT NOT NULL,
+	    author_id TEXT NOT NULL,
+	    team_name TEXT NOT NULL,
+	    status TEXT NOT NULL,
+	    created_at TIMESTAMP NOT NULL,
+	    merged_at TIMESTAMP,
+	    reviewer_ids TEXT[] NOT NULL
+	)
 	`
 
-	rows, _ := tx.Query(ctx, queryGetSurrogateIDs, reviewerIDs)
-	surrogateReviewerIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if _, err = tx.Exec(ctx, queryCreateImportTable); err != nil {
+		return fmt.Errorf("failed to create import table: %w", err)
+	}
+
+	_, err = tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"import_pull_requests"},
+		[]string{
+			"pull_request_id", "name", "author_id", "team_name", "status", "created_at", "merged_at", "reviewer_ids",
+		},
+		pgx.CopyFromSlice(len(pullRequests), func(i int) ([]any, error) {
+			pullRequest := pullRequests[i]
+			reviewerIDs := append([]string{}, pullRequest.AssignedReviewers...)
+
+			return []any{
+				pullRequest.ID,
+				pullRequest.Name,
+				pullRequest.AuthorID,
+				pullRequest.TeamName,
+				string(pullRequest.Status),
+				*pullRequest.CreatedAt,
+				pullRequest.MergedAt,
+				reviewerIDs,
+			}, nil
+		}),
+	)
 	if err != nil {
-		return fmt.Errorf("failed to collect reviewer IDs: %w", err)
+		return fmt.Errorf("failed to copy pull requests: %w", err)
 	}
 
-	if len(surrogateReviewerIDs) == 0 {
-		return nil
+	const queryInsertPullRequests = `
+	INSERT INTO pull_requests (pull_request_id, name, author_id, team_id, status, created_at, merged_at)
+	SELECT i.pull_request_id, i.name, i.author_id, COALESCE(t.id, tm.team_id),
+	       i.status::pull_request_status, i.created_at, i.merged_at
+	FROM import_pull_requests i
+	JOIN users a ON a.user_id = i.author_id
+	LEFT JOIN teams t ON t.name = i.team_name
+	LEFT JOIN team_members tm ON tm.user_id = a.id AND tm.is_primary
+	ON CONFLICT DO NOTHING
+	`
+
+	tag, err := tx.Exec(ctx, queryInsertPullRequests)
+	if err != nil {
+		return fmt.Errorf("failed to insert pull requests: %w", err)
+	}
+	// a PR stored since the IDs were checked fails the whole import
+	if tag.RowsAffected() != int64(len(pullRequests)) {
+		return service.ErrPullRequestAlreadyExists
 	}
 
 	const queryInsertReviewers = `
 	INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-	VALUES ($1, $2)
+	SELECT prs.id, u.id
+	FROM import_pull_requests i
+	JOIN pull_requests prs ON prs.pull_request_id = i.pull_request_id
+	CROSS JOIN LATERAL unnest(i.reviewer_ids) r(user_id)
+	JOIN users u ON u.user_id = r.user_id
 	`
 
-	batch := &pgx.Batch{}
-
-	for _, surrogateReviewerID := range surrogateReviewerIDs {
-		batch.Queue(queryInsertReviewers, pullRequestSurrogateID, surrogateReviewerID)
+	if _, err = tx.Exec(ctx, queryInsertReviewers); err != nil {
+		return fmt.Errorf("failed to insert reviewers: %w", err)
 	}
 
-	if err = tx.SendBatch(ctx, batch).Close(); err != nil {
-		return fmt.Errorf("failed to insert batch: %w", err)
+	// dropped explicitly, the import may run in a savepoint of a longer transaction
+	if _, err = tx.Exec(ctx, `DROP TABLE import_pull_requests`); err != nil {
+		return fmt.Errorf("failed to drop import table: %w", err)
 	}
 
 	if err = tx.Commit(ctx); err != nil {
@@ -258,3 +421,60 @@ func (r *PostgresPullRequestRepository) insertReviewers(
 
 	return nil
 }
+
+// CreateDecline records the decline, declining the same PR again only refreshes the reason.
+func (r *PostgresPullRequestRepository) CreateDecline(ctx context.Context, decline *prsDomain.Decline) error {
+	const query = `
+	INSERT INTO review_declines (pull_request_id, reviewer_id, reason, comment)
+	SELECT pr.id, u.id, $3, $4
+	FROM pull_requests pr, users u
+	WHERE pr.pull_request_id = $1 AND u.user_id = $2
+	ON CONFLICT (pull_request_id, reviewer_id) DO UPDATE
+	SET reason = EXCLUDED.reason, comment = EXCLUDED.comment, declined_at = CURRENT_TIMESTAMP
+	`
+
+	tag, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(
+		ctx,
+		query,
+		decline.PullRequestID,
+		decline.ReviewerID,
+		decline.Reason,
+		decline.Comment,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert decline: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrPullRequestNotFound
+	}
+
+	return nil
+}
+
+// insertReviewers adds the assigned reviewers missing from the PR and moves the others to their current slots.
+func (r *PostgresPullRequestRepository) insertReviewers(
+	ctx context.Context,
+	tx pgx.Tx,
+	pullRequest *prsDomain.PullRequest,
+	pullRequestSurrogateID int64,
+) error {
+	const queryInsertReviewers = `
+	INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, group_team_id)
+	SELECT $1, u.id, (SELECT gt.id FROM teams gt WHERE gt.name = NULLIF($3, ''))
+	FROM users u
+	WHERE u.user_id = $2
+	ON CONFLICT (pull_request_id, reviewer_id) DO UPDATE SET group_team_id = EXCLUDED.group_team_id
+	`
+
+	batch := &pgx.Batch{}
+
+	for _, reviewerID := range pullRequest.AssignedReviewers {
+		batch.Queue(queryInsertReviewers, pullRequestSurrogateID, reviewerID, pullRequest.GroupReviewers[reviewerID])
+	}
+
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to insert batch: %w", err)
+	}
+
+	return nil
+}
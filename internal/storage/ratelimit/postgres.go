@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"reviewer-assigner/internal/ratelimit"
+	"sync"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresLimiter keeps buckets in Postgres, so every replica sees the same limits.
+type PostgresLimiter struct {
+	pool   *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+
+	mu        sync.Mutex
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewPostgresLimiter(
+	pool *pgxpool.Pool,
+	getter *trmpgx.CtxGetter,
+) *PostgresLimiter {
+	return &PostgresLimiter{
+		pool:   pool,
+		getter: getter,
+		now:    time.Now,
+	}
+}
+
+func (l *PostgresLimiter) Take(ctx context.Context, key string, rule ratelimit.Rule) (ratelimit.Result, error) {
+	if l.sweepDue() {
+		if err := l.Sweep(ctx); err != nil {
+			return ratelimit.Result{}, err
+		}
+	}
+
+	// refilled locks an existing bucket, so concurrent requests of a client are serialized.
+	// Two first requests may both insert a full bucket, then one token is lost to the upsert.
+	const query = `
+	WITH refilled AS (
+		SELECT LEAST(
+			$2::float8,
+			COALESCE(
+				(
+					SELECT tokens + EXTRACT(EPOCH FROM clock_timestamp() - updated_at)::float8 * $3::float8
+					FROM rate_limit_buckets
+					WHERE bucket_key = $1
+					FOR UPDATE
+				),
+				$2::float8
+			)
+		) AS tokens
+	),
+	taken AS (
+		SELECT CASE WHEN tokens >= 1 THEN tokens - 1 ELSE tokens END AS tokens
+		FROM refilled
+	),
+	saved AS (
+		INSERT INTO rate_limit_buckets (bucket_key, tokens, updated_at, full_at)
+		SELECT
+			$1, tokens, clock_timestamp(),
+			clock_timestamp() + make_interval(secs => ($2::float8 - tokens) / $3::float8)
+		FROM taken
+		ON CONFLICT (bucket_key) DO UPDATE
+		SET tokens = EXCLUDED.tokens, updated_at = EXCLUDED.updated_at, full_at = EXCLUDED.full_at
+	)
+	SELECT tokens FROM refilled
+	`
+
+	var tokens float64
+	err := l.getter.DefaultTrOrDB(ctx, l.pool).
+		QueryRow(ctx, query, key, rule.Burst, rule.Rate).
+		Scan(&tokens)
+	if err != nil {
+		return ratelimit.Result{}, fmt.Errorf("failed to take rate limit token: %w", err)
+	}
+
+	if tokens >= 1 {
+		return ratelimit.Result{Allowed: true}, nil
+	}
+
+	return ratelimit.Result{RetryAfter: rule.RetryAfter(tokens)}, nil
+}
+
+// Sweep deletes buckets idle long enough to be full again, they are the same as missing ones.
+// Take runs it once in ratelimit.SweepInterval on every replica.
+func (l *PostgresLimiter) Sweep(ctx context.Context) error {
+	const query = `
+	DELETE FROM rate_limit_buckets
+	WHERE full_at < clock_timestamp()
+	`
+
+	_, err := l.getter.DefaultTrOrDB(ctx, l.pool).Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to sweep rate limit buckets: %w", err)
+	}
+
+	return nil
+}
+
+func (l *PostgresLimiter) sweepDue() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) < ratelimit.SweepInterval {
+		return false
+	}
+	l.lastSweep = now
+
+	return true
+}
@@ -0,0 +1,135 @@
+package state
+
+import (
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	stateDomain "reviewer-assigner/internal/domain/state"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"time"
+)
+
+type UserDB struct {
+	UserID   string `db:"user_id"`
+	Name     string `db:"name"`
+	IsActive bool   `db:"is_active"`
+}
+
+func DBToDomainUser(d *UserDB) stateDomain.User {
+	return stateDomain.User{
+		ID:       d.UserID,
+		Name:     d.Name,
+		IsActive: d.IsActive,
+	}
+}
+
+type TeamDB struct {
+	Name       string `db:"name"`
+	ParentName string `db:"parent_name"`
+}
+
+type MemberDB struct {
+	TeamName  string `db:"team_name"`
+	UserID    string `db:"user_id"`
+	IsPrimary bool   `db:"is_primary"`
+}
+
+type RuleDB struct {
+	TeamName    string `db:"team_name"`
+	Kind        string `db:"kind"`
+	UserID      string `db:"user_id"`
+	OtherUserID string `db:"other_user_id"`
+}
+
+func DBToDomainRule(d *RuleDB) teamsDomain.Rule {
+	return teamsDomain.Rule{
+		Kind:        teamsDomain.RuleKind(d.Kind),
+		UserID:      d.UserID,
+		OtherUserID: d.OtherUserID,
+	}
+}
+
+type ReviewRuleDB struct {
+	TeamName        string `db:"team_name"`
+	MinLinesChanged int    `db:"min_lines_changed"`
+	Label           string `db:"label"`
+	ReviewersCount  int    `db:"reviewers_count"`
+	ExtraTeamName   string `db:"extra_team_name"`
+}
+
+func DBToDomainReviewRule(d *ReviewRuleDB) teamsDomain.ReviewRule {
+	return teamsDomain.ReviewRule{
+		MinLinesChanged: d.MinLinesChanged,
+		Label:           d.Label,
+		ReviewersCount:  d.ReviewersCount,
+		ExtraTeamName:   d.ExtraTeamName,
+	}
+}
+
+type RequiredGroupDB struct {
+	TeamName     string   `db:"team_name"`
+	Labels       []string `db:"labels"`
+	PathPrefixes []string `db:"path_prefixes"`
+}
+
+func DBToDomainRequiredGroup(d *RequiredGroupDB) *teamsDomain.RequiredGroup {
+	return &teamsDomain.RequiredGroup{
+		TeamName:     d.TeamName,
+		Labels:       d.Labels,
+		PathPrefixes: d.PathPrefixes,
+	}
+}
+
+type PullRequestDB struct {
+	PullRequestID string     `db:"pull_request_id"`
+	Name          string     `db:"name"`
+	AuthorID      string     `db:"author_id"`
+	TeamName      string     `db:"team_name"`
+	Status        string     `db:"status"`
+	CreatedAt     time.Time  `db:"created_at"`
+	MergedAt      *time.Time `db:"merged_at"`
+}
+
+func DBToDomainPullRequest(d *PullRequestDB) stateDomain.PullRequest {
+	return stateDomain.PullRequest{
+		ID:        d.PullRequestID,
+		Name:      d.Name,
+		AuthorID:  d.AuthorID,
+		TeamName:  d.TeamName,
+		Status:    prsDomain.StatusPR(d.Status),
+		CreatedAt: d.CreatedAt,
+		MergedAt:  d.MergedAt,
+		Reviewers: []stateDomain.Reviewer{},
+		Declines:  []stateDomain.Decline{},
+	}
+}
+
+type ReviewerDB struct {
+	PullRequestID string     `db:"pull_request_id"`
+	UserID        string     `db:"user_id"`
+	GroupTeamName string     `db:"group_team_name"`
+	ApprovedAt    *time.Time `db:"approved_at"`
+}
+
+func DBToDomainReviewer(d *ReviewerDB) stateDomain.Reviewer {
+	return stateDomain.Reviewer{
+		UserID:        d.UserID,
+		GroupTeamName: d.GroupTeamName,
+		ApprovedAt:    d.ApprovedAt,
+	}
+}
+
+type DeclineDB struct {
+	PullRequestID string    `db:"pull_request_id"`
+	ReviewerID    string    `db:"reviewer_id"`
+	Reason        string    `db:"reason"`
+	Comment       string    `db:"comment"`
+	DeclinedAt    time.Time `db:"declined_at"`
+}
+
+func DBToDomainDecline(d *DeclineDB) stateDomain.Decline {
+	return stateDomain.Decline{
+		ReviewerID: d.ReviewerID,
+		Reason:     prsDomain.DeclineReason(d.Reason),
+		Comment:    d.Comment,
+		DeclinedAt: d.DeclinedAt,
+	}
+}
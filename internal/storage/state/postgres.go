@@ -0,0 +1,528 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	stateDomain "reviewer-assigner/internal/domain/state"
+	teamsDomain "reviewer-assigner/internal/domain/teams"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresStateRepository struct {
+	pool   *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewPostgresStateRepository(
+	pool *pgxpool.Pool,
+	getter *trmpgx.CtxGetter,
+) *PostgresStateRepository {
+	return &PostgresStateRepository{
+		pool:   pool,
+		getter: getter,
+	}
+}
+
+// IsEmpty reports whether there are no users, teams and PRs yet.
+func (r *PostgresStateRepository) IsEmpty(ctx context.Context) (bool, error) {
+	const query = `
+	SELECT NOT EXISTS (SELECT 1 FROM users)
+		AND NOT EXISTS (SELECT 1 FROM teams)
+		AND NOT EXISTS (SELECT 1 FROM pull_requests)
+	`
+
+	var empty bool
+	if err := r.getter.DefaultTrOrDB(ctx, r.pool).QueryRow(ctx, query).Scan(&empty); err != nil {
+		return false, fmt.Errorf("failed to check database is empty: %w", err)
+	}
+
+	return empty, nil
+}
+
+func (r *PostgresStateRepository) GetUsers(ctx context.Context) ([]stateDomain.User, error) {
+	const query = `SELECT user_id, name, is_active FROM users ORDER BY id`
+
+	usersDB, err := collect[UserDB](ctx, r, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	users := make([]stateDomain.User, 0, len(usersDB))
+	for i := range usersDB {
+		users = append(users, DBToDomainUser(&usersDB[i]))
+	}
+
+	return users, nil
+}
+
+// GetTeams returns the teams with their members and policies, members are ordered by user_id
+// and policies in the order they were added.
+func (r *PostgresStateRepository) GetTeams(ctx context.Context) ([]stateDomain.Team, error) {
+	const queryTeams = `
+	SELECT t.name, COALESCE(p.name, '') AS parent_name
+	FROM teams t
+	LEFT JOIN teams p ON p.id = t.parent_id
+	ORDER BY t.id
+	`
+
+	teamsDB, err := collect[TeamDB](ctx, r, queryTeams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+
+	teams := make([]stateDomain.Team, 0, len(teamsDB))
+	byName := make(map[string]*stateDomain.Team, len(teamsDB))
+	for _, teamDB := range teamsDB {
+		teams = append(teams, stateDomain.Team{
+			Name:        teamDB.Name,
+			ParentName:  teamDB.ParentName,
+			Members:     []stateDomain.Member{},
+			Rules:       []teamsDomain.Rule{},
+			ReviewRules: []teamsDomain.ReviewRule{},
+		})
+	}
+	for i := range teams {
+		byName[teams[i].Name] = &teams[i]
+	}
+
+	if err = r.fillTeams(ctx, byName); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+func (r *PostgresStateRepository) fillTeams(ctx context.Context, byName map[string]*stateDomain.Team) error {
+	const queryMembers = `
+	SELECT t.name AS team_name, u.user_id, tm.is_primary
+	FROM team_members tm
+	JOIN teams t ON t.id = tm.team_id
+	JOIN users u ON u.id = tm.user_id
+	ORDER BY t.id, u.user_id
+	`
+
+	membersDB, err := collect[MemberDB](ctx, r, queryMembers)
+	if err != nil {
+		return fmt.Errorf("failed to get team members: %w", err)
+	}
+	for _, memberDB := range membersDB {
+		team := byName[memberDB.TeamName]
+		team.Members = append(team.Members, stateDomain.Member{UserID: memberDB.UserID, IsPrimary: memberDB.IsPrimary})
+	}
+
+	const queryRules = `
+	SELECT t.name AS team_name, tr.kind::text AS kind, tr.user_id, tr.other_user_id
+	FROM team_rules tr
+	JOIN teams t ON t.id = tr.team_id
+	ORDER BY tr.id
+	`
+
+	rulesDB, err := collect[RuleDB](ctx, r, queryRules)
+	if err != nil {
+		return fmt.Errorf("failed to get team rules: %w", err)
+	}
+	for i := range rulesDB {
+		team := byName[rulesDB[i].TeamName]
+		team.Rules = append(team.Rules, DBToDomainRule(&rulesDB[i]))
+	}
+
+	const queryReviewRules = `
+	SELECT
+		t.name AS team_name,
+		rr.min_lines_changed,
+		rr.label,
+		rr.reviewers_count,
+		COALESCE(e.name, '') AS extra_team_name
+	FROM team_review_rules rr
+	JOIN teams t ON t.id = rr.team_id
+	LEFT JOIN teams e ON e.id = rr.extra_team_id
+	ORDER BY rr.id
+	`
+
+	reviewRulesDB, err := collect[ReviewRuleDB](ctx, r, queryReviewRules)
+	if err != nil {
+		return fmt.Errorf("failed to get team review rules: %w", err)
+	}
+	for i := range reviewRulesDB {
+		team := byName[reviewRulesDB[i].TeamName]
+		team.ReviewRules = append(team.ReviewRules, DBToDomainReviewRule(&reviewRulesDB[i]))
+	}
+
+	const queryRequiredGroups = `
+	SELECT t.name AS team_name, g.labels::text[] AS labels, g.path_prefixes::text[] AS path_prefixes
+	FROM required_reviewer_groups g
+	JOIN teams t ON t.id = g.team_id
+	`
+
+	requiredGroupsDB, err := collect[RequiredGroupDB](ctx, r, queryRequiredGroups)
+	if err != nil {
+		return fmt.Errorf("failed to get required groups: %w", err)
+	}
+	for i := range requiredGroupsDB {
+		byName[requiredGroupsDB[i].TeamName].RequiredGroup = DBToDomainRequiredGroup(&requiredGroupsDB[i])
+	}
+
+	return nil
+}
+
+// GetPullRequests returns the PRs with their reviewers and declines, both ordered by user_id.
+func (r *PostgresStateRepository) GetPullRequests(ctx context.Context) ([]stateDomain.PullRequest, error) {
+	const queryPullRequests = `
+	SELECT
+		pr.pull_request_id,
+		pr.name,
+		pr.author_id,
+		COALESCE(t.name, '') AS team_name,
+		pr.status::text AS status,
+		pr.created_at,
+		pr.merged_at
+	FROM pull_requests pr
+	LEFT JOIN teams t ON t.id = pr.team_id
+	ORDER BY pr.id
+	`
+
+	pullRequestsDB, err := collect[PullRequestDB](ctx, r, queryPullRequests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull requests: %w", err)
+	}
+
+	pullRequests := make([]stateDomain.PullRequest, 0, len(pullRequestsDB))
+	for i := range pullRequestsDB {
+		pullRequests = append(pullRequests, DBToDomainPullRequest(&pullRequestsDB[i]))
+	}
+	byID := make(map[string]*stateDomain.PullRequest, len(pullRequests))
+	for i := range pullRequests {
+		byID[pullRequests[i].ID] = &pullRequests[i]
+	}
+
+	const queryReviewers = `
+	SELECT pr.pull_request_id, u.user_id, COALESCE(g.name, '') AS group_team_name, prr.approved_at
+	FROM pull_request_reviewers prr
+	JOIN pull_requests pr ON pr.id = prr.pull_request_id
+	JOIN users u ON u.id = prr.reviewer_id
+	LEFT JOIN teams g ON g.id = prr.group_team_id
+	ORDER BY pr.id, u.user_id
+	`
+
+	reviewersDB, err := collect[ReviewerDB](ctx, r, queryReviewers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewers: %w", err)
+	}
+	for i := range reviewersDB {
+		pullRequest := byID[reviewersDB[i].PullRequestID]
+		pullRequest.Reviewers = append(pullRequest.Reviewers, DBToDomainReviewer(&reviewersDB[i]))
+	}
+
+	const queryDeclines = `
+	SELECT pr.pull_request_id, u.user_id AS reviewer_id, rd.reason::text AS reason, rd.comment, rd.declined_at
+	FROM review_declines rd
+	JOIN pull_requests pr ON pr.id = rd.pull_request_id
+	JOIN users u ON u.id = rd.reviewer_id
+	ORDER BY pr.id, u.user_id
+	`
+
+	declinesDB, err := collect[DeclineDB](ctx, r, queryDeclines)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get declines: %w", err)
+	}
+	for i := range declinesDB {
+		pullRequest := byID[declinesDB[i].PullRequestID]
+		pullRequest.Declines = append(pullRequest.Declines, DBToDomainDecline(&declinesDB[i]))
+	}
+
+	return pullRequests, nil
+}
+
+func (r *PostgresStateRepository) CreateUsers(ctx context.Context, users []stateDomain.User) error {
+	userIDs := make([]string, 0, len(users))
+	names := make([]string, 0, len(users))
+	isActive := make([]bool, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+		names = append(names, user.Name)
+		isActive = append(isActive, user.IsActive)
+	}
+
+	// the ordinality keeps the archive order, so the next export lists users the same way
+	const query = `
+	INSERT INTO users (user_id, name, is_active)
+	SELECT x.user_id, x.name, x.is_active
+	FROM unnest($1::text[], $2::text[], $3::bool[]) WITH ORDINALITY AS x(user_id, name, is_active, n)
+	ORDER BY x.n
+	`
+
+	if _, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(ctx, query, userIDs, names, isActive); err != nil {
+		return fmt.Errorf("failed to create users: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTeams creates the teams with their members and policies, the users must exist already.
+func (r *PostgresStateRepository) CreateTeams(ctx context.Context, teams []stateDomain.Team) error {
+	names := make([]string, 0, len(teams))
+	parentNames := make([]string, 0, len(teams))
+	for _, team := range teams {
+		names = append(names, team.Name)
+		parentNames = append(parentNames, team.ParentName)
+	}
+
+	tr := r.getter.DefaultTrOrDB(ctx, r.pool)
+
+	const queryTeams = `
+	INSERT INTO teams (name)
+	SELECT x.name FROM unnest($1::text[]) WITH ORDINALITY AS x(name, n)
+	ORDER BY x.n
+	`
+
+	if _, err := tr.Exec(ctx, queryTeams, names); err != nil {
+		return fmt.Errorf("failed to create teams: %w", err)
+	}
+
+	// parents go separately, a team may come before its parent
+	const queryParents = `
+	UPDATE teams t SET parent_id = p.id
+	FROM unnest($1::text[], $2::text[]) AS x(name, parent_name)
+	JOIN teams p ON p.name = x.parent_name
+	WHERE t.name = x.name
+	`
+
+	if _, err := tr.Exec(ctx, queryParents, names, parentNames); err != nil {
+		return fmt.Errorf("failed to set parent teams: %w", err)
+	}
+
+	if err := r.createMembers(ctx, teams); err != nil {
+		return err
+	}
+
+	return r.createPolicies(ctx, teams)
+}
+
+func (r *PostgresStateRepository) createMembers(ctx context.Context, teams []stateDomain.Team) error {
+	var teamNames, userIDs []string
+	var isPrimary []bool
+	for _, team := range teams {
+		for _, member := range team.Members {
+			teamNames = append(teamNames, team.Name)
+			userIDs = append(userIDs, member.UserID)
+			isPrimary = append(isPrimary, member.IsPrimary)
+		}
+	}
+
+	const query = `
+	INSERT INTO team_members (team_id, user_id, is_primary)
+	SELECT t.id, u.id, x.is_primary
+	FROM unnest($1::text[], $2::text[], $3::bool[]) AS x(team_name, user_id, is_primary)
+	JOIN teams t ON t.name = x.team_name
+	JOIN users u ON u.user_id = x.user_id
+	`
+
+	if _, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(ctx, query, teamNames, userIDs, isPrimary); err != nil {
+		return fmt.Errorf("failed to create team members: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresStateRepository) createPolicies(ctx context.Context, teams []stateDomain.Team) error {
+	tr := r.getter.DefaultTrOrDB(ctx, r.pool)
+
+	var ruleTeams, kinds, ruleUserIDs, otherUserIDs []string
+	var reviewRuleTeams, labels, extraTeamNames []string
+	var minLinesChanged, reviewersCounts []int
+	for _, team := range teams {
+		for _, rule := range team.Rules {
+			ruleTeams = append(ruleTeams, team.Name)
+			kinds = append(kinds, string(rule.Kind))
+			ruleUserIDs = append(ruleUserIDs, rule.UserID)
+			otherUserIDs = append(otherUserIDs, rule.OtherUserID)
+		}
+		for _, reviewRule := range team.ReviewRules {
+			reviewRuleTeams = append(reviewRuleTeams, team.Name)
+			minLinesChanged = append(minLinesChanged, reviewRule.MinLinesChanged)
+			labels = append(labels, reviewRule.Label)
+			reviewersCounts = append(reviewersCounts, reviewRule.ReviewersCount)
+			extraTeamNames = append(extraTeamNames, reviewRule.ExtraTeamName)
+		}
+	}
+
+	const queryRules = `
+	INSERT INTO team_rules (team_id, kind, user_id, other_user_id)
+	SELECT t.id, x.kind::team_rule_kind, x.user_id, x.other_user_id
+	FROM unnest($1::text[], $2::text[], $3::text[], $4::text[]) WITH ORDINALITY
+		AS x(team_name, kind, user_id, other_user_id, n)
+	JOIN teams t ON t.name = x.team_name
+	ORDER BY x.n
+	`
+
+	if _, err := tr.Exec(ctx, queryRules, ruleTeams, kinds, ruleUserIDs, otherUserIDs); err != nil {
+		return fmt.Errorf("failed to create team rules: %w", err)
+	}
+
+	const queryReviewRules = `
+	INSERT INTO team_review_rules (team_id, min_lines_changed, label, reviewers_count, extra_team_id)
+	SELECT t.id, x.min_lines_changed, x.label, x.reviewers_count, e.id
+	FROM unnest($1::text[], $2::int[], $3::text[], $4::int[], $5::text[]) WITH ORDINALITY
+		AS x(team_name, min_lines_changed, label, reviewers_count, extra_team_name, n)
+	JOIN teams t ON t.name = x.team_name
+	LEFT JOIN teams e ON e.name = x.extra_team_name
+	ORDER BY x.n
+	`
+
+	_, err := tr.Exec(ctx, queryReviewRules, reviewRuleTeams, minLinesChanged, labels, reviewersCounts, extraTeamNames)
+	if err != nil {
+		return fmt.Errorf("failed to create team review rules: %w", err)
+	}
+
+	// labels and prefixes are arrays of their own, unnest would flatten them
+	const queryRequiredGroup = `
+	INSERT INTO required_reviewer_groups (team_id, labels, path_prefixes)
+	SELECT t.id, $2, $3 FROM teams t WHERE t.name = $1
+	`
+
+	for _, team := range teams {
+		if team.RequiredGroup == nil {
+			continue
+		}
+
+		group := team.RequiredGroup
+		if _, err = tr.Exec(ctx, queryRequiredGroup, team.Name, group.Labels, group.PathPrefixes); err != nil {
+			return fmt.Errorf("failed to create required group: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CreatePullRequests creates the PRs with their reviewers and declines as they are,
+// the users and teams must exist already.
+func (r *PostgresStateRepository) CreatePullRequests(
+	ctx context.Context,
+	pullRequests []stateDomain.PullRequest,
+) error {
+	ids := make([]string, 0, len(pullRequests))
+	names := make([]string, 0, len(pullRequests))
+	authorIDs := make([]string, 0, len(pullRequests))
+	teamNames := make([]string, 0, len(pullRequests))
+	statuses := make([]string, 0, len(pullRequests))
+	createdAt := make([]time.Time, 0, len(pullRequests))
+	mergedAt := make([]*time.Time, 0, len(pullRequests))
+	for _, pullRequest := range pullRequests {
+		ids = append(ids, pullRequest.ID)
+		names = append(names, pullRequest.Name)
+		authorIDs = append(authorIDs, pullRequest.AuthorID)
+		teamNames = append(teamNames, pullRequest.TeamName)
+		statuses = append(statuses, string(pullRequest.Status))
+		createdAt = append(createdAt, pullRequest.CreatedAt)
+		mergedAt = append(mergedAt, pullRequest.MergedAt)
+	}
+
+	const query = `
+	INSERT INTO pull_requests (pull_request_id, name, author_id, team_id, status, created_at, merged_at)
+	SELECT x.pull_request_id, x.name, x.author_id, t.id, x.status::pull_request_status, x.created_at, x.merged_at
+	FROM unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::text[], $6::timestamp[], $7::timestamp[])
+		WITH ORDINALITY AS x(pull_request_id, name, author_id, team_name, status, created_at, merged_at, n)
+	LEFT JOIN teams t ON t.name = x.team_name
+	ORDER BY x.n
+	`
+
+	_, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(
+		ctx,
+		query,
+		ids,
+		names,
+		authorIDs,
+		teamNames,
+		statuses,
+		createdAt,
+		mergedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull requests: %w", err)
+	}
+
+	if err = r.createReviewers(ctx, pullRequests); err != nil {
+		return err
+	}
+
+	return r.createDeclines(ctx, pullRequests)
+}
+
+func (r *PostgresStateRepository) createReviewers(ctx context.Context, pullRequests []stateDomain.PullRequest) error {
+	var pullRequestIDs, userIDs, groupTeamNames []string
+	var approvedAt []*time.Time
+	for _, pullRequest := range pullRequests {
+		for _, reviewer := range pullRequest.Reviewers {
+			pullRequestIDs = append(pullRequestIDs, pullRequest.ID)
+			userIDs = append(userIDs, reviewer.UserID)
+			groupTeamNames = append(groupTeamNames, reviewer.GroupTeamName)
+			approvedAt = append(approvedAt, reviewer.ApprovedAt)
+		}
+	}
+
+	const query = `
+	INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, group_team_id, approved_at)
+	SELECT pr.id, u.id, g.id, x.approved_at
+	FROM unnest($1::text[], $2::text[], $3::text[], $4::timestamp[])
+		AS x(pull_request_id, user_id, group_team_name, approved_at)
+	JOIN pull_requests pr ON pr.pull_request_id = x.pull_request_id
+	JOIN users u ON u.user_id = x.user_id
+	LEFT JOIN teams g ON g.name = x.group_team_name
+	`
+
+	_, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(ctx, query, pullRequestIDs, userIDs, groupTeamNames, approvedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create reviewers: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresStateRepository) createDeclines(ctx context.Context, pullRequests []stateDomain.PullRequest) error {
+	var pullRequestIDs, reviewerIDs, reasons, comments []string
+	var declinedAt []time.Time
+	for _, pullRequest := range pullRequests {
+		for _, decline := range pullRequest.Declines {
+			pullRequestIDs = append(pullRequestIDs, pullRequest.ID)
+			reviewerIDs = append(reviewerIDs, decline.ReviewerID)
+			reasons = append(reasons, string(decline.Reason))
+			comments = append(comments, decline.Comment)
+			declinedAt = append(declinedAt, decline.DeclinedAt)
+		}
+	}
+
+	const query = `
+	INSERT INTO review_declines (pull_request_id, reviewer_id, reason, comment, declined_at)
+	SELECT pr.id, u.id, x.reason::decline_reason, x.comment, x.declined_at
+	FROM unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::timestamp[])
+		AS x(pull_request_id, reviewer_id, reason, comment, declined_at)
+	JOIN pull_requests pr ON pr.pull_request_id = x.pull_request_id
+	JOIN users u ON u.user_id = x.reviewer_id
+	`
+
+	_, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(
+		ctx,
+		query,
+		pullRequestIDs,
+		reviewerIDs,
+		reasons,
+		comments,
+		declinedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create declines: %w", err)
+	}
+
+	return nil
+}
+
+func collect[T any](ctx context.Context, r *PostgresStateRepository, query string) ([]T, error) {
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query)
+
+	return pgx.CollectRows(rows, pgx.RowToStructByName[T])
+}
@@ -1,6 +1,10 @@
 package stats
 
-import "reviewer-assigner/internal/http/handlers/stats"
+import (
+	prsDomain "reviewer-assigner/internal/domain/pullrequests"
+	"reviewer-assigner/internal/http/handlers/stats"
+	"time"
+)
 
 type UserAssignmentDB struct {
 	UserID          string `db:"user_id"`
@@ -15,3 +19,79 @@ func DBToDomainUserAssignment(u *UserAssignmentDB) stats.UserAssignment {
 		Count:  u.AssignmentCount,
 	}
 }
+
+type ReviewerLoadDB struct {
+	TeamName              string   `db:"team_name"`
+	UserID                string   `db:"user_id"`
+	Name                  string   `db:"username"`
+	IsActive              bool     `db:"is_active"`
+	OpenReviews           int      `db:"open_reviews"`
+	Assignments           int      `db:"assignments"`
+	AvgTimeToMergeSeconds *float64 `db:"avg_time_to_merge_seconds"`
+}
+
+func DBToDomainReviewerLoad(r *ReviewerLoadDB) stats.ReviewerLoad {
+	load := stats.ReviewerLoad{
+		UserID:      r.UserID,
+		Name:        r.Name,
+		IsActive:    r.IsActive,
+		OpenReviews: r.OpenReviews,
+		Assignments: r.Assignments,
+	}
+
+	if r.AvgTimeToMergeSeconds != nil {
+		avg := secondsToDuration(*r.AvgTimeToMergeSeconds)
+		load.AvgTimeToMerge = &avg
+	}
+
+	return load
+}
+
+type TimeseriesPointDB struct {
+	BucketStart              time.Time `db:"bucket_start"`
+	Created                  int       `db:"created"`
+	Merged                   int       `db:"merged"`
+	MedianTimeToMergeSeconds *float64  `db:"median_time_to_merge_seconds"`
+}
+
+func DBToDomainTimeseriesPoint(p *TimeseriesPointDB) stats.TimeseriesPoint {
+	point := stats.TimeseriesPoint{
+		BucketStart: p.BucketStart,
+		Created:     p.Created,
+		Merged:      p.Merged,
+	}
+
+	if p.MedianTimeToMergeSeconds != nil {
+		median := secondsToDuration(*p.MedianTimeToMergeSeconds)
+		point.MedianTimeToMerge = &median
+	}
+
+	return point
+}
+
+type ReviewerDeclinesDB struct {
+	UserID      string   `db:"user_id"`
+	Name        string   `db:"username"`
+	Assignments int      `db:"assignments"`
+	Declines    int      `db:"declines"`
+	Reasons     []string `db:"reasons"`
+}
+
+func DBToDomainReviewerDeclines(r *ReviewerDeclinesDB) stats.ReviewerDeclines {
+	reasons := make(map[prsDomain.DeclineReason]int, len(r.Reasons))
+	for _, reason := range r.Reasons {
+		reasons[prsDomain.DeclineReason(reason)]++
+	}
+
+	return stats.ReviewerDeclines{
+		UserID:      r.UserID,
+		Name:        r.Name,
+		Assignments: r.Assignments,
+		Declines:    r.Declines,
+		Reasons:     reasons,
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -3,6 +3,7 @@ package stats
 import (
 	"context"
 	"fmt"
+	"reviewer-assigner/internal/http/handlers"
 	"reviewer-assigner/internal/http/handlers/stats"
 	"strings"
 
@@ -28,9 +29,8 @@ func NewPostgresStatsRepository(
 }
 
 func (r *PostgresStatsRepository) GetStatsReviewersAssignments(
-	ctx context.Context, status string, activeOnly bool,
+	ctx context.Context, status string, activeOnly bool, page handlers.Page,
 ) ([]stats.UserAssignment, error) {
-	// TODO: add pagination
 	const queryBase = `
 	SELECT
 		u.user_id,
@@ -47,8 +47,11 @@ func (r *PostgresStatsRepository) GetStatsReviewersAssignments(
 	var args []any
 	var whereConditions []string
 	if status != "" {
-		whereConditions = append(whereConditions, "pr.status = $1::pull_request_status")
 		args = append(args, status)
+		whereConditions = append(
+			whereConditions,
+			fmt.Sprintf("pr.status = $%d::pull_request_status", len(args)),
+		)
 	}
 	if activeOnly {
 		whereConditions = append(whereConditions, "u.is_active = true")
@@ -62,9 +65,18 @@ func (r *PostgresStatsRepository) GetStatsReviewersAssignments(
 	queryBuilder.WriteString(`
         GROUP BY u.user_id, u.name
 		HAVING count(prr.pull_request_id) > 0
-        ORDER BY assignment_count DESC
+        ORDER BY assignment_count DESC, u.user_id
     `)
 
+	if page.Limit > 0 {
+		args = append(args, page.Limit)
+		fmt.Fprintf(&queryBuilder, " LIMIT $%d", len(args))
+	}
+	if page.Offset > 0 {
+		args = append(args, page.Offset)
+		fmt.Fprintf(&queryBuilder, " OFFSET $%d", len(args))
+	}
+
 	query := queryBuilder.String()
 
 	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, args...)
@@ -80,3 +92,238 @@ func (r *PostgresStatsRepository) GetStatsReviewersAssignments(
 
 	return userAssignments, nil
 }
+
+// GetStatsReviewersLoad returns a page of teams ordered by name with the load of every member
+// and the total number of teams matching the filter. A team filter matches the team and all its subteams.
+// Teams without members have no load, they are neither listed nor counted.
+func (r *PostgresStatsRepository) GetStatsReviewersLoad(
+	ctx context.Context,
+	filter *stats.LoadFilter,
+) ([]stats.TeamLoad, int, error) {
+	var teamName *string
+	if filter.TeamName != "" {
+		teamName = &filter.TeamName
+	}
+
+	var limit *int
+	if filter.Page.Limit > 0 {
+		limit = &filter.Page.Limit
+	}
+
+	db := r.getter.DefaultTrOrDB(ctx, r.pool)
+
+	const queryCountTeams = `
+	WITH RECURSIVE subtree AS (
+		SELECT t.id FROM teams t
+		WHERE $1::text IS NULL OR t.name = $1
+		UNION ALL
+		SELECT c.id FROM subtree s
+		JOIN teams c ON c.parent_id = s.id
+		WHERE $1::text IS NOT NULL
+	)
+	SELECT COUNT(*) FROM subtree s
+	WHERE EXISTS (SELECT 1 FROM team_members tm WHERE tm.team_id = s.id)
+	`
+
+	var total int
+	err := db.QueryRow(ctx, queryCountTeams, teamName).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count teams: %w", err)
+	}
+
+	const queryLoad = `
+	WITH RECURSIVE subtree AS (
+		SELECT t.id, t.name FROM teams t
+		WHERE $3::text IS NULL OR t.name = $3
+		UNION ALL
+		SELECT c.id, c.name FROM subtree s
+		JOIN teams c ON c.parent_id = s.id
+		WHERE $3::text IS NOT NULL
+	),
+	page AS (
+		SELECT t.id, t.name FROM subtree t
+		WHERE EXISTS (SELECT 1 FROM team_members tm WHERE tm.team_id = t.id)
+		ORDER BY t.name
+		LIMIT $4 OFFSET $5
+	)
+	SELECT
+		p.name AS team_name,
+		u.user_id,
+		u.name AS username,
+		u.is_active,
+		COUNT(pr.id) FILTER (WHERE pr.status = 'OPEN') AS open_reviews,
+		COUNT(pr.id) FILTER (WHERE pr.created_at >= $1 AND pr.created_at < $2) AS assignments,
+		AVG(EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)) FILTER (
+			WHERE pr.merged_at IS NOT NULL AND pr.created_at >= $1 AND pr.created_at < $2
+		)::float8 AS avg_time_to_merge_seconds
+	FROM page p
+	JOIN team_members tm ON tm.team_id = p.id
+	JOIN users u ON u.id = tm.user_id
+	LEFT JOIN pull_request_reviewers prr ON prr.reviewer_id = u.id
+	LEFT JOIN pull_requests pr ON pr.id = prr.pull_request_id
+	GROUP BY p.name, u.id
+	ORDER BY p.name, u.user_id
+	`
+
+	rows, _ := db.Query(
+		ctx,
+		queryLoad,
+		filter.From,
+		filter.To,
+		teamName,
+		limit,
+		filter.Page.Offset,
+	)
+	reviewersLoadDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[ReviewerLoadDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to collect reviewers load: %w", err)
+	}
+
+	var teamsLoad []stats.TeamLoad
+	for _, reviewerLoadDB := range reviewersLoadDB {
+		if len(teamsLoad) == 0 || teamsLoad[len(teamsLoad)-1].TeamName != reviewerLoadDB.TeamName {
+			teamsLoad = append(teamsLoad, stats.TeamLoad{TeamName: reviewerLoadDB.TeamName})
+		}
+
+		team := &teamsLoad[len(teamsLoad)-1]
+		team.Reviewers = append(team.Reviewers, DBToDomainReviewerLoad(&reviewerLoadDB))
+	}
+
+	return teamsLoad, total, nil
+}
+
+// GetStatsPullRequestsTimeseries returns a point for every bucket overlapping the period,
+// empty buckets included. Only PRs created or merged within the period are counted.
+// A team filter rolls up the PRs of the team and all its subteams.
+func (r *PostgresStatsRepository) GetStatsPullRequestsTimeseries(
+	ctx context.Context,
+	filter *stats.TimeseriesFilter,
+) ([]stats.TimeseriesPoint, error) {
+	var teamName *string
+	if filter.TeamName != "" {
+		teamName = &filter.TeamName
+	}
+
+	const query = `
+	WITH RECURSIVE buckets AS (
+		SELECT generate_series(
+			date_trunc($3::text, $1::timestamp),
+			$2::timestamp - interval '1 microsecond',
+			('1 ' || $3::text)::interval
+		) AS bucket_start
+	),
+	subtree AS (
+		SELECT t.id FROM teams t WHERE t.name = $4
+		UNION ALL
+		SELECT c.id FROM subtree s
+		JOIN teams c ON c.parent_id = s.id
+	),
+	prs AS (
+		SELECT pr.created_at, pr.merged_at FROM pull_requests pr
+		WHERE $4::text IS NULL OR pr.team_id IN (SELECT id FROM subtree)
+	),
+	created AS (
+		SELECT date_trunc($3::text, created_at) AS bucket_start, COUNT(*) AS created
+		FROM prs
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY 1
+	),
+	merged AS (
+		SELECT
+			date_trunc($3::text, merged_at) AS bucket_start,
+			COUNT(*) AS merged,
+			percentile_cont(0.5) WITHIN GROUP (
+				ORDER BY EXTRACT(EPOCH FROM merged_at - created_at)::float8
+			) AS median_time_to_merge_seconds
+		FROM prs
+		WHERE merged_at >= $1 AND merged_at < $2
+		GROUP BY 1
+	)
+	SELECT
+		b.bucket_start,
+		COALESCE(c.created, 0) AS created,
+		COALESCE(m.merged, 0) AS merged,
+		m.median_time_to_merge_seconds
+	FROM buckets b
+	LEFT JOIN created c ON c.bucket_start = b.bucket_start
+	LEFT JOIN merged m ON m.bucket_start = b.bucket_start
+	ORDER BY b.bucket_start
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(
+		ctx,
+		query,
+		filter.From,
+		filter.To,
+		string(filter.Bucket),
+		teamName,
+	)
+	pointsDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[TimeseriesPointDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect timeseries: %w", err)
+	}
+
+	points := make([]stats.TimeseriesPoint, 0, len(pointsDB))
+	for _, pointDB := range pointsDB {
+		points = append(points, DBToDomainTimeseriesPoint(&pointDB))
+	}
+
+	return points, nil
+}
+
+// GetStatsReviewersDeclines returns reviewers offered a review on PRs created within the period,
+// the ones declining most often first. A team filter rolls up the PRs of the team and all its subteams.
+func (r *PostgresStatsRepository) GetStatsReviewersDeclines(
+	ctx context.Context,
+	filter *stats.DeclinesFilter,
+) ([]stats.ReviewerDeclines, error) {
+	var teamName *string
+	if filter.TeamName != "" {
+		teamName = &filter.TeamName
+	}
+
+	// a reviewer leaves pull_request_reviewers on decline, so offers are the union of both tables
+	const query = `
+	WITH RECURSIVE subtree AS (
+		SELECT t.id FROM teams t WHERE t.name = $3
+		UNION ALL
+		SELECT c.id FROM subtree s
+		JOIN teams c ON c.parent_id = s.id
+	),
+	offers AS (
+		SELECT prr.pull_request_id, prr.reviewer_id FROM pull_request_reviewers prr
+		UNION
+		SELECT rd.pull_request_id, rd.reviewer_id FROM review_declines rd
+	)
+	SELECT
+		u.user_id,
+		u.name AS username,
+		COUNT(*) AS assignments,
+		COUNT(rd.reason) AS declines,
+		COALESCE(
+			array_agg(rd.reason::text ORDER BY rd.reason) FILTER (WHERE rd.reason IS NOT NULL),
+			'{}'
+		) AS reasons
+	FROM offers o
+	JOIN pull_requests pr ON pr.id = o.pull_request_id
+	JOIN users u ON u.id = o.reviewer_id
+	LEFT JOIN review_declines rd ON rd.pull_request_id = o.pull_request_id AND rd.reviewer_id = o.reviewer_id
+	WHERE pr.created_at >= $1 AND pr.created_at < $2
+		AND ($3::text IS NULL OR pr.team_id IN (SELECT id FROM subtree))
+	GROUP BY u.id
+	ORDER BY COUNT(rd.reason)::float8 / COUNT(*) DESC, u.user_id
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, filter.From, filter.To, teamName)
+	reviewersDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[ReviewerDeclinesDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect reviewers declines: %w", err)
+	}
+
+	reviewers := make([]stats.ReviewerDeclines, 0, len(reviewersDB))
+	for _, reviewerDB := range reviewersDB {
+		reviewers = append(reviewers, DBToDomainReviewerDeclines(&reviewerDB))
+	}
+
+	return reviewers, nil
+}
@@ -5,16 +5,86 @@ import (
 )
 
 type MemberDB struct {
-	ID       string `db:"id"`
-	MemberID string `db:"user_id"`
-	Name     string `db:"name"`
-	IsActive bool   `db:"is_active"`
+	ID        string `db:"id"`
+	MemberID  string `db:"user_id"`
+	Name      string `db:"name"`
+	IsActive  bool   `db:"is_active"`
+	IsPrimary bool   `db:"is_primary"`
 }
 
 func DBToDomainMember(d *MemberDB) *teamsDomain.Member {
 	return &teamsDomain.Member{
-		ID:       d.MemberID,
-		Name:     d.Name,
-		IsActive: d.IsActive,
+		ID:        d.MemberID,
+		Name:      d.Name,
+		IsActive:  d.IsActive,
+		IsPrimary: d.IsPrimary,
+	}
+}
+
+type TeamMemberDB struct {
+	TeamName string `db:"team_name"`
+	MemberDB
+}
+
+type TeamIDDB struct {
+	ID   int64  `db:"id"`
+	Name string `db:"name"`
+}
+
+type TeamRefDB struct {
+	Name         string `db:"name"`
+	ParentName   string `db:"parent_name"`
+	MembersCount int    `db:"members_count"`
+}
+
+func DBToDomainTeamRef(d *TeamRefDB) teamsDomain.TeamRef {
+	return teamsDomain.TeamRef{
+		Name:         d.Name,
+		ParentName:   d.ParentName,
+		MembersCount: d.MembersCount,
+	}
+}
+
+type RuleDB struct {
+	Kind        teamsDomain.RuleKind `db:"kind"`
+	UserID      string               `db:"user_id"`
+	OtherUserID string               `db:"other_user_id"`
+}
+
+func DBToDomainRule(d *RuleDB) teamsDomain.Rule {
+	return teamsDomain.Rule{
+		Kind:        d.Kind,
+		UserID:      d.UserID,
+		OtherUserID: d.OtherUserID,
+	}
+}
+
+type ReviewRuleDB struct {
+	MinLinesChanged int    `db:"min_lines_changed"`
+	Label           string `db:"label"`
+	ReviewersCount  int    `db:"reviewers_count"`
+	ExtraTeamName   string `db:"extra_team_name"`
+}
+
+func DBToDomainReviewRule(d *ReviewRuleDB) teamsDomain.ReviewRule {
+	return teamsDomain.ReviewRule{
+		MinLinesChanged: d.MinLinesChanged,
+		Label:           d.Label,
+		ReviewersCount:  d.ReviewersCount,
+		ExtraTeamName:   d.ExtraTeamName,
+	}
+}
+
+type RequiredGroupDB struct {
+	TeamName     string   `db:"team_name"`
+	Labels       []string `db:"labels"`
+	PathPrefixes []string `db:"path_prefixes"`
+}
+
+func DBToDomainRequiredGroup(d *RequiredGroupDB) teamsDomain.RequiredGroup {
+	return teamsDomain.RequiredGroup{
+		TeamName:     d.TeamName,
+		Labels:       d.Labels,
+		PathPrefixes: d.PathPrefixes,
 	}
 }
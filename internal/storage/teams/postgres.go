@@ -9,9 +9,13 @@ import (
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// uniqueViolation is the SQLSTATE of a unique constraint violation.
+const uniqueViolation = "23505"
+
 type PostgresTeamRepository struct {
 	pool   *pgxpool.Pool
 	getter *trmpgx.CtxGetter
@@ -31,20 +35,33 @@ func (r *PostgresTeamRepository) GetTeamByName(
 	ctx context.Context,
 	teamName string,
 ) (*teamsDomain.Team, error) {
-	const query = `
-	SELECT u.id, u.user_id, u.name, u.is_active FROM teams t
-	JOIN users u ON t.id = u.team_id
+	const queryTeam = `
+	SELECT t.id, COALESCE(p.name, '') FROM teams t
+	LEFT JOIN teams p ON p.id = t.parent_id
 	WHERE t.name = $1
 	`
 
-	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamName)
-	membersDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[MemberDB])
+	var teamID int64
+	var parentName string
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).QueryRow(ctx, queryTeam, teamName).Scan(&teamID, &parentName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, service.ErrTeamNotFound
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to collect team members: %w", err)
+		return nil, fmt.Errorf("failed to find team: %w", err)
 	}
 
-	if len(membersDB) == 0 {
-		return nil, service.ErrTeamNotFound
+	const query = `
+	SELECT u.id, u.user_id, u.name, u.is_active, tm.is_primary FROM users u
+	JOIN team_members tm ON tm.user_id = u.id
+	WHERE tm.team_id = $1
+	ORDER BY u.id
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamID)
+	membersDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[MemberDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect team members: %w", err)
 	}
 
 	members := make([]teamsDomain.Member, 0, len(membersDB))
@@ -53,11 +70,188 @@ func (r *PostgresTeamRepository) GetTeamByName(
 	}
 
 	return &teamsDomain.Team{
-		Name:    teamName,
-		Members: members,
+		Name:       teamName,
+		ParentName: parentName,
+		Members:    members,
 	}, nil
 }
 
+// GetAncestors returns the teams above the given one with their members, nearest first.
+// Ancestors without members are left out.
+// GetExistingTeamNames returns the ones of teamNames that are stored.
+func (r *PostgresTeamRepository) GetExistingTeamNames(ctx context.Context, teamNames []string) ([]string, error) {
+	const query = `
+	SELECT name FROM teams
+	WHERE name = ANY($1)
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamNames)
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect team names: %w", err)
+	}
+
+	return existing, nil
+}
+
+func (r *PostgresTeamRepository) GetAncestors(
+	ctx context.Context,
+	teamName string,
+) ([]teamsDomain.Team, error) {
+	const query = `
+	WITH RECURSIVE ancestors AS (
+		SELECT p.id, p.name, p.parent_id, 1 AS depth FROM teams t
+		JOIN teams p ON p.id = t.parent_id
+		WHERE t.name = $1
+		UNION ALL
+		SELECT p.id, p.name, p.parent_id, a.depth + 1 FROM ancestors a
+		JOIN teams p ON p.id = a.parent_id
+	)
+	SELECT a.name team_name, u.id, u.user_id, u.name, u.is_active, tm.is_primary FROM ancestors a
+	JOIN team_members tm ON tm.team_id = a.id
+	JOIN users u ON u.id = tm.user_id
+	ORDER BY a.depth, u.id
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamName)
+	membersDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[TeamMemberDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect ancestor members: %w", err)
+	}
+
+	var ancestors []teamsDomain.Team
+	for _, member := range membersDB {
+		if len(ancestors) == 0 || ancestors[len(ancestors)-1].Name != member.TeamName {
+			ancestors = append(ancestors, teamsDomain.Team{Name: member.TeamName})
+		}
+
+		ancestor := &ancestors[len(ancestors)-1]
+		ancestor.Members = append(ancestor.Members, *DBToDomainMember(&member.MemberDB))
+	}
+
+	return ancestors, nil
+}
+
+// GetTree returns the subtree under rootName, or every team when rootName is nil,
+// parents before children and siblings ordered by name.
+func (r *PostgresTeamRepository) GetTree(
+	ctx context.Context,
+	rootName *string,
+) ([]teamsDomain.TeamRef, error) {
+	const query = `
+	WITH RECURSIVE tree AS (
+		SELECT t.id, t.parent_id, ARRAY[t.name::text] path FROM teams t
+		WHERE ($1::text IS NULL AND t.parent_id IS NULL) OR t.name = $1
+		UNION ALL
+		SELECT c.id, c.parent_id, tr.path || c.name::text FROM tree tr
+		JOIN teams c ON c.parent_id = tr.id
+	)
+	SELECT
+		t.name,
+		COALESCE(p.name, '') parent_name,
+		(SELECT COUNT(*) FROM team_members tm WHERE tm.team_id = t.id) members_count
+	FROM tree tr
+	JOIN teams t ON t.id = tr.id
+	LEFT JOIN teams p ON p.id = tr.parent_id
+	ORDER BY tr.path
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, rootName)
+	refsDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[TeamRefDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect team tree: %w", err)
+	}
+	if rootName != nil && len(refsDB) == 0 {
+		return nil, service.ErrTeamNotFound
+	}
+
+	refs := make([]teamsDomain.TeamRef, 0, len(refsDB))
+	for _, ref := range refsDB {
+		refs = append(refs, DBToDomainTeamRef(&ref))
+	}
+
+	return refs, nil
+}
+
+// SetParent nests the team under parentName, a nil parentName makes it a top-level team.
+// Both teams are locked first, so concurrent calls cannot close a cycle together.
+func (r *PostgresTeamRepository) SetParent(
+	ctx context.Context,
+	teamName string,
+	parentName *string,
+) error {
+	tx, err := r.getter.DefaultTrOrDB(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	const queryLock = `
+	SELECT id, name FROM teams
+	WHERE name = $1 OR name = $2
+	ORDER BY id
+	FOR UPDATE
+	`
+
+	rows, _ := tx.Query(ctx, queryLock, teamName, parentName)
+	lockedDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[TeamIDDB])
+	if err != nil {
+		return fmt.Errorf("failed to lock teams: %w", err)
+	}
+
+	teamIDs := make(map[string]int64, len(lockedDB))
+	for _, locked := range lockedDB {
+		teamIDs[locked.Name] = locked.ID
+	}
+
+	teamID, ok := teamIDs[teamName]
+	if !ok {
+		return service.ErrTeamNotFound
+	}
+
+	var parentID *int64
+	if parentName != nil {
+		id, ok := teamIDs[*parentName]
+		if !ok {
+			return service.ErrTeamNotFound
+		}
+		parentID = &id
+
+		const queryIsAncestor = `
+		WITH RECURSIVE lineage AS (
+			SELECT id, parent_id FROM teams WHERE id = $1
+			UNION ALL
+			SELECT t.id, t.parent_id FROM lineage l
+			JOIN teams t ON t.id = l.parent_id
+		)
+		SELECT EXISTS (SELECT 1 FROM lineage WHERE id = $2)
+		`
+
+		var isCycle bool
+		if err = tx.QueryRow(ctx, queryIsAncestor, id, teamID).Scan(&isCycle); err != nil {
+			return fmt.Errorf("failed to check team lineage: %w", err)
+		}
+		if isCycle {
+			return service.ErrTeamCycle
+		}
+	}
+
+	const querySetParent = `
+	UPDATE teams SET parent_id = $2 WHERE id = $1
+	`
+
+	if _, err = tx.Exec(ctx, querySetParent, teamID, parentID); err != nil {
+		return fmt.Errorf("failed to set parent team: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SaveTeam creates the team, members known from other teams join it with the name and activity given here.
 func (r *PostgresTeamRepository) SaveTeam(
 	ctx context.Context,
 	teamName string,
@@ -80,14 +274,9 @@ func (r *PostgresTeamRepository) SaveTeam(
 		return 0, fmt.Errorf("failed to create team: %w", err)
 	}
 
-	const queryInsertMember = `
-	INSERT INTO users (user_id, name, team_id, is_active)
-	VALUES ($1, $2, $3, $4)
-	`
-
 	batch := &pgx.Batch{}
 	for _, member := range members {
-		batch.Queue(queryInsertMember, member.ID, member.Name, teamID, member.IsActive)
+		batch.Queue(queryUpsertMember, teamID, member.ID, member.Name, member.IsActive)
 	}
 
 	if err = tx.SendBatch(ctx, batch).Close(); err != nil {
@@ -126,9 +315,10 @@ func (r *PostgresTeamRepository) UpdateMembers(
 	}
 
 	const query = `
-	UPDATE users
-	SET name = $1, is_active = $2 
-	WHERE user_id = $3 AND team_id = $4
+	UPDATE users u
+	SET name = $1, is_active = $2
+	FROM team_members tm
+	WHERE tm.user_id = u.id AND u.user_id = $3 AND tm.team_id = $4
 	`
 
 	batch := &pgx.Batch{}
@@ -148,3 +338,488 @@ func (r *PostgresTeamRepository) UpdateMembers(
 
 	return nil
 }
+
+// queryUpsertMember creates the user or refreshes a known one and adds the membership.
+// The team becomes the primary one for users without a primary team. Returns no rows for existing members.
+const queryUpsertMember = `
+	WITH upserted AS (
+		INSERT INTO users (user_id, name, is_active)
+		VALUES ($2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE
+		SET name = EXCLUDED.name, is_active = EXCLUDED.is_active
+		RETURNING id
+	)
+	INSERT INTO team_members (team_id, user_id, is_primary)
+	SELECT $1, u.id, NOT EXISTS (
+		SELECT 1 FROM team_members tm WHERE tm.user_id = u.id AND tm.is_primary
+	)
+	FROM upserted u
+	ON CONFLICT (team_id, user_id) DO NOTHING
+	RETURNING user_id
+	`
+
+// AddMember adds the user to the team, users of other teams keep their memberships and primary team.
+func (r *PostgresTeamRepository) AddMember(
+	ctx context.Context,
+	teamName string,
+	member *teamsDomain.Member,
+) error {
+	tx, err := r.getter.DefaultTrOrDB(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	const queryTeamID = `
+	SELECT id FROM teams WHERE name = $1
+	`
+
+	var teamID int64
+	err = tx.QueryRow(ctx, queryTeamID, teamName).Scan(&teamID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find team: %w", err)
+	}
+
+	var surrogateUserID int64
+	err = tx.QueryRow(ctx, queryUpsertMember, teamID, member.ID, member.Name, member.IsActive).
+		Scan(&surrogateUserID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamMemberAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert member: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// MoveMember moves the user from one team to another, a nil toTeamName only removes the membership.
+// A moved user keeps the primary flag, a removed primary membership passes it to the oldest remaining one.
+// Rules of the old team binding the user are dropped along the way.
+func (r *PostgresTeamRepository) MoveMember(
+	ctx context.Context,
+	userID string,
+	fromTeamName string,
+	toTeamName *string,
+) error {
+	tx, err := r.getter.DefaultTrOrDB(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	const queryLeave = `
+	DELETE FROM team_members tm
+	USING teams t, users u
+	WHERE t.id = tm.team_id AND u.id = tm.user_id AND t.name = $2 AND u.user_id = $1
+	RETURNING tm.team_id, tm.user_id, tm.is_primary
+	`
+
+	var oldTeamID, surrogateUserID int64
+	var wasPrimary bool
+	err = tx.QueryRow(ctx, queryLeave, userID, fromTeamName).Scan(&oldTeamID, &surrogateUserID, &wasPrimary)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamMemberNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove membership: %w", err)
+	}
+
+	const queryDropRules = `
+	DELETE FROM team_rules tr
+	WHERE tr.team_id = $1 AND $2 IN (tr.user_id, tr.other_user_id)
+	`
+
+	if _, err = tx.Exec(ctx, queryDropRules, oldTeamID, userID); err != nil {
+		return fmt.Errorf("failed to drop team rules: %w", err)
+	}
+
+	if toTeamName != nil {
+		const queryJoin = `
+		INSERT INTO team_members (team_id, user_id, is_primary)
+		SELECT t.id, $2, $3 FROM teams t
+		WHERE t.name = $1
+		ON CONFLICT (team_id, user_id) DO NOTHING
+		RETURNING team_id
+		`
+
+		var newTeamID int64
+		err = tx.QueryRow(ctx, queryJoin, *toTeamName, surrogateUserID, wasPrimary).Scan(&newTeamID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrTeamMemberAlreadyExists
+		}
+		if err != nil {
+			return fmt.Errorf("failed to add membership: %w", err)
+		}
+	} else if wasPrimary {
+		const queryPromote = `
+		UPDATE team_members SET is_primary = true
+		WHERE user_id = $1 AND team_id = (SELECT MIN(team_id) FROM team_members WHERE user_id = $1)
+		`
+
+		if _, err = tx.Exec(ctx, queryPromote, surrogateUserID); err != nil {
+			return fmt.Errorf("failed to promote primary team: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) RenameTeam(
+	ctx context.Context,
+	teamName string,
+	newTeamName string,
+) error {
+	const query = `
+	UPDATE teams SET name = $2
+	WHERE name = $1
+	RETURNING id
+	`
+
+	var teamID int64
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).
+		QueryRow(ctx, query, teamName, newTeamName).
+		Scan(&teamID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamNotFound
+	}
+	// the unique index on the name settles concurrent renames and creations
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return service.ErrTeamAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to rename team: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTeam deletes the team with its rules, teams with members or subteams are kept.
+func (r *PostgresTeamRepository) DeleteTeam(
+	ctx context.Context,
+	teamName string,
+) error {
+	const query = `
+	DELETE FROM teams t
+	WHERE t.name = $1
+	  AND NOT EXISTS (SELECT 1 FROM team_members tm WHERE tm.team_id = t.id)
+	  AND NOT EXISTS (SELECT 1 FROM teams c WHERE c.parent_id = t.id)
+	RETURNING t.id
+	`
+
+	var teamID int64
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).
+		QueryRow(ctx, query, teamName).
+		Scan(&teamID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamNotEmpty
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) GetRules(
+	ctx context.Context,
+	teamName string,
+) ([]teamsDomain.Rule, error) {
+	const query = `
+	SELECT tr.kind, tr.user_id, tr.other_user_id FROM team_rules tr
+	JOIN teams t ON t.id = tr.team_id
+	WHERE t.name = $1
+	ORDER BY tr.id
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamName)
+	rulesDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[RuleDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect team rules: %w", err)
+	}
+
+	rules := make([]teamsDomain.Rule, 0, len(rulesDB))
+	for _, rule := range rulesDB {
+		rules = append(rules, DBToDomainRule(&rule))
+	}
+
+	return rules, nil
+}
+
+// GetInheritedRules returns the rules of the team together with the rules of all its ancestors.
+func (r *PostgresTeamRepository) GetInheritedRules(
+	ctx context.Context,
+	teamName string,
+) ([]teamsDomain.Rule, error) {
+	const query = `
+	WITH RECURSIVE lineage AS (
+		SELECT id, parent_id, 0 AS depth FROM teams WHERE name = $1
+		UNION ALL
+		SELECT t.id, t.parent_id, l.depth + 1 FROM lineage l
+		JOIN teams t ON t.id = l.parent_id
+	)
+	SELECT tr.kind, tr.user_id, tr.other_user_id FROM team_rules tr
+	JOIN lineage l ON l.id = tr.team_id
+	ORDER BY l.depth, tr.id
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamName)
+	rulesDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[RuleDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect inherited team rules: %w", err)
+	}
+
+	rules := make([]teamsDomain.Rule, 0, len(rulesDB))
+	for _, rule := range rulesDB {
+		rules = append(rules, DBToDomainRule(&rule))
+	}
+
+	return rules, nil
+}
+
+func (r *PostgresTeamRepository) AddRule(
+	ctx context.Context,
+	teamName string,
+	rule *teamsDomain.Rule,
+) error {
+	const query = `
+	INSERT INTO team_rules (team_id, kind, user_id, other_user_id)
+	SELECT t.id, $2::team_rule_kind, $3, $4 FROM teams t
+	WHERE t.name = $1
+	ON CONFLICT DO NOTHING
+	RETURNING id
+	`
+
+	var ruleID int64
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).
+		QueryRow(ctx, query, teamName, rule.Kind, rule.UserID, rule.OtherUserID).
+		Scan(&ruleID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamRuleAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert team rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) DeleteRule(
+	ctx context.Context,
+	teamName string,
+	rule *teamsDomain.Rule,
+) error {
+	const query = `
+	DELETE FROM team_rules tr
+	USING teams t
+	WHERE t.id = tr.team_id
+	  AND t.name = $1 AND tr.kind = $2 AND tr.user_id = $3 AND tr.other_user_id = $4
+	RETURNING tr.id
+	`
+
+	var ruleID int64
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).
+		QueryRow(ctx, query, teamName, rule.Kind, rule.UserID, rule.OtherUserID).
+		Scan(&ruleID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamRuleNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete team rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) GetReviewRules(
+	ctx context.Context,
+	teamName string,
+) ([]teamsDomain.ReviewRule, error) {
+	const query = `
+	SELECT trr.min_lines_changed, trr.label, trr.reviewers_count, COALESCE(et.name, '') AS extra_team_name
+	FROM team_review_rules trr
+	JOIN teams t ON t.id = trr.team_id
+	LEFT JOIN teams et ON et.id = trr.extra_team_id
+	WHERE t.name = $1
+	ORDER BY trr.id
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamName)
+	rulesDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[ReviewRuleDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect review rules: %w", err)
+	}
+
+	rules := make([]teamsDomain.ReviewRule, 0, len(rulesDB))
+	for _, rule := range rulesDB {
+		rules = append(rules, DBToDomainReviewRule(&rule))
+	}
+
+	return rules, nil
+}
+
+// GetInheritedReviewRules returns the review rules of the team together with the ones of all its ancestors.
+func (r *PostgresTeamRepository) GetInheritedReviewRules(
+	ctx context.Context,
+	teamName string,
+) ([]teamsDomain.ReviewRule, error) {
+	const query = `
+	WITH RECURSIVE lineage AS (
+		SELECT id, parent_id, 0 AS depth FROM teams WHERE name = $1
+		UNION ALL
+		SELECT t.id, t.parent_id, l.depth + 1 FROM lineage l
+		JOIN teams t ON t.id = l.parent_id
+	)
+	SELECT trr.min_lines_changed, trr.label, trr.reviewers_count, COALESCE(et.name, '') AS extra_team_name
+	FROM team_review_rules trr
+	JOIN lineage l ON l.id = trr.team_id
+	LEFT JOIN teams et ON et.id = trr.extra_team_id
+	ORDER BY l.depth, trr.id
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, teamName)
+	rulesDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[ReviewRuleDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect inherited review rules: %w", err)
+	}
+
+	rules := make([]teamsDomain.ReviewRule, 0, len(rulesDB))
+	for _, rule := range rulesDB {
+		rules = append(rules, DBToDomainReviewRule(&rule))
+	}
+
+	return rules, nil
+}
+
+// AddReviewRule expects the extra team of the rule to exist, the rule is stored without it otherwise.
+func (r *PostgresTeamRepository) AddReviewRule(
+	ctx context.Context,
+	teamName string,
+	rule *teamsDomain.ReviewRule,
+) error {
+	const query = `
+	INSERT INTO team_review_rules (team_id, min_lines_changed, label, reviewers_count, extra_team_id)
+	SELECT t.id, $2, $3, $4, (SELECT et.id FROM teams et WHERE et.name = NULLIF($5, ''))
+	FROM teams t
+	WHERE t.name = $1
+	ON CONFLICT DO NOTHING
+	RETURNING id
+	`
+
+	var ruleID int64
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).
+		QueryRow(ctx, query, teamName, rule.MinLinesChanged, rule.Label, rule.ReviewersCount, rule.ExtraTeamName).
+		Scan(&ruleID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamRuleAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert review rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) DeleteReviewRule(
+	ctx context.Context,
+	teamName string,
+	rule *teamsDomain.ReviewRule,
+) error {
+	const query = `
+	DELETE FROM team_review_rules trr
+	USING teams t
+	WHERE t.id = trr.team_id
+	  AND t.name = $1 AND trr.min_lines_changed = $2 AND trr.label = $3 AND trr.reviewers_count = $4
+	  AND trr.extra_team_id IS NOT DISTINCT FROM (SELECT et.id FROM teams et WHERE et.name = NULLIF($5, ''))
+	RETURNING trr.id
+	`
+
+	var ruleID int64
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).
+		QueryRow(ctx, query, teamName, rule.MinLinesChanged, rule.Label, rule.ReviewersCount, rule.ExtraTeamName).
+		Scan(&ruleID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTeamRuleNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete review rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) GetRequiredGroups(ctx context.Context) ([]teamsDomain.RequiredGroup, error) {
+	const query = `
+	SELECT t.name AS team_name, rrg.labels, rrg.path_prefixes
+	FROM required_reviewer_groups rrg
+	JOIN teams t ON t.id = rrg.team_id
+	ORDER BY t.name
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query)
+	groupsDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[RequiredGroupDB])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect required groups: %w", err)
+	}
+
+	groups := make([]teamsDomain.RequiredGroup, 0, len(groupsDB))
+	for _, group := range groupsDB {
+		groups = append(groups, DBToDomainRequiredGroup(&group))
+	}
+
+	return groups, nil
+}
+
+// SetRequiredGroup makes the team a required group or replaces what it matches.
+func (r *PostgresTeamRepository) SetRequiredGroup(ctx context.Context, group *teamsDomain.RequiredGroup) error {
+	const query = `
+	INSERT INTO required_reviewer_groups (team_id, labels, path_prefixes)
+	SELECT t.id, $2, $3 FROM teams t
+	WHERE t.name = $1
+	ON CONFLICT (team_id) DO UPDATE SET labels = EXCLUDED.labels, path_prefixes = EXCLUDED.path_prefixes
+	`
+
+	// nil slices are sent as NULL, the columns expect empty arrays
+	labels := append([]string{}, group.Labels...)
+	pathPrefixes := append([]string{}, group.PathPrefixes...)
+
+	tag, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(ctx, query, group.TeamName, labels, pathPrefixes)
+	if err != nil {
+		return fmt.Errorf("failed to upsert required group: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) DeleteRequiredGroup(ctx context.Context, teamName string) error {
+	const query = `
+	DELETE FROM required_reviewer_groups rrg
+	USING teams t
+	WHERE t.id = rrg.team_id AND t.name = $1
+	`
+
+	tag, err := r.getter.DefaultTrOrDB(ctx, r.pool).Exec(ctx, query, teamName)
+	if err != nil {
+		return fmt.Errorf("failed to delete required group: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrRequiredGroupNotFound
+	}
+
+	return nil
+}
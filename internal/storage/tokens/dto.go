@@ -0,0 +1,34 @@
+package tokens
+
+import (
+	"reviewer-assigner/internal/domain/access"
+	tokensDomain "reviewer-assigner/internal/domain/tokens"
+	"time"
+)
+
+type TokenDB struct {
+	ID        string      `db:"token_id"`
+	Actor     string      `db:"actor"`
+	Role      access.Role `db:"role"`
+	Hash      string      `db:"token_hash"`
+	Scopes    []string    `db:"scopes"`
+	CreatedAt time.Time   `db:"created_at"`
+	RevokedAt *time.Time  `db:"revoked_at"`
+}
+
+func DBToDomainToken(d *TokenDB) *tokensDomain.Token {
+	scopes := make([]access.Scope, 0, len(d.Scopes))
+	for _, scope := range d.Scopes {
+		scopes = append(scopes, access.Scope(scope))
+	}
+
+	return &tokensDomain.Token{
+		ID:        d.ID,
+		Actor:     d.Actor,
+		Role:      d.Role,
+		Hash:      d.Hash,
+		Scopes:    scopes,
+		CreatedAt: d.CreatedAt,
+		RevokedAt: d.RevokedAt,
+	}
+}
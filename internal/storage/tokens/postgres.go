@@ -0,0 +1,89 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	tokensDomain "reviewer-assigner/internal/domain/tokens"
+	"reviewer-assigner/internal/service"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresTokenRepository struct {
+	pool   *pgxpool.Pool
+	getter *trmpgx.CtxGetter
+}
+
+func NewPostgresTokenRepository(
+	pool *pgxpool.Pool,
+	getter *trmpgx.CtxGetter,
+) *PostgresTokenRepository {
+	return &PostgresTokenRepository{
+		pool:   pool,
+		getter: getter,
+	}
+}
+
+func (r *PostgresTokenRepository) SaveToken(ctx context.Context, token *tokensDomain.Token) error {
+	const query = `
+	INSERT INTO api_tokens (token_id, actor, role, token_hash, scopes)
+	VALUES ($1, $2, $3::access_role, $4, $5)
+	RETURNING created_at
+	`
+
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).
+		QueryRow(ctx, query, token.ID, token.Actor, token.Role, token.Hash, scopes).
+		Scan(&token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTokenRepository) GetTokenByHash(
+	ctx context.Context,
+	hash string,
+) (*tokensDomain.Token, error) {
+	const query = `
+	SELECT token_id, actor, role, token_hash, scopes, created_at, revoked_at FROM api_tokens
+	WHERE token_hash = $1
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, hash)
+	tokenDB, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[TokenDB])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, service.ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	return DBToDomainToken(&tokenDB), nil
+}
+
+func (r *PostgresTokenRepository) RevokeToken(ctx context.Context, tokenID string) error {
+	const query = `
+	UPDATE api_tokens SET revoked_at = NOW()
+	WHERE token_id = $1 AND revoked_at IS NULL
+	RETURNING token_id
+	`
+
+	err := r.getter.DefaultTrOrDB(ctx, r.pool).QueryRow(ctx, query, tokenID).Scan(&tokenID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.ErrTokenNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	return nil
+}
@@ -6,11 +6,13 @@ import (
 )
 
 type UserDB struct {
-	ID       int64  `db:"id"`
-	UserID   string `db:"user_id"`
-	Name     string `db:"name"`
-	IsActive bool   `db:"is_active"`
-	TeamName string `db:"team_name"`
+	ID          int64    `db:"id"`
+	UserID      string   `db:"user_id"`
+	Name        string   `db:"name"`
+	IsActive    bool     `db:"is_active"`
+	TeamName    string   `db:"team_name"`
+	TeamNames   []string `db:"team_names"`
+	OpenReviews int      `db:"open_reviews"`
 }
 
 func toDomainUser(u *UserDB) *usersDomain.User {
@@ -20,6 +22,8 @@ func toDomainUser(u *UserDB) *usersDomain.User {
 			Name:     u.Name,
 			IsActive: u.IsActive,
 		},
-		TeamName: u.TeamName,
+		TeamName:    u.TeamName,
+		TeamNames:   u.TeamNames,
+		OpenReviews: u.OpenReviews,
 	}
 }
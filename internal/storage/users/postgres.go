@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 	"github.com/jackc/pgx/v5"
@@ -28,14 +29,33 @@ func NewPostgresUserRepository(
 	}
 }
 
+// querySelectUsers reads users with their teams and open reviews,
+// users taken out of their last team have an empty team name.
+const querySelectUsers = `
+	SELECT
+	    u.id, u.user_id, u.name, u.is_active, COALESCE(t.name, '') team_name,
+	    ARRAY(
+	        SELECT ot.name FROM team_members otm
+	        JOIN teams ot ON ot.id = otm.team_id
+	        WHERE otm.user_id = u.id
+	        ORDER BY otm.is_primary DESC, ot.name
+	    ) team_names,
+	    (
+	        SELECT COUNT(*) FROM pull_request_reviewers prr
+	        JOIN pull_requests pr ON pr.id = prr.pull_request_id
+	        WHERE prr.reviewer_id = u.id AND pr.status = 'OPEN'
+	    ) open_reviews
+	FROM users u
+	LEFT JOIN team_members tm ON tm.user_id = u.id AND tm.is_primary
+	LEFT JOIN teams t ON t.id = tm.team_id
+	`
+
 func (r *PostgresUserRepository) GetUserByID(
 	ctx context.Context,
 	userID string,
 ) (*usersDomain.User, error) {
-	const query = `
-	SELECT u.id, u.user_id, u.name, u.is_active, t.name team_name FROM users u
-	JOIN teams t ON t.id = u.team_id
-	WHERE user_id = $1
+	const query = querySelectUsers + `
+	WHERE u.user_id = $1
 	`
 
 	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, userID)
@@ -50,6 +70,86 @@ func (r *PostgresUserRepository) GetUserByID(
 	return toDomainUser(userDB), nil
 }
 
+// ListUsers returns a page of users matching the filter ordered by name
+// and the total number of matching users.
+func (r *PostgresUserRepository) ListUsers(
+	ctx context.Context,
+	filter *usersDomain.Filter,
+) ([]usersDomain.User, int, error) {
+	const queryFilter = `
+	WHERE ($1::text IS NULL OR EXISTS (
+	        SELECT 1 FROM team_members ftm
+	        JOIN teams ft ON ft.id = ftm.team_id
+	        WHERE ftm.user_id = u.id AND ft.name = $1
+	    ))
+	  AND ($2::bool IS NULL OR u.is_active = $2)
+	  AND ($3::text IS NULL OR u.name ILIKE $3 || '%')
+	`
+
+	var teamName, namePrefix *string
+	if filter.TeamName != "" {
+		teamName = &filter.TeamName
+	}
+	if filter.NamePrefix != "" {
+		escaped := likeEscaper.Replace(filter.NamePrefix)
+		namePrefix = &escaped
+	}
+
+	var limit *int
+	if filter.Limit > 0 {
+		limit = &filter.Limit
+	}
+
+	db := r.getter.DefaultTrOrDB(ctx, r.pool)
+
+	const queryCount = `
+	SELECT COUNT(*) FROM users u
+	` + queryFilter
+
+	var total int
+	err := db.QueryRow(ctx, queryCount, teamName, filter.IsActive, namePrefix).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	const query = querySelectUsers + queryFilter + `
+	ORDER BY u.name, u.user_id
+	LIMIT $4 OFFSET $5
+	`
+
+	rows, _ := db.Query(ctx, query, teamName, filter.IsActive, namePrefix, limit, filter.Offset)
+	usersDB, err := pgx.CollectRows(rows, pgx.RowToStructByName[UserDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to collect users: %w", err)
+	}
+
+	users := make([]usersDomain.User, 0, len(usersDB))
+	for _, userDB := range usersDB {
+		users = append(users, *toDomainUser(&userDB))
+	}
+
+	return users, total, nil
+}
+
+// likeEscaper keeps LIKE wildcards typed by the caller literal.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetExistingUserIDs returns the ones of userIDs that are stored.
+func (r *PostgresUserRepository) GetExistingUserIDs(ctx context.Context, userIDs []string) ([]string, error) {
+	const query = `
+	SELECT user_id FROM users
+	WHERE user_id = ANY($1)
+	`
+
+	rows, _ := r.getter.DefaultTrOrDB(ctx, r.pool).Query(ctx, query, userIDs)
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect user IDs: %w", err)
+	}
+
+	return existing, nil
+}
+
 func (r *PostgresUserRepository) UpdateIsActive(ctx context.Context, user *usersDomain.User) error {
 	const query = `
 	UPDATE users SET is_active = $1
@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"reviewer-assigner/internal/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetup_UnknownExporter(t *testing.T) {
+	_, err := Setup(context.Background(), &config.Tracing{Exporter: "jaeger"})
+	require.ErrorIs(t, err, ErrUnknownExporter)
+}
+
+func TestStartEnd(t *testing.T) {
+	spans := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(NewProvider(sdktrace.WithSyncer(spans)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	ctx, parent := Start(context.Background(), "parent")
+	_, child := Start(ctx, "child")
+	End(child, errors.New("boom"))
+	End(parent, nil)
+
+	got := spans.GetSpans()
+	require.Len(t, got, 2)
+
+	assert.Equal(t, "child", got[0].Name)
+	assert.Equal(t, codes.Error, got[0].Status.Code)
+	assert.Equal(t, "boom", got[0].Status.Description)
+	assert.Equal(t, got[1].SpanContext.SpanID(), got[0].Parent.SpanID())
+
+	assert.Equal(t, "parent", got[1].Name)
+	assert.Equal(t, codes.Unset, got[1].Status.Code)
+}
+
+func TestOperation(t *testing.T) {
+	testCases := []struct {
+		sql      string
+		expected string
+	}{
+		{sql: "SELECT 1", expected: "SELECT"},
+		{sql: "\n\t insert INTO teams VALUES ($1)", expected: "INSERT"},
+		{sql: "", expected: "query"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, operation(tc.sql))
+		})
+	}
+}
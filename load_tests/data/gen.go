@@ -22,8 +22,9 @@ type Team struct {
 	Name string
 }
 
+// ToStringSlice leaves parent_id empty, generated teams are top-level.
 func (t *Team) ToStringSlice() []string {
-	return []string{strconv.FormatInt(t.ID, 10), t.Name}
+	return []string{strconv.FormatInt(t.ID, 10), t.Name, ""}
 }
 
 type User struct {
@@ -39,11 +40,15 @@ func (u *User) ToStringSlice() []string {
 		strconv.FormatInt(u.ID, 10),
 		u.UserID,
 		u.Name,
-		strconv.FormatInt(u.TeamID, 10),
 		strconv.FormatBool(u.IsActive),
 	}
 }
 
+// ToMembershipStringSlice makes the user's team the primary one.
+func (u *User) ToMembershipStringSlice() []string {
+	return []string{strconv.FormatInt(u.TeamID, 10), strconv.FormatInt(u.ID, 10), "true"}
+}
+
 type PullRequest struct {
 	ID            int64
 	PullRequestID string
@@ -52,6 +57,7 @@ type PullRequest struct {
 	Status        string
 	CreatedAt     time.Time
 	MergedAt      *time.Time
+	TeamID        int64
 }
 
 func (p *PullRequest) ToStringSlice() []string {
@@ -67,6 +73,7 @@ func (p *PullRequest) ToStringSlice() []string {
 		p.Status,
 		p.CreatedAt.Format(time.RFC3339),
 		mergedAt,
+		strconv.FormatInt(p.TeamID, 10),
 	}
 }
 
@@ -123,6 +130,7 @@ func main() {
 			AuthorID:      author.UserID,
 			Status:        status,
 			CreatedAt:     createdAt,
+			TeamID:        author.TeamID,
 		}
 
 		if status == string(prDomain.StatusMerged) {
@@ -193,8 +201,10 @@ func main() {
 	}
 
 	userRecords := make([][]string, len(users))
+	teamMemberRecords := make([][]string, len(users))
 	for i, user := range users {
 		userRecords[i] = user.ToStringSlice()
+		teamMemberRecords[i] = user.ToMembershipStringSlice()
 	}
 
 	pullRequestRecords := make([][]string, len(pullRequests))
@@ -212,8 +222,9 @@ func main() {
 		headers  []string
 		records  [][]string
 	}{
-		{"teams.csv", []string{"id", "name"}, teamRecords},
-		{"users.csv", []string{"id", "user_id", "name", "team_id", "is_active"}, userRecords},
+		{"teams.csv", []string{"id", "name", "parent_id"}, teamRecords},
+		{"users.csv", []string{"id", "user_id", "name", "is_active"}, userRecords},
+		{"team_members.csv", []string{"team_id", "user_id", "is_primary"}, teamMemberRecords},
 		{
 			"pull_requests.csv",
 			[]string{
@@ -224,6 +235,7 @@ func main() {
 				"status",
 				"created_at",
 				"merged_at",
+				"team_id",
 			},
 			pullRequestRecords,
 		},
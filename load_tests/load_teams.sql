@@ -6,8 +6,9 @@ SELECT json_agg(json_build_object(
                     'username', u.name,
                     'is_active', u.is_active))
             FROM users u
-            WHERE u.team_id = t.id
+            JOIN team_members tm ON tm.user_id = u.id
+            WHERE tm.team_id = t.id
         )
 ))
 FROM teams t
-WHERE EXISTS (SELECT 1 FROM users u WHERE u.team_id = t.id);
+WHERE EXISTS (SELECT 1 FROM team_members tm WHERE tm.team_id = t.id);
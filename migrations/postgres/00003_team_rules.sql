@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE team_rule_kind AS ENUM ('CONFLICT_OF_INTEREST', 'PAIRING');
+
+CREATE TABLE team_rules (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    kind team_rule_kind NOT NULL,
+    user_id VARCHAR(64) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    other_user_id VARCHAR(64) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    UNIQUE (team_id, kind, user_id, other_user_id),
+    CHECK (user_id <> other_user_id)
+);
+
+CREATE INDEX idx_team_rules_team_id ON team_rules(team_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_team_rules_team_id;
+DROP TABLE team_rules;
+DROP TYPE team_rule_kind;
+-- +goose StatementEnd
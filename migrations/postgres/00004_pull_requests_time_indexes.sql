@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_pull_requests_created_at ON pull_requests(created_at);
+CREATE INDEX idx_pull_requests_merged_at ON pull_requests(merged_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pull_requests_created_at;
+DROP INDEX IF EXISTS idx_pull_requests_merged_at;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE access_role AS ENUM ('admin', 'team_admin', 'member');
+
+-- tokens issued before roles keep their scopes with the least privileged role,
+-- the ones that should manage teams or tokens are reissued with an explicit role
+ALTER TABLE api_tokens ADD COLUMN role access_role NOT NULL DEFAULT 'member';
+ALTER TABLE api_tokens ALTER COLUMN role DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE api_tokens DROP COLUMN role;
+DROP TYPE access_role;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- UNLOGGED: buckets are cheap to lose on a crash, clients just get a full burst again
+CREATE UNLOGGED TABLE rate_limit_buckets (
+    bucket_key VARCHAR(256) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE rate_limit_buckets;
+-- +goose StatementEnd
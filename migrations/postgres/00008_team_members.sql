@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE team_members (
+    team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    is_primary BOOLEAN NOT NULL DEFAULT false,
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_members_user_id ON team_members(user_id);
+-- a user authors pull requests on behalf of at most one primary team
+CREATE UNIQUE INDEX idx_team_members_primary ON team_members(user_id) WHERE is_primary;
+
+INSERT INTO team_members (team_id, user_id, is_primary)
+SELECT u.team_id, u.id, true FROM users u
+WHERE u.team_id IS NOT NULL;
+
+ALTER TABLE pull_requests ADD COLUMN team_id BIGINT REFERENCES teams(id) ON DELETE SET NULL;
+
+UPDATE pull_requests prs SET team_id = u.team_id
+FROM users u
+WHERE u.user_id = prs.author_id;
+
+CREATE INDEX idx_pull_requests_team_id ON pull_requests(team_id);
+
+DROP INDEX IF EXISTS idx_users_team_id;
+ALTER TABLE users DROP COLUMN team_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN team_id BIGINT REFERENCES teams(id) ON DELETE SET NULL;
+
+UPDATE users u SET team_id = tm.team_id
+FROM team_members tm
+WHERE tm.user_id = u.id AND tm.is_primary;
+
+CREATE INDEX idx_users_team_id ON users(team_id);
+
+DROP INDEX IF EXISTS idx_pull_requests_team_id;
+ALTER TABLE pull_requests DROP COLUMN team_id;
+
+DROP TABLE team_members;
+-- +goose StatementEnd
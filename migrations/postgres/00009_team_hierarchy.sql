@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD COLUMN parent_id BIGINT REFERENCES teams(id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_teams_parent_id ON teams(parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_teams_parent_id;
+ALTER TABLE teams DROP COLUMN parent_id;
+-- +goose StatementEnd
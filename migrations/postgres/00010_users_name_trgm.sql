@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- serves case-insensitive name prefix search of the user directory
+CREATE INDEX idx_users_name_trgm ON users USING gin (name gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- pg_trgm is kept, it may have been installed before and be used outside of this migration
+DROP INDEX IF EXISTS idx_users_name_trgm;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE decline_reason AS ENUM ('NO_CONTEXT', 'CONFLICT_OF_INTEREST', 'UNAVAILABLE', 'OTHER');
+
+CREATE TABLE review_declines (
+    pull_request_id BIGINT NOT NULL REFERENCES pull_requests(id) ON DELETE RESTRICT,
+    reviewer_id BIGINT NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    reason decline_reason NOT NULL,
+    comment VARCHAR(512) NOT NULL DEFAULT '',
+    declined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (pull_request_id, reviewer_id)
+);
+
+CREATE INDEX idx_review_declines_reviewer_id ON review_declines(reviewer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_review_declines_reviewer_id;
+DROP TABLE review_declines;
+DROP TYPE decline_reason;
+-- +goose StatementEnd
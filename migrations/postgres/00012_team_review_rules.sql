@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE team_review_rules (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    min_lines_changed INTEGER NOT NULL DEFAULT 0 CHECK (min_lines_changed >= 0),
+    label VARCHAR(64) NOT NULL DEFAULT '',
+    reviewers_count SMALLINT NOT NULL DEFAULT 0 CHECK (reviewers_count BETWEEN 0 AND 10),
+    extra_team_id BIGINT REFERENCES teams(id) ON DELETE CASCADE,
+    UNIQUE NULLS NOT DISTINCT (team_id, min_lines_changed, label, reviewers_count, extra_team_id),
+    CHECK (reviewers_count > 0 OR extra_team_id IS NOT NULL)
+);
+
+CREATE INDEX idx_team_review_rules_team_id ON team_review_rules(team_id);
+CREATE INDEX idx_team_review_rules_extra_team_id ON team_review_rules(extra_team_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_team_review_rules_extra_team_id;
+DROP INDEX IF EXISTS idx_team_review_rules_team_id;
+DROP TABLE team_review_rules;
+-- +goose StatementEnd
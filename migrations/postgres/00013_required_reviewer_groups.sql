@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE required_reviewer_groups (
+    team_id BIGINT PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    labels VARCHAR(64)[] NOT NULL DEFAULT '{}',
+    path_prefixes VARCHAR(256)[] NOT NULL DEFAULT '{}',
+    CHECK (cardinality(labels) > 0 OR cardinality(path_prefixes) > 0)
+);
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN group_team_id BIGINT REFERENCES teams(id) ON DELETE SET NULL,
+    ADD COLUMN approved_at TIMESTAMP DEFAULT NULL;
+
+CREATE INDEX idx_pull_request_reviewers_group_team_id ON pull_request_reviewers(group_team_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pull_request_reviewers_group_team_id;
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN approved_at,
+    DROP COLUMN group_team_id;
+DROP TABLE required_reviewer_groups;
+-- +goose StatementEnd
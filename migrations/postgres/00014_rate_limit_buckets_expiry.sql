@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- full_at is when an idle bucket refills, from then on it is the same as a missing one and is deleted
+ALTER TABLE rate_limit_buckets ADD COLUMN full_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp();
+ALTER TABLE rate_limit_buckets ALTER COLUMN full_at DROP DEFAULT;
+
+CREATE INDEX idx_rate_limit_buckets_full_at ON rate_limit_buckets(full_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_rate_limit_buckets_full_at;
+ALTER TABLE rate_limit_buckets DROP COLUMN full_at;
+-- +goose StatementEnd
@@ -0,0 +1,128 @@
+// Package client is a typed Go client of the reviewer-assigner HTTP API.
+// It depends on the standard library only and is a module of its own, so other services
+// import it with go get github.com/s-khechnev/reviewer-assigner/pkg/client.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Client calls the API on behalf of the token owner, it is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// New returns a client of the API at baseURL, e.g. http://localhost:8080.
+// The token is sent as a bearer token unless empty, a nil httpClient gets a default one.
+func New(baseURL, token string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// APIError is the error body the API answers with, Code is one of the API error codes,
+// e.g. NOT_FOUND or PR_EXISTS.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	RequestID  string `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID == "" {
+		return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Code, e.Message)
+	}
+
+	return fmt.Sprintf("%d %s: %s (request_id %s)", e.StatusCode, e.Code, e.Message, e.RequestID)
+}
+
+type errorResponse struct {
+	Error     APIError `json:"error"`
+	RequestID string   `json:"request_id"`
+}
+
+// do sends body as JSON and decodes a successful response into out, any other status becomes *APIError.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call %s %s: %w", method, path, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return decodeError(res)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err = json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+func decodeError(res *http.Response) error {
+	apiErr := &APIError{StatusCode: res.StatusCode}
+
+	var errRes errorResponse
+	if err := json.NewDecoder(res.Body).Decode(&errRes); err != nil || errRes.Error.Code == "" {
+		// not an API error body, e.g. from a proxy in front of the API
+		apiErr.Code = "UNKNOWN"
+		apiErr.Message = http.StatusText(res.StatusCode)
+
+		return apiErr
+	}
+
+	apiErr.Code = errRes.Error.Code
+	apiErr.Message = errRes.Error.Message
+	apiErr.RequestID = errRes.RequestID
+
+	return apiErr
+}
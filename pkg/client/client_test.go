@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/pullRequest/create", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"pull_request_id":"pr1","pull_request_name":"Add search",`+
+			`"author_id":"u1","labels":["backend"]}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"pr":{"pull_request_id":"pr1","pull_request_name":"Add search","author_id":"u1",` +
+			`"status":"OPEN","assigned_reviewers":["u2","u3"]}}`))
+	}))
+	defer server.Close()
+
+	pr, err := New(server.URL+"/", "secret", nil).CreatePullRequest(context.Background(), &CreatePullRequestParams{
+		PullRequestID:   "pr1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+		Labels:          []string{"backend"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "OPEN", pr.Status)
+	assert.Equal(t, []string{"u2", "u3"}, pr.AssignedReviewers)
+}
+
+func TestClient_GetReviewerAssignments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stats/reviewers/assignments", r.URL.Path)
+		assert.Equal(t, "OPEN", r.URL.Query().Get("status"))
+		assert.True(t, r.URL.Query().Has("active_only"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.False(t, r.URL.Query().Has("offset"))
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"assignments": []map[string]any{{"user_id": "u2", "username": "Bob", "count": 3}},
+		})
+	}))
+	defer server.Close()
+
+	params := &ReviewerAssignmentsParams{Status: "OPEN", ActiveOnly: true, Limit: 10}
+	assignments, err := New(server.URL, "", nil).GetReviewerAssignments(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, []ReviewerAssignments{{UserID: "u2", Username: "Bob", Count: 3}}, assignments)
+}
+
+func TestClient_APIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected APIError
+	}{
+		{
+			name:   "api error body",
+			status: http.StatusConflict,
+			body:   `{"error":{"code":"PR_MERGED","message":"cannot reassign on merged PR"},"request_id":"req-1"}`,
+			expected: APIError{
+				StatusCode: http.StatusConflict,
+				Code:       "PR_MERGED",
+				Message:    "cannot reassign on merged PR",
+				RequestID:  "req-1",
+			},
+		},
+		{
+			name:   "foreign body",
+			status: http.StatusBadGateway,
+			body:   `<html>bad gateway</html>`,
+			expected: APIError{
+				StatusCode: http.StatusBadGateway,
+				Code:       "UNKNOWN",
+				Message:    "Bad Gateway",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := New(server.URL, "", nil).ReassignPullRequest(context.Background(), "pr1", "u2")
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.expected, *apiErr)
+		})
+	}
+}
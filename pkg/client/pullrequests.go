@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type PullRequest struct {
+	PullRequestID     string   `json:"pull_request_id"`
+	PullRequestName   string   `json:"pull_request_name"`
+	AuthorID          string   `json:"author_id"`
+	Status            string   `json:"status"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	// GroupReviewers maps the reviewers filling required group slots to their group.
+	GroupReviewers    map[string]string `json:"group_reviewers,omitempty"`
+	ApprovedReviewers []string          `json:"approved_reviewers,omitempty"`
+	CreatedAt         *time.Time        `json:"created_at,omitempty"`
+	MergedAt          *time.Time        `json:"merged_at,omitempty"`
+}
+
+// CreatePullRequestParams describes a new PR, all but the id, the name and the author are optional.
+type CreatePullRequestParams struct {
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	AuthorID        string `json:"author_id"`
+	// TeamName defaults to the primary team of the author.
+	TeamName           string   `json:"team_name,omitempty"`
+	LinesChanged       int      `json:"lines_changed,omitempty"`
+	Labels             []string `json:"labels,omitempty"`
+	Paths              []string `json:"paths,omitempty"`
+	RequestedReviewers []string `json:"requested_reviewers,omitempty"`
+}
+
+// CreatePullRequest creates the PR and assigns its reviewers.
+func (c *Client) CreatePullRequest(ctx context.Context, params *CreatePullRequestParams) (*PullRequest, error) {
+	var res struct {
+		PullRequest PullRequest `json:"pr"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/create", nil, params, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.PullRequest, nil
+}
+
+// MergePullRequest merges the PR, merging it again changes nothing.
+func (c *Client) MergePullRequest(ctx context.Context, pullRequestID string) (*PullRequest, error) {
+	req := struct {
+		PullRequestID string `json:"pull_request_id"`
+	}{
+		PullRequestID: pullRequestID,
+	}
+
+	var res struct {
+		PullRequest PullRequest `json:"pr"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/merge", nil, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.PullRequest, nil
+}
+
+type ReassignResult struct {
+	PullRequest PullRequest `json:"pr"`
+	ReplacedBy  string      `json:"replaced_by"`
+}
+
+// ReassignPullRequest hands the review of oldReviewerID over to another member of the team.
+func (c *Client) ReassignPullRequest(
+	ctx context.Context,
+	pullRequestID, oldReviewerID string,
+) (*ReassignResult, error) {
+	req := struct {
+		PullRequestID string `json:"pull_request_id"`
+		OldReviewerID string `json:"old_reviewer_id"`
+	}{
+		PullRequestID: pullRequestID,
+		OldReviewerID: oldReviewerID,
+	}
+
+	var res ReassignResult
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/reassign", nil, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
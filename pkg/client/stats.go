@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type ReviewerAssignments struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Count    int    `json:"count"`
+}
+
+// ReviewerAssignmentsParams filters the assignment counts, zero values leave a filter out.
+type ReviewerAssignmentsParams struct {
+	// Status is OPEN or MERGED.
+	Status     string
+	ActiveOnly bool
+	Limit      int
+	Offset     int
+}
+
+// GetReviewerAssignments counts the reviews assigned to every reviewer, the busiest first.
+func (c *Client) GetReviewerAssignments(
+	ctx context.Context,
+	params *ReviewerAssignmentsParams,
+) ([]ReviewerAssignments, error) {
+	query := url.Values{}
+	if params.Status != "" {
+		query.Set("status", params.Status)
+	}
+	if params.ActiveOnly {
+		query.Set("active_only", "")
+	}
+	setPage(query, params.Limit, params.Offset)
+
+	var res struct {
+		Assignments []ReviewerAssignments `json:"assignments"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/stats/reviewers/assignments", query, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Assignments, nil
+}
+
+type ReviewerLoad struct {
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	IsActive    bool   `json:"is_active"`
+	OpenReviews int    `json:"open_reviews"`
+	Assignments int    `json:"assignments"`
+	// AvgTimeToMergeSeconds is nil when none of the reviewed PRs were merged in the period.
+	AvgTimeToMergeSeconds *float64 `json:"avg_time_to_merge_seconds"`
+}
+
+type Fairness struct {
+	Gini    float64 `json:"gini"`
+	MaxLoad int     `json:"max_load"`
+	MinLoad int     `json:"min_load"`
+}
+
+type TeamLoad struct {
+	TeamName  string         `json:"team_name"`
+	Fairness  Fairness       `json:"fairness"`
+	Reviewers []ReviewerLoad `json:"reviewers"`
+}
+
+type ReviewersLoad struct {
+	From   time.Time  `json:"from"`
+	To     time.Time  `json:"to"`
+	Limit  int        `json:"limit"`
+	Offset int        `json:"offset"`
+	Total  int        `json:"total"`
+	Teams  []TeamLoad `json:"teams"`
+}
+
+// ReviewersLoadParams picks the period and the teams, zero values fall back to the API defaults.
+type ReviewersLoadParams struct {
+	From     time.Time
+	To       time.Time
+	TeamName string
+	Limit    int
+	Offset   int
+}
+
+// GetReviewersLoad returns the load of the reviewers of every team over the period.
+func (c *Client) GetReviewersLoad(ctx context.Context, params *ReviewersLoadParams) (*ReviewersLoad, error) {
+	query := url.Values{}
+	if !params.From.IsZero() {
+		query.Set("from", params.From.Format(time.RFC3339))
+	}
+	if !params.To.IsZero() {
+		query.Set("to", params.To.Format(time.RFC3339))
+	}
+	if params.TeamName != "" {
+		query.Set("team_name", params.TeamName)
+	}
+	setPage(query, params.Limit, params.Offset)
+
+	var res ReviewersLoad
+	if err := c.do(ctx, http.MethodGet, "/stats/reviewers/load", query, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func setPage(query url.Values, limit, offset int) {
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type Member struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type Team struct {
+	TeamName string   `json:"team_name"`
+	Members  []Member `json:"members"`
+}
+
+// TeamMember marks the members whose primary team is the requested one.
+type TeamMember struct {
+	Member
+
+	IsPrimary bool `json:"is_primary"`
+}
+
+// TeamDetails is a team as GetTeam returns it, ParentTeamName is empty for top-level teams.
+type TeamDetails struct {
+	TeamName       string       `json:"team_name"`
+	ParentTeamName string       `json:"parent_team_name,omitempty"`
+	Members        []TeamMember `json:"members"`
+}
+
+// AddTeam creates the team, members that do not exist yet are created along with it.
+func (c *Client) AddTeam(ctx context.Context, team *Team) (*Team, error) {
+	var res struct {
+		Team Team `json:"team"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/team/add", nil, team, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Team, nil
+}
+
+func (c *Client) GetTeam(ctx context.Context, teamName string) (*TeamDetails, error) {
+	var res TeamDetails
+	if err := c.do(ctx, http.MethodGet, "/team/get", url.Values{"team_name": {teamName}}, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
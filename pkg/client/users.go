@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// User names the primary team in TeamName and every team of the user in TeamNames.
+type User struct {
+	UserID      string   `json:"user_id"`
+	Username    string   `json:"username"`
+	TeamName    string   `json:"team_name"`
+	TeamNames   []string `json:"team_names"`
+	IsActive    bool     `json:"is_active"`
+	OpenReviews int      `json:"open_reviews"`
+}
+
+// SetUserActive activates or deactivates the user, inactive users are not picked as reviewers.
+func (c *Client) SetUserActive(ctx context.Context, userID string, isActive bool) (*User, error) {
+	req := struct {
+		UserID   string `json:"user_id"`
+		IsActive bool   `json:"is_active"`
+	}{
+		UserID:   userID,
+		IsActive: isActive,
+	}
+
+	var res struct {
+		User User `json:"user"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/users/setIsActive", nil, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.User, nil
+}